@@ -1,89 +1,202 @@
 package main
 
 import (
-	"encoding/json"
 	"errors"
 	"flag"
 	"fmt"
 	"os"
 	"runtime/debug"
-	"slices"
+	"strings"
 
+	"github.com/trendmicro/vision-one-mcp-server/internal/config"
 	"github.com/trendmicro/vision-one-mcp-server/internal/v1mcp"
 )
 
 func main() {
-	if err := run(); err != nil {
+	if err := run(os.Args[1:]); err != nil {
 		fmt.Fprintf(os.Stderr, "%v\n", err)
 		os.Exit(1)
 	}
 }
 
-func run() error {
-	readOnly := flag.Bool("readonly", true, "set readonly false to allow the MCP server to perform write operations.")
-	v1Region := flag.String("region", "", "set the region of your vision one account.")
-	showVersion := flag.Bool("version", false, "print version information")
-	host := flag.String("host", "", "set the Trend Vision One endpoint you want to use. Only useful for interacting with internal environments.")
+// options are the flags that are not part of the configuration.
+type options struct {
+	configFile  string
+	showVersion bool
+}
 
-	flag.Parse()
+func run(args []string) error {
+	if len(args) > 0 && args[0] == "config" {
+		if len(args) < 2 || args[1] != "validate" {
+			return errors.New("usage: v1-mcp-server config validate [flags]")
+		}
+		return validateConfig(args[2:])
+	}
 
-	if *showVersion {
+	cfg, opts, err := loadConfig(os.Args[0], args)
+	if opts.showVersion {
 		printVersion()
 		return nil
 	}
+	if err != nil {
+		return err
+	}
+
+	return v1mcp.RunMcpStdioServer(serverConfig(cfg, getVersion()))
+}
 
-	apiKey := os.Getenv("TREND_VISION_ONE_API_KEY")
-	if apiKey == "" {
-		return errors.New("TREND_VISION_ONE_API_KEY not set")
+// validateConfig prints the effective configuration of args with its
+// secrets masked, or its problems.
+func validateConfig(args []string) error {
+	cfg, _, err := loadConfig("v1-mcp-server config validate", args)
+	if err != nil {
+		return fmt.Errorf("invalid configuration:\n%w", err)
 	}
 
-	if *host != "" && *v1Region != "" {
-		return errors.New("host and region cannot be used together")
+	b, err := cfg.Masked()
+	if err != nil {
+		return err
+	}
+	if cfg.Source != "" {
+		fmt.Printf("# %s\n", cfg.Source)
 	}
+	fmt.Print(string(b))
+	return nil
+}
 
-	if *v1Region != "" {
-		if err := validateRegion(*v1Region); err != nil {
-			return err
-		}
+// loadConfig returns the configuration of args: the defaults, overridden by
+// the -config file, the environment and the other flags. The flags are
+// parsed twice, first for the file and then on top of it.
+func loadConfig(name string, args []string) (config.Config, options, error) {
+	opts := options{}
+	defaults := config.Default()
+	if err := newFlagSet(name, &defaults, &opts).Parse(args); err != nil {
+		return config.Config{}, opts, err
+	}
+	if opts.showVersion {
+		return config.Config{}, opts, nil
 	}
 
-	version := getVersion()
+	cfg, err := config.Load(opts.configFile)
+	if err != nil {
+		return config.Config{}, opts, err
+	}
+	cfg.ApplyEnv(os.Getenv)
+	fs := newFlagSet(name, &cfg, &opts)
+	if err := fs.Parse(args); err != nil {
+		return config.Config{}, opts, err
+	}
 
-	serverCfg := v1mcp.ServerConfig{
-		ApiKey:   apiKey,
-		ReadOnly: *readOnly,
-		Region:   *v1Region,
-		Version:  version,
-		Host:     *host,
+	if !cfg.LazyToolsets && isFlagSet(fs, "toolset-idle-timeout") {
+		return config.Config{}, opts, errors.New("toolset-idle-timeout requires lazy-toolsets")
 	}
 
-	return v1mcp.RunMcpStdioServer(serverCfg)
+	if err := cfg.Validate(); err != nil {
+		return config.Config{}, opts, err
+	}
+	if err := cfg.ReadAPIKey(os.Getenv); err != nil {
+		return config.Config{}, opts, err
+	}
+	return cfg, opts, nil
 }
 
-func validateRegion(region string) error {
-	validRegions := []string{
-		"au",
-		"eu",
-		"in",
-		"jp",
-		"sg",
-		"us",
-		"mea",
-	}
+// newFlagSet returns the flags of the server bound to cfg and opts. The
+// defaults of the flags are the values of cfg.
+func newFlagSet(name string, cfg *config.Config, opts *options) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ExitOnError)
+	fs.StringVar(&opts.configFile, "config", "", "set a YAML or JSON configuration file. Environment variables and flags override its settings.")
+	fs.BoolVar(&opts.showVersion, "version", false, "print version information")
+
+	fs.BoolVar(&cfg.ReadOnly, "readonly", cfg.ReadOnly, "set readonly false to allow the MCP server to perform write operations.")
+	fs.StringVar(&cfg.Region, "region", cfg.Region, "set the region of your vision one account.")
+	fs.StringVar(&cfg.Host, "host", cfg.Host, "set the Trend Vision One endpoint you want to use. Only useful for interacting with internal environments.")
+	fs.StringVar(&cfg.Guardrails.ApplicationName, "guardrails-app-name", cfg.Guardrails.ApplicationName, "set to evaluate tool results with AI Guard using the given application name before they are returned.")
+	fs.BoolVar(&cfg.Guardrails.CheckArguments, "guardrails-check-args", cfg.Guardrails.CheckArguments, "also evaluate tool arguments with AI Guard. Requires guardrails-app-name.")
+	fs.StringVar(&cfg.PromptsDir, "prompts-dir", cfg.PromptsDir, "set a directory of prompt files that override or extend the built-in prompts.")
+	fs.StringVar(&cfg.AuditLog, "audit-log", cfg.AuditLog, "set a file to record every tool call and Vision One request to as JSON lines, or - for stderr. Without it, write tool calls are recorded to stderr.")
+	fs.BoolVar(&cfg.DryRun, "dry-run", cfg.DryRun, "set to make write tools return the requests they would send to Vision One instead of sending them. Requires readonly=false.")
+	fs.StringVar(&cfg.Policy, "policy", cfg.Policy, "set a YAML or JSON policy file that grants write tools individually and constrains their arguments. Requires readonly=false.")
+	fs.StringVar(&cfg.ExportDir, "export-dir", cfg.ExportDir, "set a directory to enable the export_to_file tool, which writes large list results to files in this directory.")
+	fs.StringVar(&cfg.ImportDir, "import-dir", cfg.ImportDir, "set a directory the bulk import tools may read files from with their filePath argument.")
+	fs.BoolVar(&cfg.LazyToolsets, "lazy-toolsets", cfg.LazyToolsets, "set to only register the v1mcp_tools_describe and enable_toolset tools at start and add toolsets when the model enables them.")
+	fs.DurationVar(&cfg.ToolsetIdleTimeout, "toolset-idle-timeout", cfg.ToolsetIdleTimeout, "set how long toolsets enabled in lazy mode stay without being used, 0 keeps them. Requires lazy-toolsets.")
+	fs.Var(listFlag{&cfg.Toolsets}, "toolsets", "set a comma separated list of the toolsets to enable, e.g. container,threatintel. Every toolset is enabled by default.")
+	fs.DurationVar(&cfg.RequestTimeout, "request-timeout", cfg.RequestTimeout, "set the time limit of a Vision One request including reading its response and its retries, 0 for no limit.")
+	fs.IntVar(&cfg.Retries, "retries", cfg.Retries, "set how often rate limited requests, and reads while Vision One is unavailable, are retried.")
+	fs.Float64Var(&cfg.RateLimit, "rate-limit", cfg.RateLimit, "set the maximum number of Vision One requests per second, 0 for no limit.")
+	fs.StringVar(&cfg.Proxy, "proxy", cfg.Proxy, "set the URL of the proxy for Vision One requests. Defaults to the HTTPS_PROXY environment variable.")
+	fs.IntVar(&cfg.DefaultTop.All, "default-top", cfg.DefaultTop.All, "set the page size of list tools when the model doesn't set top, 0 keeps the default of the API.")
+	return fs
+}
 
-	b, _ := json.Marshal(validRegions)
+// isFlagSet returns whether the flag name of fs was set on the command line.
+func isFlagSet(fs *flag.FlagSet, name string) bool {
+	set := false
+	fs.Visit(func(f *flag.Flag) {
+		if f.Name == name {
+			set = true
+		}
+	})
+	return set
+}
 
-	if region == "" {
-		return fmt.Errorf("missing region, please provide one of %s", string(b))
-	}
+// listFlag is a comma separated list flag.
+type listFlag struct {
+	values *[]string
+}
 
-	if !slices.Contains(validRegions, region) {
-		return fmt.Errorf("invalid region %q, provide on of %s", region, string(b))
+func (f listFlag) String() string {
+	if f.values == nil {
+		return ""
 	}
+	return strings.Join(*f.values, ",")
+}
 
+func (f listFlag) Set(s string) error {
+	*f.values = []string{}
+	for _, value := range strings.Split(s, ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			*f.values = append(*f.values, value)
+		}
+	}
 	return nil
 }
 
+func serverConfig(cfg config.Config, version string) v1mcp.ServerConfig {
+	return v1mcp.ServerConfig{
+		ApiKey:   cfg.APIKey.Value,
+		ReadOnly: cfg.ReadOnly,
+		Region:   cfg.Region,
+		Version:  version,
+		Host:     cfg.Host,
+
+		Toolsets: cfg.Toolsets,
+
+		RequestTimeout: cfg.RequestTimeout,
+		MaxRetries:     cfg.Retries,
+		RateLimit:      cfg.RateLimit,
+		Proxy:          cfg.Proxy,
+
+		DefaultTop:        cfg.DefaultTop.All,
+		ToolsetDefaultTop: cfg.DefaultTop.Toolsets,
+
+		GuardrailsApplicationName: cfg.Guardrails.ApplicationName,
+		GuardrailsCheckArguments:  cfg.Guardrails.CheckArguments,
+
+		PromptsDir: cfg.PromptsDir,
+		ExportDir:  cfg.ExportDir,
+		ImportDir:  cfg.ImportDir,
+
+		AuditLog: cfg.AuditLog,
+		DryRun:   cfg.DryRun,
+
+		PolicyFile: cfg.Policy,
+
+		LazyToolsets:       cfg.LazyToolsets,
+		ToolsetIdleTimeout: cfg.ToolsetIdleTimeout,
+	}
+}
+
 func getVersion() string {
 	info, ok := debug.ReadBuildInfo()
 	if !ok {
@@ -6,6 +6,8 @@ require (
 	github.com/google/go-querystring v1.2.0
 	github.com/mark3labs/mcp-go v0.43.2
 	github.com/stretchr/testify v1.9.0
+	golang.org/x/net v0.60.0
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
@@ -19,5 +21,4 @@ require (
 	github.com/spf13/cast v1.10.0 // indirect
 	github.com/wk8/go-ordered-map/v2 v2.1.8 // indirect
 	github.com/yosida95/uritemplate/v3 v3.0.2 // indirect
-	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
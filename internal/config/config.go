@@ -0,0 +1,261 @@
+// Package config loads the configuration of the server.
+//
+// The configuration is a YAML or JSON file passed with -config:
+//
+//	region: us
+//	apiKey:
+//	  file: /run/secrets/vision-one-api-key
+//	readOnly: true
+//	toolsets: [container, threatintel]
+//	requestTimeout: 1m
+//	retries: 2
+//	rateLimit: 5
+//	proxy: http://proxy.example.com:3128
+//	auditLog: /var/log/v1-mcp-server/audit.jsonl
+//	defaultTop:
+//	  all: 50
+//	  toolsets:
+//	    endpoint: 200
+//
+// Settings are applied in this order, later ones win: the defaults of
+// Default, the file, the environment and the command line flags.
+package config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"net/url"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/trendmicro/vision-one-mcp-server/internal/v1mcp/tools"
+	"gopkg.in/yaml.v3"
+)
+
+// APIKeyEnv is the environment variable the API key is read from. It takes
+// precedence over the API key source of the file.
+const APIKeyEnv = "TREND_VISION_ONE_API_KEY"
+
+// redacted replaces secrets in the output of config validate.
+const redacted = "[REDACTED]"
+
+// Regions are the Vision One regions.
+var Regions = []string{"au", "eu", "in", "jp", "sg", "us", "mea"}
+
+// APIKey is where the API key is read from. At most one of Env and File is
+// set, Value is only set once the key was read.
+type APIKey struct {
+	// Env is the environment variable the API key is read from.
+	Env string `yaml:"env,omitempty"`
+	// File is a file the API key is read from, surrounding whitespace is
+	// removed.
+	File string `yaml:"file,omitempty"`
+
+	Value string `yaml:"-"`
+}
+
+// DefaultTop is the page size of list tools when the model doesn't set
+// top. Zero keeps the default of the API.
+type DefaultTop struct {
+	All int `yaml:"all"`
+	// Toolsets overrides All by toolset.
+	Toolsets map[string]int `yaml:"toolsets,omitempty"`
+}
+
+// Guardrails are the AI Guard settings, see the -guardrails flags.
+type Guardrails struct {
+	ApplicationName string `yaml:"applicationName,omitempty"`
+	CheckArguments  bool   `yaml:"checkArguments"`
+}
+
+// Config is the configuration of the server.
+type Config struct {
+	Region   string `yaml:"region,omitempty"`
+	Host     string `yaml:"host,omitempty"`
+	APIKey   APIKey `yaml:"apiKey"`
+	ReadOnly bool   `yaml:"readOnly"`
+
+	// Toolsets are the enabled toolsets, every toolset when empty.
+	Toolsets           []string      `yaml:"toolsets,omitempty"`
+	LazyToolsets       bool          `yaml:"lazyToolsets"`
+	ToolsetIdleTimeout time.Duration `yaml:"toolsetIdleTimeout"`
+
+	// RequestTimeout limits a Vision One request including reading its
+	// response and its retries.
+	RequestTimeout time.Duration `yaml:"requestTimeout"`
+	Retries        int           `yaml:"retries"`
+	// RateLimit is the maximum number of requests per second.
+	RateLimit float64 `yaml:"rateLimit"`
+	Proxy     string  `yaml:"proxy,omitempty"`
+
+	DefaultTop DefaultTop `yaml:"defaultTop"`
+
+	AuditLog   string     `yaml:"auditLog,omitempty"`
+	PromptsDir string     `yaml:"promptsDir,omitempty"`
+	ExportDir  string     `yaml:"exportDir,omitempty"`
+	ImportDir  string     `yaml:"importDir,omitempty"`
+	DryRun     bool       `yaml:"dryRun"`
+	Policy     string     `yaml:"policy,omitempty"`
+	Guardrails Guardrails `yaml:"guardrails"`
+
+	// Source is the file the configuration was loaded from, if any.
+	Source string `yaml:"-"`
+}
+
+// Default returns the configuration without a file, environment or flags.
+func Default() Config {
+	return Config{
+		APIKey:             APIKey{Env: APIKeyEnv},
+		ReadOnly:           true,
+		ToolsetIdleTimeout: 30 * time.Minute,
+	}
+}
+
+// Load returns the default configuration overridden by the file at path.
+// Unknown fields are rejected so that misspelled settings are not ignored.
+func Load(path string) (Config, error) {
+	c := Default()
+	if path == "" {
+		return c, nil
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return Config{}, fmt.Errorf("error reading config: %w", err)
+	}
+
+	// The API key source of the file replaces the default one.
+	c.APIKey = APIKey{}
+	decoder := yaml.NewDecoder(bytes.NewReader(b))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&c); err != nil && !errors.Is(err, io.EOF) {
+		return Config{}, fmt.Errorf("error reading config %s: %w", path, err)
+	}
+	if c.APIKey == (APIKey{}) {
+		c.APIKey.Env = APIKeyEnv
+	}
+	c.Source = path
+	return c, nil
+}
+
+// ApplyEnv overrides c with the environment.
+func (c *Config) ApplyEnv(getenv func(string) string) {
+	if key := getenv(APIKeyEnv); key != "" {
+		c.APIKey = APIKey{Env: APIKeyEnv, Value: key}
+	}
+}
+
+// ReadAPIKey reads the API key from its source unless it was read already.
+func (c *Config) ReadAPIKey(getenv func(string) string) error {
+	if c.APIKey.Value != "" {
+		return nil
+	}
+	switch {
+	case c.APIKey.Env != "" && c.APIKey.File != "":
+		return errors.New("apiKey: env and file cannot be used together")
+	case c.APIKey.File != "":
+		b, err := os.ReadFile(c.APIKey.File)
+		if err != nil {
+			return fmt.Errorf("error reading API key: %w", err)
+		}
+		c.APIKey.Value = strings.TrimSpace(string(b))
+		if c.APIKey.Value == "" {
+			return fmt.Errorf("API key file %s is empty", c.APIKey.File)
+		}
+	case c.APIKey.Env != "":
+		c.APIKey.Value = getenv(c.APIKey.Env)
+		if c.APIKey.Value == "" {
+			return fmt.Errorf("%s not set", c.APIKey.Env)
+		}
+	default:
+		return errors.New("apiKey: missing env or file")
+	}
+	return nil
+}
+
+// Validate returns the problems of c.
+func (c Config) Validate() error {
+	errs := []error{}
+
+	switch {
+	case c.Host != "" && c.Region != "":
+		errs = append(errs, errors.New("host and region cannot be used together"))
+	case c.Host == "" && c.Region == "":
+		errs = append(errs, fmt.Errorf("missing region, please provide one of %s", strings.Join(Regions, ", ")))
+	case c.Region != "" && !slices.Contains(Regions, c.Region):
+		errs = append(errs, fmt.Errorf("invalid region %q, provide one of %s", c.Region, strings.Join(Regions, ", ")))
+	}
+
+	toolsets := tools.Toolsets(tools.Registry)
+	for _, toolset := range slices.Concat(c.Toolsets, slices.Sorted(maps.Keys(c.DefaultTop.Toolsets))) {
+		if !slices.Contains(toolsets, toolset) {
+			errs = append(errs, fmt.Errorf("unknown toolset %q, expected one of %s", toolset, strings.Join(toolsets, ", ")))
+		}
+	}
+	if c.DefaultTop.All < 0 || slices.ContainsFunc(slices.Collect(maps.Values(c.DefaultTop.Toolsets)), func(top int) bool { return top < 0 }) {
+		errs = append(errs, errors.New("defaultTop can't be negative"))
+	}
+
+	if c.ToolsetIdleTimeout < 0 {
+		errs = append(errs, errors.New("toolsetIdleTimeout can't be negative"))
+	}
+	if c.RequestTimeout < 0 {
+		errs = append(errs, errors.New("requestTimeout can't be negative"))
+	}
+	if c.Retries < 0 {
+		errs = append(errs, errors.New("retries can't be negative"))
+	}
+	if c.RateLimit < 0 {
+		errs = append(errs, errors.New("rateLimit can't be negative"))
+	}
+	if c.Proxy != "" {
+		if u, err := url.Parse(c.Proxy); err != nil || u.Host == "" {
+			errs = append(errs, fmt.Errorf("invalid proxy %q", redactURL(c.Proxy)))
+		}
+	}
+
+	if c.Guardrails.CheckArguments && c.Guardrails.ApplicationName == "" {
+		errs = append(errs, errors.New("guardrails-check-args requires guardrails-app-name"))
+	}
+	if c.DryRun && c.ReadOnly {
+		errs = append(errs, errors.New("dry-run requires readonly=false"))
+	}
+	if c.Policy != "" && c.ReadOnly {
+		errs = append(errs, errors.New("policy requires readonly=false"))
+	}
+
+	return errors.Join(errs...)
+}
+
+// Masked returns c as YAML with its secrets masked: the API key and the
+// password of the proxy.
+func (c Config) Masked() ([]byte, error) {
+	c.Proxy = redactURL(c.Proxy)
+	return yaml.Marshal(c)
+}
+
+// MarshalYAML masks the API key.
+func (k APIKey) MarshalYAML() (any, error) {
+	masked := struct {
+		Env   string `yaml:"env,omitempty"`
+		File  string `yaml:"file,omitempty"`
+		Value string `yaml:"value,omitempty"`
+	}{Env: k.Env, File: k.File}
+	if k.Value != "" {
+		masked.Value = redacted
+	}
+	return masked, nil
+}
+
+func redactURL(s string) string {
+	u, err := url.Parse(s)
+	if err != nil || u.User == nil {
+		return s
+	}
+	return u.Redacted()
+}
@@ -18,17 +18,50 @@ type AISecurityApplyGuardrailsInput struct {
 	Messages []AISecurityChatMessage `json:"messages,omitempty"`
 
 	// For OpenAIChatCompletionResponseV1
-	ID      string                 `json:"id,omitempty"`
-	Object  string                 `json:"object,omitempty"`
-	Created int64                  `json:"created,omitempty"`
-	Choices []AISecurityChatChoice `json:"choices,omitempty"`
-	Usage   *AISecurityUsage       `json:"usage,omitempty"`
+	ID                string                 `json:"id,omitempty"`
+	Object            string                 `json:"object,omitempty"`
+	Created           int64                  `json:"created,omitempty"`
+	SystemFingerprint string                 `json:"system_fingerprint,omitempty"`
+	Choices           []AISecurityChatChoice `json:"choices,omitempty"`
+	Usage             *AISecurityUsage       `json:"usage,omitempty"`
+}
+
+// AISecurityChatCompletionResponse is an OpenAI chat completion response
+// as produced by a model. It is evaluated using the OpenAIChatCompletionResponseV1 request type.
+type AISecurityChatCompletionResponse struct {
+	ID                string                 `json:"id,omitempty"`
+	Object            string                 `json:"object,omitempty"`
+	Created           int64                  `json:"created,omitempty"`
+	Model             string                 `json:"model,omitempty"`
+	SystemFingerprint string                 `json:"system_fingerprint,omitempty"`
+	Choices           []AISecurityChatChoice `json:"choices"`
+	Usage             *AISecurityUsage       `json:"usage,omitempty"`
 }
 
 // AISecurityChatMessage represents a message in the chat conversation.
 type AISecurityChatMessage struct {
 	Role    string `json:"role"`
-	Content string `json:"content"`
+	Content string `json:"content,omitempty"`
+	// Name is an optional name for the participant.
+	Name string `json:"name,omitempty"`
+	// ToolCalls are the tool calls requested by an assistant message.
+	ToolCalls []AISecurityToolCall `json:"tool_calls,omitempty"`
+	// ToolCallID is the tool call a tool message is responding to.
+	ToolCallID string `json:"tool_call_id,omitempty"`
+}
+
+// AISecurityToolCall represents a tool call requested by the model.
+type AISecurityToolCall struct {
+	ID       string                     `json:"id"`
+	Type     string                     `json:"type"`
+	Function AISecurityToolCallFunction `json:"function"`
+}
+
+// AISecurityToolCallFunction is the function invoked by a tool call.
+type AISecurityToolCallFunction struct {
+	Name string `json:"name"`
+	// Arguments are the JSON encoded arguments generated by the model.
+	Arguments string `json:"arguments"`
 }
 
 // AISecurityChatChoice represents a choice in the OpenAI chat completion response.
@@ -40,9 +73,10 @@ type AISecurityChatChoice struct {
 
 // AISecurityChoiceMessage represents a message in a chat choice.
 type AISecurityChoiceMessage struct {
-	Role    string  `json:"role"`
-	Content string  `json:"content"`
-	Refusal *string `json:"refusal,omitempty"`
+	Role      string               `json:"role"`
+	Content   string               `json:"content"`
+	Refusal   *string              `json:"refusal,omitempty"`
+	ToolCalls []AISecurityToolCall `json:"tool_calls,omitempty"`
 }
 
 // AISecurityUsage represents token usage statistics.
@@ -62,6 +96,15 @@ type AISecurityApplyGuardrailsOptions struct {
 	Prefer string
 }
 
+// AISecurityApplyGuardrailsResult is the evaluation returned by the applyGuardrails API.
+type AISecurityApplyGuardrailsResult struct {
+	ID string `json:"id,omitempty"`
+	// Action is the recommended action, either Allow or Block.
+	Action string `json:"action"`
+	// Reasons explain the policy violations that led to a Block action.
+	Reasons []string `json:"reasons"`
+}
+
 // AISecurityApplyGuardrails evaluates prompts against AI guard policies.
 func (c *V1ApiClient) AISecurityApplyGuardrails(input AISecurityApplyGuardrailsInput, opts AISecurityApplyGuardrailsOptions) (*http.Response, error) {
 	return c.genericJSONPost(
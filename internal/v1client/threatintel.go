@@ -3,24 +3,25 @@ package v1client
 import (
 	"fmt"
 	"net/http"
+	"time"
 )
 
 type ThreatIntelQueryParameters struct {
-	OrderBy       string `url:"orderBy,omitempty"`
-	Top           int    `url:"top,omitempty"`
-	SkipToken     string `url:"skipToken,omitempty"`
-	StartDateTime string `url:"startDateTime,omitempty"`
-	EndDateTime   string `url:"endDateTime,omitempty"`
-	Filter        string `url:"filter,omitempty"`
+	OrderBy       string    `url:"orderBy,omitempty"`
+	Top           int       `url:"top,omitempty"`
+	SkipToken     string    `url:"skipToken,omitempty"`
+	StartDateTime time.Time `url:"startDateTime,omitempty"`
+	EndDateTime   time.Time `url:"endDateTime,omitempty"`
+	Filter        string    `url:"filter,omitempty"`
 }
 
 type ThreatIntelFeedParameters struct {
-	StartDateTime         string `url:"startDateTime,omitempty"`
-	EndDateTime           string `url:"endDateTime,omitempty"`
-	Top                   int    `url:"top,omitempty"`
-	TopReport             int    `url:"topReport,omitempty"`
-	IndicatorObjectFormat string `url:"indicatorObjectFormat,omitempty"`
-	ResponseObjectFormat  string `url:"responseObjectFormat,omitempty"`
+	StartDateTime         time.Time `url:"startDateTime,omitempty"`
+	EndDateTime           time.Time `url:"endDateTime,omitempty"`
+	Top                   int       `url:"top,omitempty"`
+	TopReport             int       `url:"topReport,omitempty"`
+	IndicatorObjectFormat string    `url:"indicatorObjectFormat,omitempty"`
+	ResponseObjectFormat  string    `url:"responseObjectFormat,omitempty"`
 }
 
 type SuspiciousObject struct {
@@ -0,0 +1,129 @@
+package v1client
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// maxRetryAfter caps the Retry-After delays the client waits for.
+const maxRetryAfter = 30 * time.Second
+
+// newHTTPClient returns the HTTP client of co. Retries are rate limited as
+// well, and Timeout covers a request including its retries.
+func newHTTPClient(co ClientOptions) *http.Client {
+	transport := co.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	if co.RateLimit > 0 {
+		transport = &rateLimitTransport{
+			next:     transport,
+			interval: time.Duration(float64(time.Second) / co.RateLimit),
+		}
+	}
+	if co.MaxRetries > 0 {
+		transport = &retryTransport{next: transport, maxRetries: co.MaxRetries, backoff: time.Second}
+	}
+	return &http.Client{Transport: transport, Timeout: co.Timeout}
+}
+
+// retryTransport retries requests that were rate limited by Vision One, and
+// requests that only read when Vision One is unavailable.
+type retryTransport struct {
+	next       http.RoundTripper
+	maxRetries int
+	// backoff is the delay before the first retry, it doubles with every
+	// retry. Retry-After headers take precedence.
+	backoff time.Duration
+}
+
+func (t *retryTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	request := r
+	for retry := 0; ; retry++ {
+		resp, err := t.next.RoundTrip(request)
+		if retry >= t.maxRetries || !shouldRetry(r, resp, err) {
+			return resp, err
+		}
+
+		delay := t.backoff << retry
+		if resp != nil {
+			if seconds, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && seconds >= 0 {
+				delay = min(time.Duration(seconds)*time.Second, maxRetryAfter)
+			}
+			_, _ = io.Copy(io.Discard, resp.Body)
+			_ = resp.Body.Close()
+		}
+
+		select {
+		case <-r.Context().Done():
+			return nil, r.Context().Err()
+		case <-time.After(delay):
+		}
+
+		request = r.Clone(r.Context())
+		if r.GetBody != nil {
+			body, err := r.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			request.Body = body
+		}
+	}
+}
+
+// shouldRetry returns whether r can be sent again after resp or err. Rate
+// limited requests were not processed and are always retried, other
+// failures only for requests that read.
+func shouldRetry(r *http.Request, resp *http.Response, err error) bool {
+	if r.Body != nil && r.Body != http.NoBody && r.GetBody == nil {
+		return false
+	}
+	if r.Context().Err() != nil {
+		return false
+	}
+
+	reads := r.Method == http.MethodGet || r.Method == http.MethodHead
+	if err != nil {
+		return reads
+	}
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return reads
+	}
+	return false
+}
+
+// rateLimitTransport sends at most one request per interval.
+type rateLimitTransport struct {
+	next     http.RoundTripper
+	interval time.Duration
+
+	mu sync.Mutex
+	// slot is when the next request may be sent.
+	slot time.Time
+}
+
+func (t *rateLimitTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	t.mu.Lock()
+	now := time.Now()
+	if t.slot.Before(now) {
+		t.slot = now
+	}
+	wait := t.slot.Sub(now)
+	t.slot = t.slot.Add(t.interval)
+	t.mu.Unlock()
+
+	if wait > 0 {
+		select {
+		case <-r.Context().Done():
+			return nil, r.Context().Err()
+		case <-time.After(wait):
+		}
+	}
+	return t.next.RoundTrip(r)
+}
@@ -0,0 +1,68 @@
+package v1client
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetryTransport(t *testing.T) {
+	statuses := []int{}
+	bodies := []string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(b))
+		status := statuses[0]
+		statuses = statuses[1:]
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	transport := &retryTransport{next: http.DefaultTransport, maxRetries: 2, backoff: time.Millisecond}
+	send := func(method string, responses ...int) int {
+		statuses, bodies = responses, nil
+		r, err := http.NewRequest(method, server.URL, strings.NewReader("body"))
+		require.NoError(t, err)
+		resp, err := transport.RoundTrip(r)
+		require.NoError(t, err)
+		_ = resp.Body.Close()
+		return resp.StatusCode
+	}
+
+	t.Run("should retry rate limited requests with their body", func(t *testing.T) {
+		require.Equal(t, http.StatusOK, send(http.MethodPost, http.StatusTooManyRequests, http.StatusOK))
+		require.Equal(t, []string{"body", "body"}, bodies)
+	})
+
+	t.Run("should give up after maxRetries", func(t *testing.T) {
+		require.Equal(t, http.StatusServiceUnavailable, send(http.MethodGet, 503, 503, 503, 200))
+		require.Len(t, bodies, 3)
+	})
+
+	t.Run("should only retry unavailable reads", func(t *testing.T) {
+		require.Equal(t, http.StatusServiceUnavailable, send(http.MethodPost, 503, 200))
+		require.Len(t, bodies, 1)
+
+		require.Equal(t, http.StatusBadRequest, send(http.MethodGet, 400, 200))
+		require.Len(t, bodies, 1)
+	})
+}
+
+func TestRateLimitTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	client := newHTTPClient(ClientOptions{RateLimit: 50})
+	start := time.Now()
+	for range 3 {
+		resp, err := client.Get(server.URL)
+		require.NoError(t, err)
+		_ = resp.Body.Close()
+	}
+	require.GreaterOrEqual(t, time.Since(start), 40*time.Millisecond)
+}
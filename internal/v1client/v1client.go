@@ -7,6 +7,7 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"time"
 
 	"github.com/google/go-querystring/query"
 )
@@ -27,6 +28,18 @@ type ClientOptions struct {
 	// The Host to use. Use for pre-prod environments. If specified will be used instead of [Region]
 	Host      string
 	UserAgent string
+	// Transport sends the requests of the client. http.DefaultTransport is
+	// used when nil.
+	Transport http.RoundTripper
+	// Timeout limits the time of a request including its retries. No limit
+	// when zero.
+	Timeout time.Duration
+	// MaxRetries is how often rate limited requests, and requests that read
+	// while Vision One is unavailable, are retried.
+	MaxRetries int
+	// RateLimit is the maximum number of requests sent per second. No limit
+	// when zero.
+	RateLimit float64
 }
 
 func NewV1ApiClient(co ClientOptions) (*V1ApiClient, error) {
@@ -42,7 +55,7 @@ func NewV1ApiClient(co ClientOptions) (*V1ApiClient, error) {
 		}
 
 		return &V1ApiClient{
-			client:  &http.Client{},
+			client:  newHTTPClient(co),
 			apiKey:  co.ApiKey,
 			baseUrl: baseUrl,
 		}, nil
@@ -54,7 +67,7 @@ func NewV1ApiClient(co ClientOptions) (*V1ApiClient, error) {
 	}
 
 	return &V1ApiClient{
-		client:  &http.Client{},
+		client:  newHTTPClient(co),
 		apiKey:  co.ApiKey,
 		baseUrl: baseUrl,
 	}, nil
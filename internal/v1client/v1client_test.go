@@ -2,7 +2,9 @@ package v1client
 
 import (
 	"testing"
+	"time"
 
+	"github.com/google/go-querystring/query"
 	"github.com/stretchr/testify/require"
 )
 
@@ -35,3 +37,80 @@ func TestNewV1ApiClient(t *testing.T) {
 		)
 	})
 }
+
+func TestQueryParametersEncoding(t *testing.T) {
+	start := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 6, 2, 12, 30, 0, 0, time.FixedZone("UTC+2", 2*60*60))
+
+	for _, tc := range []struct {
+		name     string
+		params   any
+		expected string
+	}{
+		{
+			name:     "empty query parameters",
+			params:   QueryParameters{},
+			expected: "",
+		},
+		{
+			name: "query parameters",
+			params: QueryParameters{
+				OrderBy:                   "latestRiskScore desc",
+				Top:                       50,
+				SkipToken:                 "a+b/c=",
+				NextLink:                  "https://example.com",
+				StartDateTime:             start,
+				EndDateTime:               end,
+				LastDetectedStartDateTime: start,
+				FirstSeenEndDateTime:      end,
+				DetectedStartDateTime:     start,
+				IngestedEndDateTime:       end,
+			},
+			expected: "detectedStartDateTime=2024-06-01T00%3A00%3A00Z&endDateTime=2024-06-02T12%3A30%3A00%2B02%3A00" +
+				"&firstSeenEndDateTime=2024-06-02T12%3A30%3A00%2B02%3A00&ingestedEndDateTime=2024-06-02T12%3A30%3A00%2B02%3A00" +
+				"&lastDetectedStartDateTime=2024-06-01T00%3A00%3A00Z&orderBy=latestRiskScore+desc&skipToken=a%2Bb%2Fc%3D" +
+				"&startDateTime=2024-06-01T00%3A00%3A00Z&top=50",
+		},
+		{
+			name:     "empty threat intel query parameters",
+			params:   ThreatIntelQueryParameters{},
+			expected: "",
+		},
+		{
+			name: "threat intel query parameters",
+			params: ThreatIntelQueryParameters{
+				OrderBy:       "riskLevel asc",
+				Top:           100,
+				StartDateTime: start,
+				EndDateTime:   end,
+				Filter:        "type eq 'url'",
+			},
+			expected: "endDateTime=2024-06-02T12%3A30%3A00%2B02%3A00&filter=type+eq+%27url%27&orderBy=riskLevel+asc" +
+				"&startDateTime=2024-06-01T00%3A00%3A00Z&top=100",
+		},
+		{
+			name:     "empty threat intel feed parameters",
+			params:   ThreatIntelFeedParameters{},
+			expected: "",
+		},
+		{
+			name: "threat intel feed parameters",
+			params: ThreatIntelFeedParameters{
+				StartDateTime:         start,
+				EndDateTime:           end,
+				Top:                   1000,
+				TopReport:             5,
+				IndicatorObjectFormat: "stixBundle",
+				ResponseObjectFormat:  "taxiiEnvelope",
+			},
+			expected: "endDateTime=2024-06-02T12%3A30%3A00%2B02%3A00&indicatorObjectFormat=stixBundle" +
+				"&responseObjectFormat=taxiiEnvelope&startDateTime=2024-06-01T00%3A00%3A00Z&top=1000&topReport=5",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			values, err := query.Values(tc.params)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, values.Encode())
+		})
+	}
+}
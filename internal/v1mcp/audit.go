@@ -0,0 +1,290 @@
+package v1mcp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	mcpserver "github.com/mark3labs/mcp-go/server"
+)
+
+// AuditLogStderr as ServerConfig.AuditLog writes the audit log to stderr.
+const AuditLogStderr = "-"
+
+const (
+	auditToolCall   = "tool_call"
+	auditAPIRequest = "api_request"
+
+	auditStatusOK     = "ok"
+	auditStatusError  = "error"
+	auditStatusFailed = "failed"
+
+	auditRedacted = "[REDACTED]"
+	// auditMaxErrorLength is the longest tool error message recorded.
+	auditMaxErrorLength = 500
+)
+
+var (
+	// auditSecretArgumentRegexp matches the names of arguments whose values
+	// are never recorded.
+	auditSecretArgumentRegexp = regexp.MustCompile(`(?i)^(?:.*(?:password|passwd|secret|credential|privatekey|apikey|api_key)|token|accesstoken|authorization)$`)
+	// auditTokenRegexp matches JSON web tokens such as Vision One API keys.
+	auditTokenRegexp = regexp.MustCompile(`eyJ[A-Za-z0-9_-]+\.[A-Za-z0-9_-]+\.[A-Za-z0-9_-]*`)
+)
+
+// auditNow is the time records are stamped with.
+var auditNow = time.Now
+
+// auditRecord is a line of the audit log. A record is either a tool call or
+// a request the Vision One client sent while running a tool.
+type auditRecord struct {
+	Time time.Time `json:"time"`
+	Type string    `json:"type"`
+
+	Tool      string              `json:"tool,omitempty"`
+	Write     bool                `json:"write,omitempty"`
+	DryRun    bool                `json:"dryRun,omitempty"`
+	Arguments map[string]any      `json:"arguments,omitempty"`
+	SessionID string              `json:"sessionId,omitempty"`
+	Client    *mcp.Implementation `json:"client,omitempty"`
+
+	Method     string `json:"method,omitempty"`
+	Path       string `json:"path,omitempty"`
+	StatusCode int    `json:"statusCode,omitempty"`
+	TraceID    string `json:"traceId,omitempty"`
+
+	DurationMs int64  `json:"durationMs"`
+	Status     string `json:"status"`
+	Error      string `json:"error,omitempty"`
+}
+
+// auditLog writes audit records as JSON lines. Arguments, errors and
+// messages are redacted before they are written.
+type auditLog struct {
+	mu     sync.Mutex
+	w      io.Writer
+	closer io.Closer
+	// writeOnly records write tool calls only, and no API requests.
+	writeOnly bool
+	// dryRun marks write tool calls as dry runs.
+	dryRun  bool
+	secrets []string
+}
+
+// newAuditLog opens the audit log of cfg. Without cfg.AuditLog only write
+// tool calls are recorded, to stderr, and nil is returned when write tools
+// are disabled.
+func newAuditLog(cfg ServerConfig) (*auditLog, error) {
+	l := &auditLog{w: os.Stderr, dryRun: cfg.DryRun}
+	if cfg.ApiKey != "" {
+		l.secrets = append(l.secrets, cfg.ApiKey)
+	}
+
+	switch cfg.AuditLog {
+	case "":
+		if cfg.ReadOnly {
+			return nil, nil
+		}
+		l.writeOnly = true
+	case AuditLogStderr:
+	default:
+		f, err := os.OpenFile(cfg.AuditLog, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0o600)
+		if err != nil {
+			return nil, fmt.Errorf("error opening audit log: %w", err)
+		}
+		l.w, l.closer = f, f
+	}
+	return l, nil
+}
+
+// Close closes the audit log file. It is safe to call on a nil log.
+func (l *auditLog) Close() error {
+	if l == nil || l.closer == nil {
+		return nil
+	}
+	return l.closer.Close()
+}
+
+func (l *auditLog) write(record auditRecord) {
+	record.Time = auditNow().UTC()
+	b, err := json.Marshal(record)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error writing audit record: %v\n", err)
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if _, err := l.w.Write(append(b, '\n')); err != nil {
+		fmt.Fprintf(os.Stderr, "error writing audit record: %v\n", err)
+	}
+}
+
+// redact replaces the API key and anything that looks like a token in s.
+func (l *auditLog) redact(s string) string {
+	for _, secret := range l.secrets {
+		s = strings.ReplaceAll(s, secret, auditRedacted)
+	}
+	return auditTokenRegexp.ReplaceAllString(s, auditRedacted)
+}
+
+// redactValue returns a copy of an argument value with secrets redacted.
+func (l *auditLog) redactValue(value any) any {
+	switch v := value.(type) {
+	case string:
+		return l.redact(v)
+	case map[string]any:
+		redacted := make(map[string]any, len(v))
+		for name, item := range v {
+			if auditSecretArgumentRegexp.MatchString(name) {
+				redacted[name] = auditRedacted
+			} else {
+				redacted[name] = l.redactValue(item)
+			}
+		}
+		return redacted
+	case []any:
+		redacted := make([]any, len(v))
+		for i, item := range v {
+			redacted[i] = l.redactValue(item)
+		}
+		return redacted
+	default:
+		return value
+	}
+}
+
+// middleware records every tool call with its arguments, the session that
+// made it, how long it took and whether it succeeded.
+func (l *auditLog) middleware() mcpserver.ToolHandlerMiddleware {
+	return func(next mcpserver.ToolHandlerFunc) mcpserver.ToolHandlerFunc {
+		return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			write := isWriteTool(ctx, request.Params.Name)
+			if l.writeOnly && !write {
+				return next(ctx, request)
+			}
+
+			start := time.Now()
+			result, err := next(ctx, request)
+
+			record := auditRecord{
+				Type:       auditToolCall,
+				Tool:       request.Params.Name,
+				Write:      write,
+				DryRun:     write && l.dryRun,
+				DurationMs: time.Since(start).Milliseconds(),
+				Status:     auditStatusOK,
+			}
+			if arguments := request.GetArguments(); len(arguments) > 0 {
+				record.Arguments = l.redactValue(arguments).(map[string]any)
+			}
+			if session := mcpserver.ClientSessionFromContext(ctx); session != nil {
+				record.SessionID = session.SessionID()
+				if s, ok := session.(mcpserver.SessionWithClientInfo); ok {
+					if info := s.GetClientInfo(); info.Name != "" {
+						record.Client = &info
+					}
+				}
+			}
+
+			switch {
+			case err != nil:
+				record.Status = auditStatusFailed
+				record.Error = l.redact(err.Error())
+			case result != nil && result.IsError:
+				record.Status = auditStatusError
+				record.Error = l.redact(toolResultMessage(result))
+			}
+
+			l.write(record)
+			return result, err
+		}
+	}
+}
+
+// isWriteTool reports whether a tool may change Vision One. Tools that
+// cannot be looked up are treated as write tools so they are recorded.
+func isWriteTool(ctx context.Context, name string) bool {
+	s := mcpserver.ServerFromContext(ctx)
+	if s == nil {
+		return true
+	}
+	tool := s.GetTool(name)
+	if tool == nil || tool.Tool.Annotations.ReadOnlyHint == nil {
+		return true
+	}
+	return !*tool.Tool.Annotations.ReadOnlyHint
+}
+
+// toolResultMessage returns the text of a tool result, shortened to
+// auditMaxErrorLength.
+func toolResultMessage(result *mcp.CallToolResult) string {
+	texts := []string{}
+	for _, content := range result.Content {
+		if text, ok := mcp.AsTextContent(content); ok {
+			texts = append(texts, text.Text)
+		}
+	}
+	message := strings.Join(texts, "\n")
+	if len(message) > auditMaxErrorLength {
+		message = message[:auditMaxErrorLength] + "..."
+	}
+	return message
+}
+
+// clientTransport returns the transport of the Vision One client, next
+// when requests are not recorded. It is safe to call on a nil log.
+func (l *auditLog) clientTransport(next http.RoundTripper) http.RoundTripper {
+	if l == nil || l.writeOnly {
+		return next
+	}
+	return l.transport(next)
+}
+
+// transport wraps next to record every request sent to Vision One. Only the
+// method, path, status and trace id are recorded, never headers or bodies.
+func (l *auditLog) transport(next http.RoundTripper) http.RoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return auditTransport{log: l, next: next}
+}
+
+type auditTransport struct {
+	log  *auditLog
+	next http.RoundTripper
+}
+
+func (t auditTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	start := time.Now()
+	resp, err := t.next.RoundTrip(r)
+
+	record := auditRecord{
+		Type:       auditAPIRequest,
+		Method:     r.Method,
+		Path:       r.URL.Path,
+		DurationMs: time.Since(start).Milliseconds(),
+		Status:     auditStatusOK,
+	}
+	if err != nil {
+		record.Status = auditStatusFailed
+		record.Error = t.log.redact(err.Error())
+	} else {
+		record.StatusCode = resp.StatusCode
+		record.TraceID = resp.Header.Get("x-trace-id")
+		if resp.StatusCode >= http.StatusBadRequest {
+			record.Status = auditStatusError
+		}
+	}
+
+	t.log.write(record)
+	return resp, err
+}
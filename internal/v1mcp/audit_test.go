@@ -0,0 +1,172 @@
+package v1mcp
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	"github.com/stretchr/testify/require"
+)
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+func readAuditRecords(t *testing.T, b []byte) []auditRecord {
+	t.Helper()
+	records := []auditRecord{}
+	scanner := bufio.NewScanner(bytes.NewReader(b))
+	for scanner.Scan() {
+		record := auditRecord{}
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &record))
+		records = append(records, record)
+	}
+	return records
+}
+
+func newAuditTestServer(l *auditLog) *mcpserver.MCPServer {
+	s := mcpserver.NewMCPServer("test", "1", mcpserver.WithToolHandlerMiddleware(l.middleware()))
+	s.AddTool(
+		mcp.NewTool("read_tool", mcp.WithReadOnlyHintAnnotation(true)),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return mcp.NewToolResultText("ok"), nil
+		},
+	)
+	s.AddTool(
+		mcp.NewTool("write_tool", mcp.WithReadOnlyHintAnnotation(false)),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return mcp.NewToolResultError("account not found"), nil
+		},
+	)
+	return s
+}
+
+func callTool(s *mcpserver.MCPServer, name string, arguments map[string]any) {
+	b, _ := json.Marshal(map[string]any{
+		"jsonrpc": "2.0",
+		"id":      1,
+		"method":  "tools/call",
+		"params":  map[string]any{"name": name, "arguments": arguments},
+	})
+	s.HandleMessage(context.Background(), b)
+}
+
+func TestAuditMiddleware(t *testing.T) {
+	t.Run("should record tool calls with redacted arguments", func(t *testing.T) {
+		var out bytes.Buffer
+		l := &auditLog{w: &out, secrets: []string{"my-api-key"}}
+		s := newAuditTestServer(l)
+
+		callTool(s, "read_tool", map[string]any{
+			"filter":    "name eq 'my-api-key'",
+			"apiKeyIds": []any{"1"},
+			"nested":    []any{map[string]any{"clientSecret": "hunter2", "note": "eyJhbGciOi.eyJzdWIiOi.c2lnbmF0dXJl"}},
+		})
+		callTool(s, "write_tool", map[string]any{"password": "hunter2"})
+
+		records := readAuditRecords(t, out.Bytes())
+		require.Len(t, records, 2)
+
+		require.Equal(t, auditToolCall, records[0].Type)
+		require.Equal(t, "read_tool", records[0].Tool)
+		require.False(t, records[0].Write)
+		require.Equal(t, auditStatusOK, records[0].Status)
+		require.Equal(t, map[string]any{
+			"filter":    "name eq '[REDACTED]'",
+			"apiKeyIds": []any{"1"},
+			"nested":    []any{map[string]any{"clientSecret": "[REDACTED]", "note": "[REDACTED]"}},
+		}, records[0].Arguments)
+
+		require.Equal(t, "write_tool", records[1].Tool)
+		require.True(t, records[1].Write)
+		require.Equal(t, auditStatusError, records[1].Status)
+		require.Equal(t, "account not found", records[1].Error)
+		require.Equal(t, map[string]any{"password": "[REDACTED]"}, records[1].Arguments)
+		require.NotContains(t, out.String(), "hunter2")
+	})
+
+	t.Run("should only record write tools by default", func(t *testing.T) {
+		var out bytes.Buffer
+		l := &auditLog{w: &out, writeOnly: true}
+		s := newAuditTestServer(l)
+
+		callTool(s, "read_tool", nil)
+		callTool(s, "write_tool", nil)
+
+		records := readAuditRecords(t, out.Bytes())
+		require.Len(t, records, 1)
+		require.Equal(t, "write_tool", records[0].Tool)
+	})
+}
+
+func TestAuditTransport(t *testing.T) {
+	var out bytes.Buffer
+	l := &auditLog{w: &out, secrets: []string{"my-api-key"}}
+
+	transport := l.transport(roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		if r.Method == http.MethodDelete {
+			return nil, errors.New("dial failed for my-api-key")
+		}
+		w := httptest.NewRecorder()
+		w.Header().Set("x-trace-id", "trace-1")
+		w.WriteHeader(http.StatusForbidden)
+		return w.Result(), nil
+	}))
+
+	r := httptest.NewRequest(http.MethodGet, "https://api.xdr.trendmicro.com/v3.0/iam/accounts?top=10", nil)
+	r.Header.Set("Authorization", "Bearer my-api-key")
+	_, err := transport.RoundTrip(r)
+	require.NoError(t, err)
+
+	_, err = transport.RoundTrip(httptest.NewRequest(http.MethodDelete, "https://api.xdr.trendmicro.com/v3.0/iam/accounts/delete", nil))
+	require.Error(t, err)
+
+	records := readAuditRecords(t, out.Bytes())
+	require.Len(t, records, 2)
+
+	require.Equal(t, auditAPIRequest, records[0].Type)
+	require.Equal(t, http.MethodGet, records[0].Method)
+	require.Equal(t, "/v3.0/iam/accounts", records[0].Path)
+	require.Equal(t, http.StatusForbidden, records[0].StatusCode)
+	require.Equal(t, "trace-1", records[0].TraceID)
+	require.Equal(t, auditStatusError, records[0].Status)
+
+	require.Equal(t, auditStatusFailed, records[1].Status)
+	require.Equal(t, "dial failed for [REDACTED]", records[1].Error)
+	require.NotContains(t, out.String(), "my-api-key")
+}
+
+func TestNewAuditLog(t *testing.T) {
+	l, err := newAuditLog(ServerConfig{ReadOnly: true})
+	require.NoError(t, err)
+	require.Nil(t, l)
+
+	l, err = newAuditLog(ServerConfig{ReadOnly: false})
+	require.NoError(t, err)
+	require.True(t, l.writeOnly)
+
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	l, err = newAuditLog(ServerConfig{ReadOnly: true, AuditLog: path})
+	require.NoError(t, err)
+	require.False(t, l.writeOnly)
+	l.write(auditRecord{Type: auditToolCall, Tool: "read_tool", Status: auditStatusOK})
+	require.NoError(t, l.Close())
+
+	b, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Len(t, readAuditRecords(t, b), 1)
+
+	_, err = newAuditLog(ServerConfig{AuditLog: filepath.Join(t.TempDir(), "missing", "audit.jsonl")})
+	require.Error(t, err)
+}
@@ -0,0 +1,312 @@
+// Package completion completes the arguments of prompts and resource
+// templates with values from Trend Vision One, for the MCP
+// completion/complete request.
+package completion
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/trendmicro/vision-one-mcp-server/internal/v1client"
+	"github.com/trendmicro/vision-one-mcp-server/internal/v1mcp/tooldescriptions"
+)
+
+// cacheTTL is how long values listed from Trend Vision One are reused.
+// Completion requests are sent on every keystroke.
+const cacheTTL = time.Minute
+
+// maxValues is the maximum number of values of a completion result.
+const maxValues = 100
+
+const (
+	refPrompt   = "ref/prompt"
+	refResource = "ref/resource"
+)
+
+// Completer returns the completion values of a partial argument value.
+type Completer func(value string) ([]string, error)
+
+type Completions struct {
+	// prompts are the completers of prompt arguments by prompt name.
+	prompts map[string]map[string]Completer
+	// resources are the completers of URI template variables by URI template.
+	resources map[string]map[string]Completer
+	// arguments are the completers of the arguments of any prompt, by argument name.
+	arguments map[string]Completer
+}
+
+func New(client *v1client.V1ApiClient) *Completions {
+	alerts := listCompleter(func() (*http.Response, error) {
+		return client.WorkbenchAlertsList("", v1client.QueryParameters{})
+	}, "id", "model")
+	endpoints := listCompleter(func() (*http.Response, error) {
+		return client.EndpointSecurityListEndpoints("", v1client.QueryParameters{})
+	}, "agentGuid", "endpointName")
+	awsAccounts := listCompleter(func() (*http.Response, error) {
+		return client.CAMListAWSAccounts("", v1client.QueryParameters{})
+	}, "id", "name")
+	alibabaAccounts := listCompleter(func() (*http.Response, error) {
+		return client.CAMListAlibabaAccounts("", v1client.QueryParameters{})
+	}, "id", "name")
+	gcpProjects := listCompleter(func() (*http.Response, error) {
+		return client.CAMListGCPAccounts("", v1client.QueryParameters{})
+	}, "id", "name")
+	k8Clusters := listCompleter(func() (*http.Response, error) {
+		return client.ContainerSecurityListK8Clusters("", v1client.QueryParameters{})
+	}, "id", "name")
+	ecsClusters := listCompleter(func() (*http.Response, error) {
+		return client.ContainerSecurityListECSClusters("", v1client.QueryParameters{})
+	}, "id", "name")
+	cloudPostureAccounts := listCompleter(func() (*http.Response, error) {
+		return client.CloudPostureListAccounts(v1client.QueryParameters{})
+	}, "id", "name")
+
+	return &Completions{
+		prompts: map[string]map[string]Completer{
+			"cloud_posture_account_review": {
+				"accountId": cloudPostureAccounts,
+			},
+		},
+		resources: map[string]map[string]Completer{
+			"v1://workbench/alerts/{id}":            {"id": alerts},
+			"v1://endpoints/{agentGuid}":            {"agentGuid": endpoints},
+			"v1://cam/aws/{accountId}":              {"accountId": awsAccounts},
+			"v1://cam/alibaba/{accountId}":          {"accountId": alibabaAccounts},
+			"v1://cam/gcp/{projectId}":              {"projectId": gcpProjects},
+			"v1://container/kubernetes/{clusterId}": {"clusterId": k8Clusters},
+			"v1://container/ecs/{clusterId}":        {"clusterId": ecsClusters},
+		},
+		arguments: map[string]Completer{
+			"alertId":                  alerts,
+			"agentGuid":                endpoints,
+			"cloudPostureChecksFilter": FilterCompleter(tooldescriptions.FilterCloudPostureChecks),
+		},
+	}
+}
+
+// Complete returns the completion result of a completion/complete request.
+// Arguments without a completer have no completion values.
+func (c *Completions) Complete(params mcp.CompleteParams) (*mcp.CompleteResult, error) {
+	ref := struct {
+		Type string `json:"type"`
+		Name string `json:"name"`
+		URI  string `json:"uri"`
+	}{}
+
+	b, err := json.Marshal(params.Ref)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(b, &ref); err != nil {
+		return nil, fmt.Errorf("invalid ref: %w", err)
+	}
+
+	var completer Completer
+	switch ref.Type {
+	case refPrompt:
+		completer = c.prompts[ref.Name][params.Argument.Name]
+		if completer == nil {
+			completer = c.arguments[params.Argument.Name]
+		}
+	case refResource:
+		completer = c.resources[ref.URI][params.Argument.Name]
+	default:
+		return nil, fmt.Errorf("unsupported ref type %q", ref.Type)
+	}
+
+	result := &mcp.CompleteResult{}
+	result.Completion.Values = []string{}
+
+	if completer == nil {
+		return result, nil
+	}
+
+	values, err := completer(params.Argument.Value)
+	if err != nil {
+		return nil, err
+	}
+
+	result.Completion.Total = len(values)
+	if len(values) > maxValues {
+		values = values[:maxValues]
+		result.Completion.HasMore = true
+	}
+	result.Completion.Values = values
+
+	return result, nil
+}
+
+// option is a completion value and the labels it can also be found by,
+// e.g. the ID of an account and its name.
+type option struct {
+	value  string
+	labels []string
+}
+
+func (o option) matches(value string) bool {
+	value = strings.ToLower(value)
+	if strings.HasPrefix(strings.ToLower(o.value), value) {
+		return true
+	}
+	for _, label := range o.labels {
+		if strings.Contains(strings.ToLower(label), value) {
+			return true
+		}
+	}
+	return false
+}
+
+// cachedOptions lists options at most once per cacheTTL.
+type cachedOptions struct {
+	mu      sync.Mutex
+	load    func() ([]option, error)
+	options []option
+	expires time.Time
+}
+
+func (c *cachedOptions) get() ([]option, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if time.Now().Before(c.expires) {
+		return c.options, nil
+	}
+
+	options, err := c.load()
+	if err != nil {
+		return nil, err
+	}
+
+	c.options = options
+	c.expires = time.Now().Add(cacheTTL)
+	return options, nil
+}
+
+// listCompleter completes the valueField of the items of a list response.
+// Values are also matched by the labelFields.
+func listCompleter(list func() (*http.Response, error), valueField string, labelFields ...string) Completer {
+	cache := &cachedOptions{
+		load: func() ([]option, error) {
+			items, err := listItems(list())
+			if err != nil {
+				return nil, err
+			}
+
+			options := []option{}
+			for _, item := range items {
+				value, ok := item[valueField].(string)
+				if !ok || value == "" {
+					continue
+				}
+
+				o := option{value: value}
+				for _, field := range labelFields {
+					if label, ok := item[field].(string); ok {
+						o.labels = append(o.labels, label)
+					}
+				}
+				options = append(options, o)
+			}
+			return options, nil
+		},
+	}
+
+	return func(value string) ([]string, error) {
+		options, err := cache.get()
+		if err != nil {
+			return nil, err
+		}
+
+		values := []string{}
+		for _, o := range options {
+			if o.matches(value) {
+				values = append(values, o.value)
+			}
+		}
+		return values, nil
+	}
+}
+
+func listItems(resp *http.Response, err error) ([]map[string]any, error) {
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %d: %s", resp.StatusCode, string(body))
+	}
+
+	page := struct {
+		Items []map[string]any `json:"items"`
+	}{}
+	if err := json.Unmarshal(body, &page); err != nil {
+		return nil, err
+	}
+
+	return page.Items, nil
+}
+
+var (
+	// filterValueRegexp matches a filter ending with an unterminated value of a field.
+	filterValueRegexp = regexp.MustCompile(`([A-Za-z][A-Za-z0-9_./]*)\s+eq\s+'([^']*)$`)
+	// filterTokenRegexp matches the last, possibly partial, token of a filter.
+	filterTokenRegexp = regexp.MustCompile(`[A-Za-z0-9_./]*$`)
+)
+
+// FilterCompleter completes the field names and values of a filter using
+// its supported fields. The whole filter is returned with its last token
+// completed.
+func FilterCompleter(filter tooldescriptions.Filter) Completer {
+	fields := filter.Fields
+
+	return func(value string) ([]string, error) {
+		values := []string{}
+
+		if m := filterValueRegexp.FindStringSubmatchIndex(value); m != nil {
+			name := value[m[2]:m[3]]
+			partial := strings.ToLower(value[m[4]:m[5]])
+			prefix := value[:m[4]]
+
+			for _, field := range fields {
+				if field.Name != name {
+					continue
+				}
+				for _, v := range field.Values {
+					if strings.HasPrefix(strings.ToLower(v), partial) {
+						values = append(values, prefix+v+"'")
+					}
+				}
+			}
+			return values, nil
+		}
+
+		loc := filterTokenRegexp.FindStringIndex(value)
+		prefix, token := value[:loc[0]], strings.ToLower(value[loc[0]:])
+
+		for _, field := range fields {
+			if field.Deprecated {
+				continue
+			}
+			if strings.HasPrefix(strings.ToLower(field.Name), token) {
+				values = append(values, prefix+field.Name)
+			}
+		}
+		return values, nil
+	}
+}
@@ -0,0 +1,109 @@
+package completion
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+	"github.com/trendmicro/vision-one-mcp-server/internal/v1mcp/tooldescriptions"
+)
+
+func completeParams(ref any, name, value string) mcp.CompleteParams {
+	params := mcp.CompleteParams{Ref: ref}
+	params.Argument.Name = name
+	params.Argument.Value = value
+	return params
+}
+
+func TestComplete(t *testing.T) {
+	many := []string{}
+	for i := range 150 {
+		many = append(many, fmt.Sprintf("value-%03d", i))
+	}
+
+	c := &Completions{
+		prompts: map[string]map[string]Completer{
+			"review": {"accountId": func(string) ([]string, error) { return []string{"prompt"}, nil }},
+		},
+		resources: map[string]map[string]Completer{
+			"v1://cam/aws/{accountId}": {"accountId": func(string) ([]string, error) { return many, nil }},
+		},
+		arguments: map[string]Completer{
+			"alertId": func(v string) ([]string, error) { return []string{v + "-argument"}, nil },
+		},
+	}
+
+	result, err := c.Complete(completeParams(mcp.PromptReference{Type: refPrompt, Name: "review"}, "accountId", ""))
+	require.NoError(t, err)
+	require.Equal(t, []string{"prompt"}, result.Completion.Values)
+
+	result, err = c.Complete(completeParams(map[string]any{"type": refPrompt, "name": "custom"}, "alertId", "WB"))
+	require.NoError(t, err)
+	require.Equal(t, []string{"WB-argument"}, result.Completion.Values)
+
+	result, err = c.Complete(completeParams(mcp.ResourceReference{Type: refResource, URI: "v1://cam/aws/{accountId}"}, "accountId", ""))
+	require.NoError(t, err)
+	require.Len(t, result.Completion.Values, maxValues)
+	require.Equal(t, 150, result.Completion.Total)
+	require.True(t, result.Completion.HasMore)
+
+	result, err = c.Complete(completeParams(mcp.ResourceReference{Type: refResource, URI: "v1://unknown/{id}"}, "id", ""))
+	require.NoError(t, err)
+	require.Equal(t, []string{}, result.Completion.Values)
+
+	_, err = c.Complete(completeParams(map[string]any{"type": "ref/unknown"}, "id", ""))
+	require.Error(t, err)
+}
+
+func TestListCompleter(t *testing.T) {
+	calls := 0
+	completer := listCompleter(func() (*http.Response, error) {
+		calls++
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body: io.NopCloser(strings.NewReader(`{"items": [
+				{"id": "123456789012", "name": "Production"},
+				{"id": "210987654321", "name": "Staging"},
+				{"name": "No ID"}
+			]}`)),
+		}, nil
+	}, "id", "name")
+
+	values, err := completer("1234")
+	require.NoError(t, err)
+	require.Equal(t, []string{"123456789012"}, values)
+
+	values, err = completer("stag")
+	require.NoError(t, err)
+	require.Equal(t, []string{"210987654321"}, values)
+
+	values, err = completer("")
+	require.NoError(t, err)
+	require.Len(t, values, 2)
+
+	require.Equal(t, 1, calls, "the list should be cached")
+}
+
+func TestFilterCompleter(t *testing.T) {
+	completer := FilterCompleter(tooldescriptions.FilterWorkbenchAlerts)
+
+	tests := map[string][]string{
+		"":                                    {"id", "status", "investigationResult", "alertProvider", "modelId", "model", "modelType", "severity", "impactScopeEntityValue", "indicatorValue", "incidentId"},
+		"sev":                                 {"severity"},
+		"status eq 'Open' and (mod":           {"status eq 'Open' and (model", "status eq 'Open' and (modelId", "status eq 'Open' and (modelType"},
+		"severity eq '":                       {"severity eq 'critical'", "severity eq 'high'", "severity eq 'medium'", "severity eq 'low'"},
+		"status eq 'Open' and severity eq 'h": {"status eq 'Open' and severity eq 'high'"},
+		"model eq 'a":                         {},
+		"unknown":                             {},
+	}
+
+	for value, expected := range tests {
+		actual, err := completer(value)
+		require.NoError(t, err)
+		require.ElementsMatch(t, expected, actual, "value %q", value)
+	}
+}
@@ -0,0 +1,176 @@
+package v1mcp
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"sync"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	"github.com/trendmicro/vision-one-mcp-server/internal/v1mcp/completion"
+)
+
+const (
+	methodInitialize = "initialize"
+	methodComplete   = "completion/complete"
+)
+
+// completionsStdio answers completion/complete requests, which the MCP
+// library does not handle, and passes every other message through to the
+// stdio server. The completions capability is added to the initialize
+// response so clients know they can send completion requests.
+type completionsStdio struct {
+	completions *completion.Completions
+	out         *messageWriter
+}
+
+// listenWithCompletions runs the stdio server with completion support.
+func listenWithCompletions(
+	ctx context.Context,
+	stdioServer *mcpserver.StdioServer,
+	completions *completion.Completions,
+	stdin io.Reader,
+	stdout io.Writer,
+) error {
+	c := &completionsStdio{
+		completions: completions,
+		out:         &messageWriter{w: stdout},
+	}
+
+	serverIn, forward := io.Pipe()
+	go func() {
+		_ = forward.CloseWithError(c.readMessages(stdin, forward))
+	}()
+
+	return stdioServer.Listen(ctx, serverIn, c.out)
+}
+
+// readMessages reads the messages sent by the client. Completion requests are
+// answered, every other message is forwarded to the stdio server.
+func (c *completionsStdio) readMessages(stdin io.Reader, forward io.Writer) error {
+	reader := bufio.NewReader(stdin)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if len(line) > 0 {
+			message := struct {
+				ID     *mcp.RequestId  `json:"id"`
+				Method string          `json:"method"`
+				Params json.RawMessage `json:"params"`
+			}{}
+			_ = json.Unmarshal(line, &message)
+
+			switch {
+			case message.Method == methodComplete && message.ID != nil:
+				go c.complete(*message.ID, message.Params)
+				line = nil
+			case message.Method == methodInitialize && message.ID != nil:
+				c.out.addCompletionsCapability(*message.ID)
+			}
+
+			if line != nil {
+				if _, err := forward.Write(line); err != nil {
+					return err
+				}
+			}
+		}
+
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (c *completionsStdio) complete(id mcp.RequestId, raw json.RawMessage) {
+	var response any
+
+	params := mcp.CompleteParams{}
+	if err := json.Unmarshal(raw, &params); err != nil {
+		response = mcp.NewJSONRPCError(id, mcp.INVALID_PARAMS, err.Error(), nil)
+	} else if result, err := c.completions.Complete(params); err != nil {
+		response = mcp.NewJSONRPCError(id, mcp.INTERNAL_ERROR, err.Error(), nil)
+	} else {
+		response = mcp.NewJSONRPCResultResponse(id, result)
+	}
+
+	b, err := json.Marshal(response)
+	if err != nil {
+		return
+	}
+	_, _ = c.out.Write(append(b, '\n'))
+}
+
+// messageWriter writes newline delimited messages from concurrent writers
+// without interleaving them.
+type messageWriter struct {
+	mu  sync.Mutex
+	w   io.Writer
+	buf []byte
+
+	// initializeID is the ID of the initialize request whose response still
+	// needs the completions capability.
+	initializeID *mcp.RequestId
+}
+
+func (m *messageWriter) addCompletionsCapability(id mcp.RequestId) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.initializeID = &id
+}
+
+func (m *messageWriter) Write(p []byte) (int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.buf = append(m.buf, p...)
+	for {
+		i := bytes.IndexByte(m.buf, '\n')
+		if i < 0 {
+			return len(p), nil
+		}
+
+		line := m.patchInitializeResponse(m.buf[:i+1])
+		if _, err := m.w.Write(line); err != nil {
+			return 0, err
+		}
+		m.buf = m.buf[i+1:]
+	}
+}
+
+// patchInitializeResponse adds the completions capability to the response
+// of the initialize request. Other messages are returned unchanged.
+func (m *messageWriter) patchInitializeResponse(line []byte) []byte {
+	if m.initializeID == nil {
+		return line
+	}
+
+	message := struct {
+		JSONRPC string         `json:"jsonrpc"`
+		ID      *mcp.RequestId `json:"id"`
+		Result  map[string]any `json:"result"`
+	}{}
+	if err := json.Unmarshal(line, &message); err != nil || message.ID == nil || message.Result == nil {
+		return line
+	}
+	if message.ID.String() != m.initializeID.String() {
+		return line
+	}
+	m.initializeID = nil
+
+	capabilities, ok := message.Result["capabilities"].(map[string]any)
+	if !ok {
+		return line
+	}
+	capabilities["completions"] = struct{}{}
+
+	b, err := json.Marshal(message)
+	if err != nil {
+		return line
+	}
+	return append(b, '\n')
+}
@@ -0,0 +1,74 @@
+package v1mcp
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"testing"
+	"time"
+
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	"github.com/stretchr/testify/require"
+	"github.com/trendmicro/vision-one-mcp-server/internal/v1mcp/completion"
+)
+
+func TestListenWithCompletions(t *testing.T) {
+	cfg := ServerConfig{Region: "us"}
+	client, err := newClient(cfg, nil)
+	require.NoError(t, err)
+
+	s, err := newMcpServer(cfg, client, nil)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stdin, clientOut := io.Pipe()
+	clientIn, stdout := io.Pipe()
+
+	go func() {
+		_ = listenWithCompletions(ctx, mcpserver.NewStdioServer(s), completion.New(client), stdin, stdout)
+	}()
+
+	responses := bufio.NewReader(clientIn)
+	roundTrip := func(request string) map[string]any {
+		_, err := clientOut.Write([]byte(request + "\n"))
+		require.NoError(t, err)
+
+		done := make(chan []byte)
+		go func() {
+			line, _ := responses.ReadBytes('\n')
+			done <- line
+		}()
+
+		select {
+		case line := <-done:
+			response := map[string]any{}
+			require.NoError(t, json.Unmarshal(line, &response))
+			return response
+		case <-time.After(5 * time.Second):
+			t.Fatalf("no response to %s", request)
+			return nil
+		}
+	}
+
+	response := roundTrip(`{"jsonrpc":"2.0","id":1,"method":"initialize","params":{"protocolVersion":"2025-06-18","capabilities":{},"clientInfo":{"name":"test","version":"1"}}}`)
+	capabilities := response["result"].(map[string]any)["capabilities"].(map[string]any)
+	require.Contains(t, capabilities, "completions")
+	require.Contains(t, capabilities, "tools")
+
+	response = roundTrip(`{"jsonrpc":"2.0","id":"complete-1","method":"completion/complete","params":{"ref":{"type":"ref/prompt","name":"expired_api_key_cleanup"},"argument":{"name":"unknown","value":""}}}`)
+	require.Equal(t, "complete-1", response["id"])
+	require.Equal(t, []any{}, response["result"].(map[string]any)["completion"].(map[string]any)["values"])
+
+	response = roundTrip(`{"jsonrpc":"2.0","id":"complete-2","method":"completion/complete","params":{"ref":{"type":"ref/prompt","name":"cloud_posture_failed_checks"},"argument":{"name":"cloudPostureChecksFilter","value":"riskL"}}}`)
+	require.Equal(t, []any{"riskLevel"}, response["result"].(map[string]any)["completion"].(map[string]any)["values"])
+
+	response = roundTrip(`{"jsonrpc":"2.0","id":2,"method":"completion/complete","params":{"ref":{"type":"ref/unknown"},"argument":{"name":"id","value":""}}}`)
+	require.Contains(t, response, "error")
+
+	response = roundTrip(`{"jsonrpc":"2.0","id":3,"method":"ping"}`)
+	require.Equal(t, float64(3), response["id"])
+	require.Contains(t, response, "result")
+}
@@ -0,0 +1,134 @@
+package v1mcp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	"github.com/trendmicro/vision-one-mcp-server/internal/v1client"
+)
+
+// errDryRun is returned by the dry run transport instead of a response.
+var errDryRun = errors.New("dry run, the request was not sent to Vision One")
+
+const dryRunDescription = "\n\nDry run: the server runs in dry run mode, this tool returns the requests it would send to Vision One without sending them."
+
+// dryRunRequest is a request a write tool would have sent.
+type dryRunRequest struct {
+	Method  string              `json:"method"`
+	URL     string              `json:"url"`
+	Headers map[string][]string `json:"headers"`
+	Body    json.RawMessage     `json:"body,omitempty"`
+}
+
+type dryRunResult struct {
+	DryRun   bool            `json:"dryRun"`
+	Requests []dryRunRequest `json:"requests"`
+}
+
+// dryRunTransport records the requests that change Vision One and fails
+// them with errDryRun. Requests that only read, such as the lookups of
+// existing indicators before a bulk add, are sent with next.
+type dryRunTransport struct {
+	next http.RoundTripper
+
+	mu       sync.Mutex
+	requests []dryRunRequest
+}
+
+func (t *dryRunTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	if r.Method == http.MethodGet || r.Method == http.MethodHead {
+		next := t.next
+		if next == nil {
+			next = http.DefaultTransport
+		}
+		return next.RoundTrip(r)
+	}
+
+	request := dryRunRequest{
+		Method:  r.Method,
+		URL:     r.URL.String(),
+		Headers: map[string][]string{},
+	}
+	for name, values := range r.Header {
+		if strings.EqualFold(name, "Authorization") {
+			values = []string{"Bearer " + auditRedacted}
+		}
+		request.Headers[name] = values
+	}
+
+	if r.Body != nil {
+		b, err := io.ReadAll(r.Body)
+		_ = r.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		if len(b) > 0 {
+			if !json.Valid(b) {
+				b, _ = json.Marshal(string(b))
+			}
+			request.Body = b
+		}
+	}
+
+	t.mu.Lock()
+	t.requests = append(t.requests, request)
+	t.mu.Unlock()
+
+	return nil, errDryRun
+}
+
+// dryRunner builds the dry run versions of write tools.
+type dryRunner struct {
+	cfg ServerConfig
+	// transport sends the requests of dry run tools that only read.
+	transport http.RoundTripper
+}
+
+// tool returns tool in dry run mode. Each call runs the handler of getTool
+// with a client that records the requests that change Vision One instead of
+// sending them, so arguments are validated as usual. The recorded requests
+// are returned, with the API key redacted. When the tool sends nothing,
+// for instance because an argument is invalid, its own result is returned.
+func (d dryRunner) tool(tool mcpserver.ServerTool, getTool func(*v1client.V1ApiClient) mcpserver.ServerTool) mcpserver.ServerTool {
+	tool.Tool.Description += dryRunDescription
+	tool.Tool.Annotations.DestructiveHint = toPtr(false)
+	// The result of a dry run never matches the output schema of the tool.
+	tool.Tool.OutputSchema = mcp.ToolOutputSchema{}
+	tool.Tool.RawOutputSchema = nil
+
+	tool.Handler = func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		transport := &dryRunTransport{next: d.transport}
+		client, err := newClientWithTransport(d.cfg, transport)
+		if err != nil {
+			return nil, err
+		}
+
+		result, err := getTool(client).Handler(ctx, request)
+		if len(transport.requests) == 0 {
+			return result, err
+		}
+
+		dryRun := dryRunResult{DryRun: true, Requests: transport.requests}
+		b, err := json.MarshalIndent(dryRun, "", "  ")
+		if err != nil {
+			return nil, err
+		}
+		return mcp.NewToolResultText(fmt.Sprintf(
+			"Dry run, nothing was changed in Vision One. %s would send these requests:\n%s",
+			request.Params.Name, string(b),
+		)), nil
+	}
+	return tool
+}
+
+func toPtr[T any](t T) *T {
+	return &t
+}
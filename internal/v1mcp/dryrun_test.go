@@ -0,0 +1,94 @@
+package v1mcp
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+)
+
+func callToolResult(t *testing.T, handle func(context.Context, json.RawMessage) mcp.JSONRPCMessage, name string, arguments map[string]any) *mcp.CallToolResult {
+	t.Helper()
+	b, err := json.Marshal(map[string]any{
+		"jsonrpc": "2.0",
+		"id":      1,
+		"method":  "tools/call",
+		"params":  map[string]any{"name": name, "arguments": arguments},
+	})
+	require.NoError(t, err)
+
+	response, ok := handle(context.Background(), b).(mcp.JSONRPCResponse)
+	require.True(t, ok)
+	result, ok := response.Result.(mcp.CallToolResult)
+	require.True(t, ok)
+	return &result
+}
+
+func TestDryRun(t *testing.T) {
+	s, err := NewMcpServer(ServerConfig{Region: "us", ApiKey: "my-api-key", ReadOnly: false, DryRun: true, AuditLog: filepath.Join(t.TempDir(), "audit.jsonl")})
+	require.NoError(t, err)
+
+	t.Run("should return the request instead of sending it", func(t *testing.T) {
+		result := callToolResult(t, s.HandleMessage, "iam_api_keys_delete", map[string]any{"apiKeyIds": []any{"a1", "b2"}})
+		require.False(t, result.IsError)
+
+		text := result.Content[0].(mcp.TextContent).Text
+		require.True(t, strings.HasPrefix(text, "Dry run, nothing was changed in Vision One. iam_api_keys_delete would send these requests:\n"))
+		require.NotContains(t, text, "my-api-key")
+
+		dryRun := dryRunResult{}
+		require.NoError(t, json.Unmarshal([]byte(text[strings.Index(text, "\n")+1:]), &dryRun))
+		require.True(t, dryRun.DryRun)
+		require.Len(t, dryRun.Requests, 1)
+
+		request := dryRun.Requests[0]
+		require.Equal(t, http.MethodPost, request.Method)
+		require.Equal(t, "https://api.xdr.trendmicro.com/v3.0/iam/apiKeys/delete", request.URL)
+		require.Equal(t, []string{"Bearer [REDACTED]"}, request.Headers["Authorization"])
+		require.Equal(t, []string{"application/json"}, request.Headers["Content-Type"])
+		require.JSONEq(t, `[{"id":"a1"},{"id":"b2"}]`, string(request.Body))
+	})
+
+	t.Run("should validate arguments", func(t *testing.T) {
+		result := callToolResult(t, s.HandleMessage, "iam_account_delete", map[string]any{})
+		require.True(t, result.IsError)
+		require.NotContains(t, result.Content[0].(mcp.TextContent).Text, "Dry run")
+	})
+
+	t.Run("should only change write tools", func(t *testing.T) {
+		write := s.GetTool("iam_api_keys_delete").Tool
+		require.True(t, strings.HasSuffix(write.Description, dryRunDescription))
+		require.Empty(t, write.OutputSchema.Type)
+
+		read := s.GetTool("iam_api_keys_list").Tool
+		require.NotContains(t, read.Description, dryRunDescription)
+	})
+}
+
+func TestDryRunTransport(t *testing.T) {
+	sent := []string{}
+	transport := &dryRunTransport{next: roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		sent = append(sent, r.Method)
+		return httptest.NewRecorder().Result(), nil
+	})}
+
+	_, err := transport.RoundTrip(httptest.NewRequest(http.MethodGet, "https://api.xdr.trendmicro.com/v3.0/threatintel/suspiciousObjects", nil))
+	require.NoError(t, err)
+
+	_, err = transport.RoundTrip(httptest.NewRequest(http.MethodDelete, "https://api.xdr.trendmicro.com/v3.0/iam/accounts/1", nil))
+	require.ErrorIs(t, err, errDryRun)
+
+	_, err = transport.RoundTrip(httptest.NewRequest(http.MethodPost, "https://api.xdr.trendmicro.com/v3.0/echo", strings.NewReader("not json")))
+	require.ErrorIs(t, err, errDryRun)
+
+	require.Equal(t, []string{http.MethodGet}, sent)
+	require.Len(t, transport.requests, 2)
+	require.Empty(t, transport.requests[0].Body)
+	require.Equal(t, `"not json"`, string(transport.requests[1].Body))
+}
@@ -0,0 +1,448 @@
+// Package filter parses and validates the OData like filter expressions of
+// the Vision One API, for example
+//
+//	severity eq 'high' and not (status eq 'Closed' or contains(model, 'Login'))
+package filter
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Error is a problem of a filter at a position of the filter. Positions
+// start at 1.
+type Error struct {
+	Pos int
+	Msg string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s (at position %d)", e.Msg, e.Pos)
+}
+
+// Comparison operators.
+const (
+	OpEq = "eq"
+	OpNe = "ne"
+	OpGt = "gt"
+	OpGe = "ge"
+	OpLt = "lt"
+	OpLe = "le"
+	OpIn = "in"
+)
+
+// Logical operators.
+const (
+	OpAnd = "and"
+	OpOr  = "or"
+)
+
+// Functions.
+const (
+	FuncContains   = "contains"
+	FuncStartsWith = "startswith"
+	FuncEndsWith   = "endswith"
+	FuncHasSubset  = "hassubset"
+)
+
+var (
+	comparisonOperators = []string{OpEq, OpNe, OpGt, OpGe, OpLt, OpLe, OpIn}
+	functions           = []string{FuncContains, FuncStartsWith, FuncEndsWith, FuncHasSubset}
+)
+
+// Expr is a node of a parsed filter.
+type Expr interface {
+	// String formats the expression in the filter syntax.
+	String() string
+}
+
+// Field is a field name of a filter.
+type Field struct {
+	Name string
+	Pos  int
+}
+
+// ValueKind is the type of a literal value.
+type ValueKind int
+
+const (
+	StringValue ValueKind = iota
+	NumberValue
+	BoolValue
+	NullValue
+)
+
+// Value is a literal value. Text is the unquoted text of strings.
+type Value struct {
+	Kind ValueKind
+	Text string
+	Pos  int
+}
+
+func (v Value) String() string {
+	if v.Kind == StringValue {
+		return "'" + strings.ReplaceAll(v.Text, "'", "''") + "'"
+	}
+	return v.Text
+}
+
+// Logical joins its operands with and or or.
+type Logical struct {
+	Op       string
+	Operands []Expr
+}
+
+func (e *Logical) String() string {
+	operands := []string{}
+	for _, operand := range e.Operands {
+		if logical, ok := operand.(*Logical); ok && logical.Op != e.Op {
+			operands = append(operands, "("+operand.String()+")")
+		} else {
+			operands = append(operands, operand.String())
+		}
+	}
+	return strings.Join(operands, " "+e.Op+" ")
+}
+
+// Not negates its operand.
+type Not struct {
+	Operand Expr
+}
+
+func (e *Not) String() string {
+	if _, ok := e.Operand.(*Logical); ok {
+		return "not (" + e.Operand.String() + ")"
+	}
+	return "not " + e.Operand.String()
+}
+
+// Group is an expression in parentheses.
+type Group struct {
+	Expr Expr
+}
+
+func (e *Group) String() string {
+	return "(" + e.Expr.String() + ")"
+}
+
+// Comparison compares a field to a value, or to a list of values with the
+// in operator.
+type Comparison struct {
+	Field  Field
+	Op     string
+	Values []Value
+}
+
+func (e *Comparison) String() string {
+	if e.Op == OpIn {
+		return e.Field.Name + " in (" + joinValues(e.Values) + ")"
+	}
+	return e.Field.Name + " " + e.Op + " " + e.Values[0].String()
+}
+
+// Call is a function applied to a field, such as contains(name, 'lab') or
+// hassubset(discoveredBy, ['a', 'b']).
+type Call struct {
+	Function string
+	Pos      int
+	Field    Field
+	Values   []Value
+	// List is true when the values are given as a list in brackets.
+	List bool
+}
+
+func (e *Call) String() string {
+	if e.List {
+		return e.Function + "(" + e.Field.Name + ", [" + joinValues(e.Values) + "])"
+	}
+	return e.Function + "(" + e.Field.Name + ", " + joinValues(e.Values) + ")"
+}
+
+// Any matches an array field with an element matching the predicate, such as
+// tags/any(tag: tag eq 'production').
+type Any struct {
+	Field     Field
+	Variable  string
+	Predicate Expr
+}
+
+func (e *Any) String() string {
+	return e.Field.Name + "/any(" + e.Variable + ": " + e.Predicate.String() + ")"
+}
+
+func joinValues(values []Value) string {
+	texts := []string{}
+	for _, v := range values {
+		texts = append(texts, v.String())
+	}
+	return strings.Join(texts, ", ")
+}
+
+// Parse parses a filter. The operators and functions are not case sensitive.
+func Parse(filter string) (Expr, error) {
+	tokens, err := tokenize(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parser{tokens: tokens}
+	expr, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if t := p.peek(); t.kind != tokenEOF {
+		if t.kind == tokenRParen {
+			return nil, &Error{Pos: t.pos, Msg: "unexpected ')' without a matching '('"}
+		}
+		return nil, &Error{Pos: t.pos, Msg: fmt.Sprintf("unexpected %s, expected and, or or the end of the filter", t)}
+	}
+	return expr, nil
+}
+
+type parser struct {
+	tokens []token
+	next   int
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.next]
+}
+
+func (p *parser) peekAt(n int) token {
+	return p.tokens[min(p.next+n, len(p.tokens)-1)]
+}
+
+func (p *parser) advance() token {
+	t := p.tokens[p.next]
+	if t.kind != tokenEOF {
+		p.next++
+	}
+	return t
+}
+
+func (p *parser) expect(kind tokenKind, what string) (token, error) {
+	t := p.advance()
+	if t.kind != kind {
+		return t, &Error{Pos: t.pos, Msg: fmt.Sprintf("expected %s, found %s", what, t)}
+	}
+	return t, nil
+}
+
+func (p *parser) parseOr() (Expr, error) {
+	return p.parseLogical(OpOr, p.parseAnd)
+}
+
+func (p *parser) parseAnd() (Expr, error) {
+	return p.parseLogical(OpAnd, p.parseUnary)
+}
+
+func (p *parser) parseLogical(op string, parseOperand func() (Expr, error)) (Expr, error) {
+	operand, err := parseOperand()
+	if err != nil {
+		return nil, err
+	}
+
+	operands := []Expr{operand}
+	for p.peek().isKeyword(op) {
+		p.advance()
+		operand, err := parseOperand()
+		if err != nil {
+			return nil, err
+		}
+		operands = append(operands, operand)
+	}
+
+	if len(operands) == 1 {
+		return operands[0], nil
+	}
+	return &Logical{Op: op, Operands: operands}, nil
+}
+
+func (p *parser) parseUnary() (Expr, error) {
+	if p.peek().isKeyword("not") {
+		p.advance()
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &Not{Operand: operand}, nil
+	}
+	return p.parsePrimary()
+}
+
+func (p *parser) parsePrimary() (Expr, error) {
+	t := p.peek()
+	switch {
+	case t.kind == tokenLParen:
+		p.advance()
+		expr, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if closing := p.advance(); closing.kind != tokenRParen {
+			return nil, &Error{Pos: closing.pos, Msg: fmt.Sprintf("expected ')' to close the '(' at position %d, found %s", t.pos, closing)}
+		}
+		return &Group{Expr: expr}, nil
+	case t.kind == tokenIdent && p.peekAt(1).kind == tokenLParen:
+		return p.parseCall()
+	case t.kind == tokenIdent && p.peekAt(1).kind == tokenSlash:
+		return p.parseAny()
+	case t.kind == tokenIdent && !t.isKeyword(OpAnd) && !t.isKeyword(OpOr):
+		return p.parseComparison()
+	case t.kind == tokenEOF:
+		return nil, &Error{Pos: t.pos, Msg: "unexpected end of the filter, expected a condition such as name eq 'value'"}
+	default:
+		return nil, &Error{Pos: t.pos, Msg: fmt.Sprintf("expected a field name, a function or '(', found %s", t)}
+	}
+}
+
+func (p *parser) parseComparison() (Expr, error) {
+	name := p.advance()
+	field := Field{Name: name.text, Pos: name.pos}
+
+	op := p.advance()
+	if op.kind != tokenIdent || !slices.Contains(comparisonOperators, strings.ToLower(op.text)) {
+		msg := fmt.Sprintf("expected an operator after %s, found %s. The operators are %s", field.Name, op, strings.Join(comparisonOperators, ", "))
+		if s := suggest(op.text, comparisonOperators); op.kind == tokenIdent && s != "" {
+			msg = fmt.Sprintf("unknown operator %s after %s, did you mean %s?", op, field.Name, s)
+		}
+		return nil, &Error{Pos: op.pos, Msg: msg}
+	}
+
+	comparison := &Comparison{Field: field, Op: strings.ToLower(op.text)}
+	if comparison.Op != OpIn {
+		value, err := p.parseValue(field.Name + " " + comparison.Op)
+		if err != nil {
+			return nil, err
+		}
+		comparison.Values = []Value{value}
+		return comparison, nil
+	}
+
+	values, err := p.parseValues(tokenLParen, tokenRParen, field.Name+" in")
+	if err != nil {
+		return nil, err
+	}
+	comparison.Values = values
+	return comparison, nil
+}
+
+func (p *parser) parseCall() (Expr, error) {
+	name := p.advance()
+	function := strings.ToLower(name.text)
+	if !slices.Contains(functions, function) {
+		msg := fmt.Sprintf("unknown function %s. The functions are %s", name.text, strings.Join(functions, ", "))
+		if s := suggest(name.text, functions); s != "" {
+			msg = fmt.Sprintf("unknown function %s, did you mean %s?", name.text, s)
+		}
+		return nil, &Error{Pos: name.pos, Msg: msg}
+	}
+	p.advance()
+
+	fieldName, err := p.expect(tokenIdent, fmt.Sprintf("a field name as the first argument of %s", function))
+	if err != nil {
+		return nil, err
+	}
+	if _, err := p.expect(tokenComma, fmt.Sprintf("',' after the field of %s", function)); err != nil {
+		return nil, err
+	}
+
+	call := &Call{Function: function, Pos: name.pos, Field: Field{Name: fieldName.text, Pos: fieldName.pos}}
+	if function == FuncHasSubset {
+		call.List = true
+		if call.Values, err = p.parseValues(tokenLBracket, tokenRBracket, function); err != nil {
+			return nil, err
+		}
+	} else {
+		value, err := p.parseValue(function)
+		if err != nil {
+			return nil, err
+		}
+		if value.Kind != StringValue {
+			return nil, &Error{Pos: value.Pos, Msg: fmt.Sprintf("%s expects a quoted string, found %s", function, value)}
+		}
+		call.Values = []Value{value}
+	}
+
+	if _, err := p.expect(tokenRParen, fmt.Sprintf("')' to close %s", function)); err != nil {
+		return nil, err
+	}
+	return call, nil
+}
+
+func (p *parser) parseAny() (Expr, error) {
+	name := p.advance()
+	p.advance()
+
+	lambda := p.advance()
+	if !lambda.isKeyword("any") {
+		return nil, &Error{Pos: lambda.pos, Msg: fmt.Sprintf("expected any after %s/, found %s", name.text, lambda)}
+	}
+	if _, err := p.expect(tokenLParen, "'(' after any"); err != nil {
+		return nil, err
+	}
+	variable, err := p.expect(tokenIdent, "a variable name such as tag")
+	if err != nil {
+		return nil, err
+	}
+	if _, err := p.expect(tokenColon, fmt.Sprintf("':' after %s", variable.text)); err != nil {
+		return nil, err
+	}
+	predicate, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := p.expect(tokenRParen, "')' to close any"); err != nil {
+		return nil, err
+	}
+
+	return &Any{Field: Field{Name: name.text, Pos: name.pos}, Variable: variable.text, Predicate: predicate}, nil
+}
+
+// parseValues parses values separated by commas between open and close.
+func (p *parser) parseValues(open, close tokenKind, after string) ([]Value, error) {
+	if _, err := p.expect(open, fmt.Sprintf("%s after %s", open, after)); err != nil {
+		return nil, err
+	}
+
+	values := []Value{}
+	for {
+		value, err := p.parseValue(after)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+
+		t := p.advance()
+		if t.kind == close {
+			return values, nil
+		}
+		if t.kind != tokenComma {
+			return nil, &Error{Pos: t.pos, Msg: fmt.Sprintf("expected ',' or %s, found %s", close, t)}
+		}
+	}
+}
+
+func (p *parser) parseValue(after string) (Value, error) {
+	t := p.advance()
+	switch t.kind {
+	case tokenString:
+		return Value{Kind: StringValue, Text: t.text, Pos: t.pos}, nil
+	case tokenNumber:
+		return Value{Kind: NumberValue, Text: t.text, Pos: t.pos}, nil
+	case tokenIdent:
+		switch strings.ToLower(t.text) {
+		case "true", "false":
+			return Value{Kind: BoolValue, Text: strings.ToLower(t.text), Pos: t.pos}, nil
+		case "null":
+			return Value{Kind: NullValue, Text: "null", Pos: t.pos}, nil
+		}
+		quoted := Value{Kind: StringValue, Text: t.text}
+		return Value{}, &Error{Pos: t.pos, Msg: fmt.Sprintf("the value %s must be quoted with single quotes: %s %s", t.text, after, quoted)}
+	case tokenEOF:
+		return Value{}, &Error{Pos: t.pos, Msg: fmt.Sprintf("missing value after %s", after)}
+	default:
+		return Value{}, &Error{Pos: t.pos, Msg: fmt.Sprintf("expected a value after %s, found %s", after, t)}
+	}
+}
@@ -0,0 +1,83 @@
+package filter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	for _, filter := range []string{
+		"severity eq 'high'",
+		"type eq 'url' and riskLevel eq 'high'",
+		"not (osName eq 'Windows') and eppAgentAntiMalwareScans eq 'enabled'",
+		"state eq 'managed' and (contains(name, 'lab') or contains(id, '123'))",
+		"(latestRiskScore ge 70) and (installedAgents eq 'Trend Vision One Agent')",
+		"hassubset(discoveredBy, ['Trend Micro Deep Security', 'Trend Vision One Agent'])",
+		"startswith(lastUser, 'john')",
+		"sweepType eq 'manual' and isHit eq true",
+		"(location eq 'Brazil' or location eq 'No specified locations') and (industry in ('Finance', 'Health'))",
+		"tags/any(tag: tag eq 'Environment::development') or tags/any(tag: contains(tag, 'Service'))",
+		"service eq 'EC2' and not riskLevels eq 'HIGH'",
+		"creatorName eq 'O''Brien'",
+	} {
+		t.Run(filter, func(t *testing.T) {
+			expr, err := Parse(filter)
+			require.NoError(t, err)
+			require.Equal(t, filter, expr.String())
+		})
+	}
+
+	t.Run("should not be case sensitive", func(t *testing.T) {
+		expr, err := Parse("type EQ 'url' AND NOT riskLevel eq 'high'")
+		require.NoError(t, err)
+		require.Equal(t, "type eq 'url' and not riskLevel eq 'high'", expr.String())
+	})
+
+	t.Run("should unquote strings", func(t *testing.T) {
+		expr, err := Parse("name eq 'it''s'")
+		require.NoError(t, err)
+		require.Equal(t, "it's", expr.(*Comparison).Values[0].Text)
+	})
+}
+
+func TestParseErrors(t *testing.T) {
+	for _, tc := range []struct {
+		filter string
+		err    string
+	}{
+		{"severity = 'high'", `unexpected character '=', use the operators eq, ne, gt, ge, lt, le, in, and, or and not (at position 10)`},
+		{"severity equals 'high'", `unknown operator equals after severity, did you mean eq? (at position 10)`},
+		{"severity eq high", `the value high must be quoted with single quotes: severity eq 'high' (at position 13)`},
+		{`severity eq "high"`, `strings must be quoted with single quotes, not " (at position 13)`},
+		{"severity eq 'high", `unterminated string, close it with ' and write a quote in the string as '' (at position 13)`},
+		{"(severity eq 'high'", `expected ')' to close the '(' at position 1, found the end of the filter (at position 20)`},
+		{"severity eq 'high')", `unexpected ')' without a matching '(' (at position 19)`},
+		{"severity eq 'high' and", `unexpected end of the filter, expected a condition such as name eq 'value' (at position 23)`},
+		{"severity eq 'high' status eq 'Open'", `unexpected status, expected and, or or the end of the filter (at position 20)`},
+		{"contain(model, 'Login')", `unknown function contain, did you mean contains? (at position 1)`},
+		{"contains(model 'Login')", `expected ',' after the field of contains, found 'Login' (at position 16)`},
+		{"hassubset(discoveredBy, 'a')", `expected '[' after hassubset, found 'a' (at position 25)`},
+		{"severity eq", `missing value after severity eq (at position 12)`},
+	} {
+		t.Run(tc.filter, func(t *testing.T) {
+			_, err := Parse(tc.filter)
+			require.EqualError(t, err, tc.err)
+		})
+	}
+}
+
+func TestLogicalString(t *testing.T) {
+	expr := &Logical{Op: OpAnd, Operands: []Expr{
+		&Comparison{Field: Field{Name: "type"}, Op: OpEq, Values: []Value{{Kind: StringValue, Text: "url"}}},
+		&Logical{Op: OpOr, Operands: []Expr{
+			&Comparison{Field: Field{Name: "riskLevel"}, Op: OpEq, Values: []Value{{Kind: StringValue, Text: "high"}}},
+			&Not{Operand: &Logical{Op: OpOr, Operands: []Expr{
+				&Call{Function: FuncContains, Field: Field{Name: "url"}, Values: []Value{{Kind: StringValue, Text: "a'b"}}},
+				&Comparison{Field: Field{Name: "score"}, Op: OpGe, Values: []Value{{Kind: NumberValue, Text: "70"}}},
+			}}},
+		}},
+	}}
+
+	require.Equal(t, "type eq 'url' and (riskLevel eq 'high' or not (contains(url, 'a''b') or score ge 70))", expr.String())
+}
@@ -0,0 +1,130 @@
+package filter
+
+import (
+	"fmt"
+	"strings"
+)
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenIdent
+	tokenString
+	tokenNumber
+	tokenLParen
+	tokenRParen
+	tokenLBracket
+	tokenRBracket
+	tokenComma
+	tokenColon
+	tokenSlash
+)
+
+var punctuation = map[byte]tokenKind{
+	'(': tokenLParen,
+	')': tokenRParen,
+	'[': tokenLBracket,
+	']': tokenRBracket,
+	',': tokenComma,
+	':': tokenColon,
+	'/': tokenSlash,
+}
+
+func (k tokenKind) String() string {
+	for c, kind := range punctuation {
+		if kind == k {
+			return fmt.Sprintf("'%c'", c)
+		}
+	}
+	return "a value"
+}
+
+type token struct {
+	kind tokenKind
+	text string
+	pos  int
+}
+
+func (t token) String() string {
+	switch t.kind {
+	case tokenEOF:
+		return "the end of the filter"
+	case tokenString:
+		return Value{Kind: StringValue, Text: t.text}.String()
+	default:
+		return t.text
+	}
+}
+
+func (t token) isKeyword(keyword string) bool {
+	return t.kind == tokenIdent && strings.EqualFold(t.text, keyword)
+}
+
+func isLetter(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c == '_'
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func tokenize(filter string) ([]token, error) {
+	tokens := []token{}
+	for i := 0; i < len(filter); {
+		c := filter[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '\'':
+			text, end, err := readString(filter, i)
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, token{kind: tokenString, text: text, pos: i + 1})
+			i = end
+		case c == '"':
+			return nil, &Error{Pos: i + 1, Msg: `strings must be quoted with single quotes, not "`}
+		case isLetter(c):
+			start := i
+			for i < len(filter) && (isLetter(filter[i]) || isDigit(filter[i]) || filter[i] == '.') {
+				i++
+			}
+			tokens = append(tokens, token{kind: tokenIdent, text: filter[start:i], pos: start + 1})
+		case isDigit(c) || c == '-' && i+1 < len(filter) && isDigit(filter[i+1]):
+			start := i
+			i++
+			for i < len(filter) && (isDigit(filter[i]) || filter[i] == '.') {
+				i++
+			}
+			tokens = append(tokens, token{kind: tokenNumber, text: filter[start:i], pos: start + 1})
+		default:
+			kind, ok := punctuation[c]
+			if !ok {
+				return nil, &Error{Pos: i + 1, Msg: fmt.Sprintf("unexpected character %q, use the operators eq, ne, gt, ge, lt, le, in, and, or and not", c)}
+			}
+			tokens = append(tokens, token{kind: kind, text: string(c), pos: i + 1})
+			i++
+		}
+	}
+	return append(tokens, token{kind: tokenEOF, pos: len(filter) + 1}), nil
+}
+
+// readString reads the string starting with the quote at start. A quote in
+// the string is escaped by doubling it.
+func readString(filter string, start int) (string, int, error) {
+	var b strings.Builder
+	for i := start + 1; i < len(filter); i++ {
+		if filter[i] != '\'' {
+			b.WriteByte(filter[i])
+			continue
+		}
+		if i+1 < len(filter) && filter[i+1] == '\'' {
+			b.WriteByte('\'')
+			i++
+			continue
+		}
+		return b.String(), i + 1, nil
+	}
+	return "", 0, &Error{Pos: start + 1, Msg: "unterminated string, close it with ' and write a quote in the string as ''"}
+}
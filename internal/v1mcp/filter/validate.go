@@ -0,0 +1,194 @@
+package filter
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/trendmicro/vision-one-mcp-server/internal/v1mcp/tooldescriptions"
+)
+
+// Validate checks the fields and values of a filter against the supported
+// fields of an endpoint. Field names and values are not case sensitive.
+// Values with a * wildcard and values of partial matches such as contains
+// are not checked. All problems are returned joined.
+func Validate(expr Expr, fields []tooldescriptions.FilterField) error {
+	v := validator{fields: fields}
+	v.walk(expr, "")
+	return errors.Join(v.errs...)
+}
+
+// Fields returns the names of the fields used by a filter, in the order
+// they first appear. The variables of any are not fields.
+func Fields(expr Expr) []string {
+	names := []string{}
+	var walk func(expr Expr, variable string)
+	add := func(field Field, variable string) {
+		if field.Name != variable && !slices.Contains(names, field.Name) {
+			names = append(names, field.Name)
+		}
+	}
+	walk = func(expr Expr, variable string) {
+		switch e := expr.(type) {
+		case *Logical:
+			for _, operand := range e.Operands {
+				walk(operand, variable)
+			}
+		case *Not:
+			walk(e.Operand, variable)
+		case *Group:
+			walk(e.Expr, variable)
+		case *Comparison:
+			add(e.Field, variable)
+		case *Call:
+			add(e.Field, variable)
+		case *Any:
+			add(e.Field, variable)
+			walk(e.Predicate, e.Variable)
+		}
+	}
+	walk(expr, "")
+	return names
+}
+
+// Check parses and validates a filter.
+func Check(filter string, fields []tooldescriptions.FilterField) error {
+	expr, err := Parse(filter)
+	if err != nil {
+		return err
+	}
+	return Validate(expr, fields)
+}
+
+type validator struct {
+	fields []tooldescriptions.FilterField
+	errs   []error
+}
+
+func (v *validator) walk(expr Expr, variable string) {
+	switch e := expr.(type) {
+	case *Logical:
+		for _, operand := range e.Operands {
+			v.walk(operand, variable)
+		}
+	case *Not:
+		v.walk(e.Operand, variable)
+	case *Group:
+		v.walk(e.Expr, variable)
+	case *Comparison:
+		if e.Field.Name == variable {
+			return
+		}
+		if field, ok := v.field(e.Field); ok && e.Op != OpGt && e.Op != OpGe && e.Op != OpLt && e.Op != OpLe {
+			v.values(field, e.Values)
+		}
+	case *Call:
+		if e.Field.Name == variable {
+			return
+		}
+		if field, ok := v.field(e.Field); ok && e.Function == FuncHasSubset {
+			v.values(field, e.Values)
+		}
+	case *Any:
+		v.field(e.Field)
+		v.walk(e.Predicate, e.Variable)
+	}
+}
+
+// field returns the supported field with the name of f, or reports an
+// unknown field.
+func (v *validator) field(f Field) (tooldescriptions.FilterField, bool) {
+	names := []string{}
+	for _, field := range v.fields {
+		if strings.EqualFold(field.Name, f.Name) {
+			return field, true
+		}
+		if !field.Deprecated {
+			names = append(names, field.Name)
+		}
+	}
+
+	msg := fmt.Sprintf("unknown field %s.", f.Name)
+	if s := suggest(f.Name, names); s != "" {
+		msg = fmt.Sprintf("unknown field %s, did you mean %s?", f.Name, s)
+	}
+	v.errs = append(v.errs, &Error{Pos: f.Pos, Msg: msg + " The supported fields are " + strings.Join(names, ", ")})
+	return tooldescriptions.FilterField{}, false
+}
+
+func (v *validator) values(field tooldescriptions.FilterField, values []Value) {
+	if len(field.Values) == 0 {
+		return
+	}
+
+	for _, value := range values {
+		if value.Kind == NullValue || value.Kind == NumberValue || strings.Contains(value.Text, "*") {
+			continue
+		}
+		if containsFold(field.Values, value.Text) {
+			continue
+		}
+
+		msg := fmt.Sprintf("unsupported value %s for %s.", value, field.Name)
+		if s := suggest(value.Text, field.Values); s != "" {
+			msg = fmt.Sprintf("unsupported value %s for %s, did you mean %s?", value, field.Name, Value{Kind: StringValue, Text: s})
+		}
+		v.errs = append(v.errs, &Error{Pos: value.Pos, Msg: msg + " The supported values are " + strings.Join(field.Values, ", ")})
+	}
+}
+
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// suggest returns the candidate closest to value, or an empty string when
+// no candidate is close enough to be a likely typo.
+func suggest(value string, candidates []string) string {
+	value = strings.ToLower(value)
+
+	best, bestDistance := "", -1
+	for _, candidate := range candidates {
+		lower := strings.ToLower(candidate)
+		distance := editDistance(value, lower)
+		if strings.HasPrefix(lower, value) || strings.HasPrefix(value, lower) ||
+			min(len(value), len(lower)) >= 3 && (strings.Contains(lower, value) || strings.Contains(value, lower)) {
+			distance = min(distance, 1)
+		}
+		if bestDistance < 0 || distance < bestDistance {
+			best, bestDistance = candidate, distance
+		}
+	}
+
+	if bestDistance < 0 || bestDistance > max(2, len(value)/3) {
+		return ""
+	}
+	return best
+}
+
+// editDistance is the Levenshtein distance of a and b.
+func editDistance(a, b string) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(b)]
+}
@@ -0,0 +1,50 @@
+package filter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/trendmicro/vision-one-mcp-server/internal/v1mcp/tooldescriptions"
+)
+
+func TestCheck(t *testing.T) {
+	alerts := tooldescriptions.FilterWorkbenchAlerts.Fields
+
+	t.Run("should accept supported fields and values", func(t *testing.T) {
+		require.NoError(t, Check("SEVERITY eq 'High' and not status eq 'Closed' and contains(model, 'anything')", alerts))
+		require.NoError(t, Check("model eq '*Login*'", alerts))
+	})
+
+	t.Run("should suggest a field", func(t *testing.T) {
+		err := Check("severty eq 'high'", alerts)
+		require.ErrorContains(t, err, "unknown field severty, did you mean severity? The supported fields are id, status, ")
+		require.ErrorContains(t, err, "(at position 1)")
+	})
+
+	t.Run("should suggest a value", func(t *testing.T) {
+		err := Check("severity eq 'hihg'", alerts)
+		require.EqualError(t, err, "unsupported value 'hihg' for severity, did you mean 'high'? The supported values are critical, high, medium, low (at position 13)")
+	})
+
+	t.Run("should return every problem", func(t *testing.T) {
+		err := Check("severity eq 'urgent' or (statuss eq 'Open' and modelType in ('preset', 'other'))", alerts)
+		require.Len(t, err.(interface{ Unwrap() []error }).Unwrap(), 3)
+	})
+
+	t.Run("should check hassubset values and the fields of any", func(t *testing.T) {
+		checks := tooldescriptions.FilterCloudPostureChecks.Fields
+		require.NoError(t, Check("hassubset(compliances, ['GDPR', 'pci']) and tags/any(tag: tag eq 'prod')", checks))
+		require.ErrorContains(t, Check("hassubset(compliances, ['GDPRR'])", checks), "did you mean 'GDPR'?")
+		require.ErrorContains(t, Check("tagz/any(tag: tag eq 'prod')", checks), "unknown field tagz, did you mean tags?")
+	})
+
+	t.Run("should return syntax errors", func(t *testing.T) {
+		require.ErrorContains(t, Check("severity eq", alerts), "missing value")
+	})
+}
+
+func TestSuggest(t *testing.T) {
+	require.Equal(t, "latestRiskScore", suggest("riskScore", []string{"id", "latestRiskScore"}))
+	require.Equal(t, "deviceName", suggest("devicename", []string{"deviceName", "deviceType"}))
+	require.Equal(t, "", suggest("owner", []string{"id", "deviceName"}))
+}
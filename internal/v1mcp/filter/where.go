@@ -0,0 +1,181 @@
+package filter
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/trendmicro/vision-one-mcp-server/internal/v1mcp/tooldescriptions"
+)
+
+// ConditionOperators are the operators of a Condition, the comparison
+// operators and the functions.
+var ConditionOperators = slices.Concat(comparisonOperators, functions)
+
+// Condition is an item of a structured filter. It is either a comparison of
+// Field and Value with Op, or a group of conditions joined with and or or.
+type Condition struct {
+	Field string      `json:"field,omitempty"`
+	Op    string      `json:"op,omitempty"`
+	Value any         `json:"value,omitempty"`
+	And   []Condition `json:"and,omitempty"`
+	Or    []Condition `json:"or,omitempty"`
+}
+
+// Compile builds the filter of conditions joined with and. The fields and
+// values are checked against the supported fields of the endpoint, field
+// names are written as the endpoint documents them and string values are
+// quoted. Problems are returned with the path of the condition such as
+// where[1].or[0].
+func Compile(conditions []Condition, fields []tooldescriptions.FilterField) (Expr, error) {
+	c := compiler{fields: fields}
+	expr := c.group(OpAnd, conditions, "where")
+	if len(c.errs) > 0 {
+		return nil, errors.Join(c.errs...)
+	}
+	return expr, nil
+}
+
+// And joins expressions with and. Expressions that are already joined with
+// and are merged instead of nested, as some endpoints reject and inside
+// parentheses.
+func And(exprs ...Expr) Expr {
+	operands := []Expr{}
+	for _, expr := range exprs {
+		if logical, ok := expr.(*Logical); ok && logical.Op == OpAnd {
+			operands = append(operands, logical.Operands...)
+		} else {
+			operands = append(operands, expr)
+		}
+	}
+	if len(operands) == 1 {
+		return operands[0]
+	}
+	return &Logical{Op: OpAnd, Operands: operands}
+}
+
+type compiler struct {
+	fields []tooldescriptions.FilterField
+	errs   []error
+}
+
+func (c *compiler) errorf(path, format string, args ...any) {
+	c.errs = append(c.errs, fmt.Errorf("%s: %s", path, fmt.Sprintf(format, args...)))
+}
+
+func (c *compiler) group(op string, conditions []Condition, path string) Expr {
+	if len(conditions) == 0 {
+		c.errorf(path, "expected at least one condition")
+		return nil
+	}
+
+	operands := []Expr{}
+	for i, condition := range conditions {
+		if expr := c.condition(condition, fmt.Sprintf("%s[%d]", path, i)); expr != nil {
+			operands = append(operands, expr)
+		}
+	}
+	if len(operands) == 1 {
+		return operands[0]
+	}
+	return &Logical{Op: op, Operands: operands}
+}
+
+func (c *compiler) condition(condition Condition, path string) Expr {
+	switch {
+	case condition.And != nil && condition.Or != nil, (condition.And != nil || condition.Or != nil) && condition.Field != "":
+		c.errorf(path, "a condition has either field, op and value, or and, or or")
+		return nil
+	case condition.And != nil:
+		return c.group(OpAnd, condition.And, path+".and")
+	case condition.Or != nil:
+		return c.group(OpOr, condition.Or, path+".or")
+	case condition.Field == "":
+		c.errorf(path, "field is required")
+		return nil
+	}
+
+	field := Field{Name: condition.Field}
+	for _, f := range c.fields {
+		if strings.EqualFold(f.Name, condition.Field) {
+			field.Name = f.Name
+		}
+	}
+
+	op := strings.ToLower(condition.Op)
+	if op == "" {
+		c.errorf(path, "op is required")
+		return nil
+	}
+	if !slices.Contains(ConditionOperators, op) {
+		if s := suggest(condition.Op, ConditionOperators); s != "" {
+			c.errorf(path, "unknown operator %s, did you mean %s?", condition.Op, s)
+		} else {
+			c.errorf(path, "unknown operator %s. The operators are %s", condition.Op, strings.Join(ConditionOperators, ", "))
+		}
+		return nil
+	}
+
+	var values []Value
+	list, ok := condition.Value.([]any)
+	switch {
+	case condition.Value == nil:
+		c.errorf(path, "value is required")
+		return nil
+	case op == OpIn || op == FuncHasSubset:
+		if !ok || len(list) == 0 {
+			c.errorf(path, "%s expects a list of values", op)
+			return nil
+		}
+		for _, item := range list {
+			value, err := conditionValue(item)
+			if err != nil {
+				c.errorf(path, "%s", err)
+				return nil
+			}
+			values = append(values, value)
+		}
+	default:
+		value, err := conditionValue(condition.Value)
+		if err != nil {
+			c.errorf(path, "%s", err)
+			return nil
+		}
+		if slices.Contains(functions, op) && value.Kind != StringValue {
+			c.errorf(path, "%s expects a string value", op)
+			return nil
+		}
+		values = []Value{value}
+	}
+
+	var expr Expr
+	if slices.Contains(functions, op) {
+		expr = &Call{Function: op, Field: field, Values: values, List: op == FuncHasSubset}
+	} else {
+		expr = &Comparison{Field: field, Op: op, Values: values}
+	}
+
+	var problems interface{ Unwrap() []error }
+	if errors.As(Validate(expr, c.fields), &problems) {
+		for _, err := range problems.Unwrap() {
+			c.errorf(path, "%s", err.(*Error).Msg)
+		}
+		return nil
+	}
+	return expr
+}
+
+func conditionValue(value any) (Value, error) {
+	switch v := value.(type) {
+	case string:
+		return Value{Kind: StringValue, Text: v}, nil
+	case float64:
+		return Value{Kind: NumberValue, Text: strconv.FormatFloat(v, 'f', -1, 64)}, nil
+	case bool:
+		return Value{Kind: BoolValue, Text: strconv.FormatBool(v)}, nil
+	default:
+		return Value{}, fmt.Errorf("unsupported value %v, use a string, a number or a boolean", value)
+	}
+}
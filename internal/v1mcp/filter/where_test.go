@@ -0,0 +1,90 @@
+package filter
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/trendmicro/vision-one-mcp-server/internal/v1mcp/tooldescriptions"
+)
+
+func conditions(t *testing.T, where string) []Condition {
+	t.Helper()
+	c := []Condition{}
+	require.NoError(t, json.Unmarshal([]byte(where), &c))
+	return c
+}
+
+func TestCompile(t *testing.T) {
+	alerts := tooldescriptions.FilterWorkbenchAlerts.Fields
+
+	for _, tc := range []struct {
+		where  string
+		filter string
+	}{
+		{`[{"field": "severity", "op": "eq", "value": "high"}]`, "severity eq 'high'"},
+		{
+			`[{"field": "SEVERITY", "op": "EQ", "value": "high"}, {"or": [{"field": "status", "op": "eq", "value": "Open"}, {"field": "status", "op": "eq", "value": "In Progress"}]}]`,
+			"severity eq 'high' and (status eq 'Open' or status eq 'In Progress')",
+		},
+		{`[{"field": "model", "op": "contains", "value": "O'Brien's"}]`, "contains(model, 'O''Brien''s')"},
+		{`[{"field": "severity", "op": "in", "value": ["high", "critical"]}]`, "severity in ('high', 'critical')"},
+		{`[{"or": [{"and": [{"field": "id", "op": "eq", "value": "WB-1"}, {"field": "modelId", "op": "eq", "value": "1"}]}, {"field": "incidentId", "op": "eq", "value": "IC-1"}]}]`, "(id eq 'WB-1' and modelId eq '1') or incidentId eq 'IC-1'"},
+	} {
+		t.Run(tc.filter, func(t *testing.T) {
+			expr, err := Compile(conditions(t, tc.where), alerts)
+			require.NoError(t, err)
+			require.Equal(t, tc.filter, expr.String())
+
+			_, err = Parse(expr.String())
+			require.NoError(t, err)
+		})
+	}
+
+	t.Run("should compile numbers and booleans without quotes", func(t *testing.T) {
+		devices := tooldescriptions.FilterAttackSurfaceDevices.Fields
+		expr, err := Compile(conditions(t, `[{"field": "latestRiskScore", "op": "ge", "value": 70}, {"field": "installedAgents", "op": "hassubset", "value": ["Trend Vision One Agent"]}]`), devices)
+		require.NoError(t, err)
+		require.Equal(t, "latestRiskScore ge 70 and hassubset(installedAgents, ['Trend Vision One Agent'])", expr.String())
+
+		tasks := tooldescriptions.FilterThreatIntelTasks.Fields
+		expr, err = Compile(conditions(t, `[{"field": "isHit", "op": "eq", "value": true}]`), tasks)
+		require.NoError(t, err)
+		require.Equal(t, "isHit eq true", expr.String())
+	})
+
+	t.Run("should return every problem with its path", func(t *testing.T) {
+		_, err := Compile(conditions(t, `[
+			{"field": "severty", "op": "eq", "value": "high"},
+			{"or": [{"field": "status", "op": "equals", "value": "Open"}, {"field": "status", "op": "eq", "value": "Opened"}]},
+			{"field": "severity", "op": "in", "value": "high"},
+			{"field": "model", "op": "contains", "value": 1},
+			{"and": []}
+		]`), alerts)
+		require.Error(t, err)
+
+		problems := err.(interface{ Unwrap() []error }).Unwrap()
+		require.Len(t, problems, 6)
+		require.ErrorContains(t, problems[0], "where[0]: unknown field severty, did you mean severity?")
+		require.ErrorContains(t, problems[1], `where[1].or[0]: unknown operator equals, did you mean eq?`)
+		require.ErrorContains(t, problems[2], "where[1].or[1]: unsupported value 'Opened' for status, did you mean 'Open'?")
+		require.EqualError(t, problems[3], "where[2]: in expects a list of values")
+		require.EqualError(t, problems[4], "where[3]: contains expects a string value")
+		require.EqualError(t, problems[5], "where[4].and: expected at least one condition")
+	})
+
+	t.Run("should reject empty groups", func(t *testing.T) {
+		_, err := Compile(nil, alerts)
+		require.EqualError(t, err, "where: expected at least one condition")
+	})
+}
+
+func TestAnd(t *testing.T) {
+	a, err := Parse("status eq 'Open' and severity eq 'high'")
+	require.NoError(t, err)
+	b, err := Parse("model eq 'x' or model eq 'y'")
+	require.NoError(t, err)
+
+	require.Equal(t, "status eq 'Open' and severity eq 'high' and (model eq 'x' or model eq 'y')", And(a, b).String())
+	require.Equal(t, a, And(a))
+}
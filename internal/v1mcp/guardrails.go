@@ -0,0 +1,194 @@
+package v1mcp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	"github.com/trendmicro/vision-one-mcp-server/internal/v1client"
+)
+
+// guardrailsExemptTools are never evaluated by the guardrails middleware.
+// The guardrails tool itself receives potentially harmful prompts as its
+// arguments on purpose, evaluating them again would block the tool.
+var guardrailsExemptTools = []string{
+	"aisecurity_guardrails_apply",
+}
+
+type guardrailsDecision struct {
+	v1client.AISecurityApplyGuardrailsResult
+}
+
+func (d guardrailsDecision) blocked() bool {
+	return strings.EqualFold(d.Action, "block")
+}
+
+// guardrailsMiddleware sends tool results, and optionally tool arguments,
+// through AI Guard before they reach the client. Blocked arguments stop the
+// tool from running, blocked results are redacted.
+func guardrailsMiddleware(client *v1client.V1ApiClient, applicationName string, checkArguments bool) mcpserver.ToolHandlerMiddleware {
+	return func(next mcpserver.ToolHandlerFunc) mcpserver.ToolHandlerFunc {
+		return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			for _, name := range guardrailsExemptTools {
+				if request.Params.Name == name {
+					return next(ctx, request)
+				}
+			}
+
+			if checkArguments && len(request.GetArguments()) > 0 {
+				b, err := json.Marshal(request.GetArguments())
+				if err != nil {
+					return nil, err
+				}
+
+				decision, err := applyGuardrails(client, applicationName, string(b))
+				if err != nil {
+					return mcp.NewToolResultError(fmt.Sprintf("failed to evaluate tool arguments with AI Guard: %s", err)), nil
+				}
+
+				if decision.blocked() {
+					return mcp.NewToolResultError(fmt.Sprintf(
+						"tool %q was not run because AI Guard blocked its arguments: %s",
+						request.Params.Name,
+						strings.Join(decision.Reasons, "; "),
+					)), nil
+				}
+			}
+
+			result, err := next(ctx, request)
+			if err != nil || result == nil {
+				return result, err
+			}
+
+			// The result of a write tool is withheld after the change was
+			// made, the model must not take it for a failure and retry.
+			ran := ""
+			if isWriteTool(ctx, request.Params.Name) {
+				ran = fmt.Sprintf("tool %q was run and its changes were applied, but ", request.Params.Name)
+			}
+
+			evaluationFailed := func(err error) *mcp.CallToolResult {
+				if ran != "" {
+					return mcp.NewToolResultError(fmt.Sprintf("%sits result was withheld because it could not be evaluated with AI Guard: %s", ran, err))
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to evaluate tool result with AI Guard: %s", err))
+			}
+			redacted := func(decision guardrailsDecision) mcp.Content {
+				return mcp.NewTextContent(fmt.Sprintf(
+					"[content redacted: %sAI Guard blocked this tool result: %s]",
+					ran,
+					strings.Join(decision.Reasons, "; "),
+				))
+			}
+
+			for i, content := range result.Content {
+				text, ok := mcp.AsTextContent(content)
+				if !ok || text.Text == "" {
+					continue
+				}
+
+				decision, err := applyGuardrails(client, applicationName, text.Text)
+				if err != nil {
+					return evaluationFailed(err), nil
+				}
+
+				if decision.blocked() {
+					result.Content[i] = redacted(decision)
+					result.StructuredContent = nil
+					result.IsError = true
+				}
+			}
+
+			// Shaped results only return a part of the structured content
+			// as text, the structured content is evaluated on its own.
+			if structured, ok := unevaluatedStructuredContent(result); ok {
+				decision, err := applyGuardrails(client, applicationName, structured)
+				if err != nil {
+					return evaluationFailed(err), nil
+				}
+
+				if decision.blocked() {
+					result.Content = []mcp.Content{redacted(decision)}
+					result.StructuredContent = nil
+					result.IsError = true
+				}
+			}
+
+			return result, nil
+		}
+	}
+}
+
+// unevaluatedStructuredContent returns the JSON of the structured content
+// of result unless a text content of result has the same value.
+func unevaluatedStructuredContent(result *mcp.CallToolResult) (string, bool) {
+	if result.StructuredContent == nil {
+		return "", false
+	}
+	b, err := json.Marshal(result.StructuredContent)
+	if err != nil {
+		return "", false
+	}
+	var structured any
+	if err := json.Unmarshal(b, &structured); err != nil {
+		return "", false
+	}
+
+	for _, content := range result.Content {
+		text, ok := mcp.AsTextContent(content)
+		if !ok {
+			continue
+		}
+		var value any
+		if err := json.Unmarshal([]byte(text.Text), &value); err == nil && reflect.DeepEqual(value, structured) {
+			return "", false
+		}
+	}
+	return string(b), true
+}
+
+// applyGuardrails evaluates content as an untrusted message entering the
+// model's context.
+func applyGuardrails(client *v1client.V1ApiClient, applicationName, content string) (guardrailsDecision, error) {
+	resp, err := client.AISecurityApplyGuardrails(
+		v1client.AISecurityApplyGuardrailsInput{
+			Messages: []v1client.AISecurityChatMessage{
+				{Role: "user", Content: content},
+			},
+		},
+		v1client.AISecurityApplyGuardrailsOptions{
+			ApplicationName: applicationName,
+			RequestType:     "OpenAIChatCompletionRequestV1",
+			Prefer:          "return=minimal",
+		},
+	)
+	if err != nil {
+		return guardrailsDecision{}, err
+	}
+
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return guardrailsDecision{}, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return guardrailsDecision{}, fmt.Errorf("unexpected status %d: %s", resp.StatusCode, string(body))
+	}
+
+	decision := guardrailsDecision{}
+	if err := json.Unmarshal(body, &decision); err != nil {
+		return guardrailsDecision{}, fmt.Errorf("could not parse response: %w", err)
+	}
+
+	return decision, nil
+}
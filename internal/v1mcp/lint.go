@@ -0,0 +1,54 @@
+package v1mcp
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	mcpserver "github.com/mark3labs/mcp-go/server"
+)
+
+// lintTool returns the problems of a tool before it is added to s. Every
+// tool needs a title and all of its hints, so that clients can show it and
+// decide whether to ask before running it, and a name no other tool of s
+// has.
+func lintTool(s *mcpserver.MCPServer, tool mcp.Tool, readOnly bool) error {
+	errs := []error{}
+	if s.GetTool(tool.Name) != nil {
+		errs = append(errs, errors.New("a tool with this name is already registered"))
+	}
+
+	annotations := tool.Annotations
+	if annotations.Title == "" {
+		errs = append(errs, errors.New("missing title"))
+	}
+	for _, hint := range []struct {
+		name  string
+		value *bool
+	}{
+		{"readOnlyHint", annotations.ReadOnlyHint},
+		{"destructiveHint", annotations.DestructiveHint},
+		{"idempotentHint", annotations.IdempotentHint},
+		{"openWorldHint", annotations.OpenWorldHint},
+	} {
+		if hint.value == nil {
+			errs = append(errs, fmt.Errorf("missing %s", hint.name))
+		}
+	}
+
+	if annotations.ReadOnlyHint != nil {
+		switch {
+		case readOnly && !*annotations.ReadOnlyHint:
+			errs = append(errs, errors.New("should be marked as readonly"))
+		case !readOnly && *annotations.ReadOnlyHint:
+			errs = append(errs, errors.New("shouldn't be marked as being readonly"))
+		case *annotations.ReadOnlyHint && annotations.DestructiveHint != nil && *annotations.DestructiveHint:
+			errs = append(errs, errors.New("readonly tools can't be destructive"))
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("tool %q: %w", tool.Name, errors.Join(errs...))
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+package v1mcp
+
+import (
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	"github.com/stretchr/testify/require"
+	"github.com/trendmicro/vision-one-mcp-server/internal/v1client"
+	"github.com/trendmicro/vision-one-mcp-server/internal/v1mcp/tools"
+)
+
+func newLintTestTool(name string, annotations mcp.ToolAnnotation) mcp.Tool {
+	return mcp.NewTool(name, mcp.WithToolAnnotation(annotations))
+}
+
+func TestLintTool(t *testing.T) {
+	complete := mcp.ToolAnnotation{
+		Title:           "List Things",
+		ReadOnlyHint:    toPtr(true),
+		DestructiveHint: toPtr(false),
+		IdempotentHint:  toPtr(true),
+		OpenWorldHint:   toPtr(false),
+	}
+
+	t.Run("should accept complete annotations", func(t *testing.T) {
+		s := mcpserver.NewMCPServer("test", "1")
+		require.NoError(t, lintTool(s, newLintTestTool("things_list", complete), true))
+	})
+
+	t.Run("should reject missing annotations", func(t *testing.T) {
+		s := mcpserver.NewMCPServer("test", "1")
+		tool := mcp.Tool{Name: "things_list", Annotations: mcp.ToolAnnotation{ReadOnlyHint: toPtr(true)}}
+		err := lintTool(s, tool, true)
+		require.EqualError(t, err, "tool \"things_list\": missing title\nmissing destructiveHint\nmissing idempotentHint\nmissing openWorldHint")
+	})
+
+	t.Run("should reject duplicate names", func(t *testing.T) {
+		s := mcpserver.NewMCPServer("test", "1")
+		s.AddTool(newLintTestTool("things_list", complete), nil)
+		err := lintTool(s, newLintTestTool("things_list", complete), true)
+		require.EqualError(t, err, "tool \"things_list\": a tool with this name is already registered")
+	})
+
+	t.Run("should reject wrong hints", func(t *testing.T) {
+		s := mcpserver.NewMCPServer("test", "1")
+		err := lintTool(s, newLintTestTool("things_list", complete), false)
+		require.EqualError(t, err, "tool \"things_list\": shouldn't be marked as being readonly")
+
+		destructive := complete
+		destructive.DestructiveHint = toPtr(true)
+		err = lintTool(s, newLintTestTool("things_list", destructive), true)
+		require.EqualError(t, err, "tool \"things_list\": readonly tools can't be destructive")
+	})
+}
+
+func TestLintRegistrations(t *testing.T) {
+	complete := mcp.ToolAnnotation{
+		Title:           "List Things",
+		ReadOnlyHint:    toPtr(true),
+		DestructiveHint: toPtr(false),
+		IdempotentHint:  toPtr(true),
+		OpenWorldHint:   toPtr(false),
+	}
+	registration := func(tool mcp.Tool) tools.Registration {
+		return tools.Registration{
+			Toolset: "things",
+			New: func(*v1client.V1ApiClient) mcpserver.ServerTool {
+				return mcpserver.ServerTool{Tool: tool}
+			},
+		}
+	}
+	getTool := func(r tools.Registration) mcpserver.ServerTool {
+		return r.New(nil)
+	}
+
+	t.Run("should accept valid tools", func(t *testing.T) {
+		require.NoError(t, lintRegistrations([]tools.Registration{
+			registration(newLintTestTool("things_list", complete)),
+			registration(newLintTestTool("things_get", complete)),
+		}, getTool))
+	})
+
+	t.Run("should reject invalid tools", func(t *testing.T) {
+		err := lintRegistrations([]tools.Registration{
+			registration(mcp.Tool{Name: "things_list", Annotations: mcp.ToolAnnotation{ReadOnlyHint: toPtr(true)}}),
+		}, getTool)
+		require.ErrorContains(t, err, "tool \"things_list\": missing title")
+	})
+
+	t.Run("should reject duplicate names", func(t *testing.T) {
+		err := lintRegistrations([]tools.Registration{
+			registration(newLintTestTool("things_list", complete)),
+			registration(newLintTestTool("things_list", complete)),
+		}, getTool)
+		require.EqualError(t, err, "tool \"things_list\": a tool with this name is already registered")
+	})
+
+	t.Run("should lint the tools of every available toolset", func(t *testing.T) {
+		require.NoError(t, lintRegistrations(tools.Registry, getTool))
+	})
+}
@@ -0,0 +1,37 @@
+package v1mcp
+
+import (
+	"context"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	"github.com/trendmicro/vision-one-mcp-server/internal/v1mcp/policy"
+)
+
+// policyMiddleware denies the write tool calls the policy does not allow,
+// before the tool is run. Read tools are not checked.
+func policyMiddleware(p *policy.Policy) mcpserver.ToolHandlerMiddleware {
+	return func(next mcpserver.ToolHandlerFunc) mcpserver.ToolHandlerFunc {
+		return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			if !isWriteTool(ctx, request.Params.Name) {
+				return next(ctx, request)
+			}
+			if err := p.Evaluate(request.Params.Name, request.GetArguments()); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			return next(ctx, request)
+		}
+	}
+}
+
+// validatePolicy checks that the policy only refers to the write tools
+// among serverTools and their arguments.
+func validatePolicy(p *policy.Policy, serverTools []mcp.Tool) error {
+	writeTools := []mcp.Tool{}
+	for _, tool := range serverTools {
+		if readOnly := tool.Annotations.ReadOnlyHint; readOnly == nil || !*readOnly {
+			writeTools = append(writeTools, tool)
+		}
+	}
+	return p.Validate(writeTools)
+}
@@ -0,0 +1,242 @@
+// Package policy grants write tools individually and constrains their
+// arguments.
+//
+// A policy is a YAML or JSON file listing the write tools that may be run.
+// Each tool can constrain its arguments by path, where a path is an argument
+// name followed by the names of nested fields separated by dots, and []
+// stands for every item of an array:
+//
+//	tools:
+//	  threatintel_suspicious_objects_add:
+//	    arguments:
+//	      scanAction:
+//	        required: true
+//	        allow: [log]
+//	  threatintel_suspicious_objects_bulk_add:
+//	    arguments:
+//	      scanAction: {required: true, allow: [log]}
+//	      objects[].scanAction: {allow: [log]}
+//	      filePath: {forbidden: true}
+//	  iam_account_update:
+//	    arguments:
+//	      role: {deny: [Master Administrator]}
+//
+// Write tools that are not listed are denied. Read tools are not affected.
+package policy
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"gopkg.in/yaml.v3"
+)
+
+// Rule constrains the values of an argument. Values are compared as
+// strings and are not case sensitive. When the argument is an array, or
+// the path goes through one, every value is checked.
+type Rule struct {
+	// Allow lists the only values the argument may have.
+	Allow []string `yaml:"allow"`
+	// Deny lists values the argument may not have.
+	Deny []string `yaml:"deny"`
+	// Required denies calls without the argument, for arguments whose
+	// default would not be allowed.
+	Required bool `yaml:"required"`
+	// Forbidden denies calls with the argument.
+	Forbidden bool `yaml:"forbidden"`
+}
+
+// Tool is the grant of a write tool.
+type Tool struct {
+	// Arguments are the rules of the arguments of the tool, by path.
+	Arguments map[string]Rule `yaml:"arguments"`
+}
+
+// Policy lists the write tools that may be run.
+type Policy struct {
+	// Tools are the write tools that may be run, by name.
+	Tools map[string]Tool `yaml:"tools"`
+
+	// Source is the file the policy was loaded from.
+	Source string `yaml:"-"`
+}
+
+// Load reads the policy file at path. Unknown fields are rejected so that
+// a misspelled rule does not silently allow a call.
+func Load(path string) (*Policy, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading policy: %w", err)
+	}
+
+	p, err := Parse(b)
+	if err != nil {
+		return nil, fmt.Errorf("error reading policy %s: %w", path, err)
+	}
+	p.Source = path
+	return p, nil
+}
+
+// Parse parses a YAML or JSON policy.
+func Parse(b []byte) (*Policy, error) {
+	p := &Policy{}
+	decoder := yaml.NewDecoder(bytes.NewReader(b))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(p); err != nil {
+		return nil, err
+	}
+	if p.Tools == nil {
+		p.Tools = map[string]Tool{}
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(p.Tools)) {
+		for _, path := range slices.Sorted(maps.Keys(p.Tools[name].Arguments)) {
+			rule := p.Tools[name].Arguments[path]
+			if rule.Forbidden && (rule.Required || len(rule.Allow) > 0 || len(rule.Deny) > 0) {
+				return nil, fmt.Errorf("tools.%s.arguments.%s: forbidden cannot be combined with other rules", name, path)
+			}
+			if !rule.Forbidden && !rule.Required && len(rule.Allow) == 0 && len(rule.Deny) == 0 {
+				return nil, fmt.Errorf("tools.%s.arguments.%s: expected allow, deny, required or forbidden", name, path)
+			}
+		}
+	}
+	return p, nil
+}
+
+// Validate checks that the policy only grants the given write tools and
+// only constrains their arguments.
+func (p *Policy) Validate(writeTools []mcp.Tool) error {
+	tools := map[string]mcp.Tool{}
+	for _, tool := range writeTools {
+		tools[tool.Name] = tool
+	}
+
+	errs := []error{}
+	for _, name := range slices.Sorted(maps.Keys(p.Tools)) {
+		tool, ok := tools[name]
+		if !ok {
+			errs = append(errs, fmt.Errorf("tools.%s: unknown write tool", name))
+			continue
+		}
+		for _, path := range slices.Sorted(maps.Keys(p.Tools[name].Arguments)) {
+			argument := strings.TrimSuffix(strings.Split(path, ".")[0], "[]")
+			if _, ok := tool.InputSchema.Properties[argument]; !ok {
+				errs = append(errs, fmt.Errorf("tools.%s.arguments.%s: %s has no argument %s", name, path, name, argument))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Evaluate returns why a call of a write tool with arguments is denied, or
+// nil when it is allowed.
+func (p *Policy) Evaluate(name string, arguments map[string]any) error {
+	tool, ok := p.Tools[name]
+	if !ok {
+		return fmt.Errorf("%s is not granted by the policy", name)
+	}
+
+	errs := []error{}
+	for _, path := range slices.Sorted(maps.Keys(tool.Arguments)) {
+		rule := tool.Arguments[path]
+		values, set := lookup(arguments, path)
+
+		switch {
+		case rule.Forbidden && set:
+			errs = append(errs, fmt.Errorf("%s may not be set", path))
+		case rule.Required && !set:
+			errs = append(errs, fmt.Errorf("%s must be set%s", path, allowedValues(rule)))
+		}
+
+		for _, value := range values {
+			if len(rule.Allow) > 0 && !containsFold(rule.Allow, value) {
+				errs = append(errs, fmt.Errorf("%s may not be %q%s", path, value, allowedValues(rule)))
+			}
+			if containsFold(rule.Deny, value) {
+				errs = append(errs, fmt.Errorf("%s may not be %q", path, value))
+			}
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("%s is denied by the policy: %w", name, errors.Join(errs...))
+	}
+	return nil
+}
+
+func allowedValues(rule Rule) string {
+	if len(rule.Allow) == 0 {
+		return ""
+	}
+	return fmt.Sprintf(", allowed values are %s", strings.Join(rule.Allow, ", "))
+}
+
+// lookup returns the values at path in arguments as strings, and whether
+// any value is set. Arrays are flattened.
+func lookup(arguments map[string]any, path string) ([]string, bool) {
+	current := []any{arguments}
+	for _, segment := range strings.Split(path, ".") {
+		name, each := strings.CutSuffix(segment, "[]")
+
+		next := []any{}
+		for _, value := range current {
+			object, ok := value.(map[string]any)
+			if !ok {
+				continue
+			}
+			child, ok := object[name]
+			if !ok || child == nil {
+				continue
+			}
+			if items, ok := child.([]any); ok && each {
+				next = append(next, items...)
+			} else {
+				next = append(next, child)
+			}
+		}
+		current = next
+	}
+
+	values := []string{}
+	for _, value := range current {
+		values = append(values, scalars(value)...)
+	}
+	return values, len(current) > 0
+}
+
+// scalars returns the scalar values of value as strings, the items of an
+// array or value itself.
+func scalars(value any) []string {
+	switch v := value.(type) {
+	case []any:
+		values := []string{}
+		for _, item := range v {
+			values = append(values, scalars(item)...)
+		}
+		return values
+	case string:
+		return []string{v}
+	case float64:
+		return []string{strconv.FormatFloat(v, 'f', -1, 64)}
+	case bool:
+		return []string{strconv.FormatBool(v)}
+	default:
+		return []string{fmt.Sprint(v)}
+	}
+}
+
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
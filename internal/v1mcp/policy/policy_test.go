@@ -0,0 +1,149 @@
+package policy
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+)
+
+const testPolicy = `
+tools:
+  threatintel_suspicious_objects_add:
+    arguments:
+      scanAction: {required: true, allow: [log]}
+  threatintel_suspicious_objects_bulk_add:
+    arguments:
+      scanAction: {allow: [log]}
+      objects[].scanAction: {allow: [log]}
+      filePath: {forbidden: true}
+  iam_account_update:
+    arguments:
+      role: {deny: [Master Administrator]}
+  cloud_posture_custom_rule_create:
+    arguments:
+      provider: {allow: [aws]}
+  iam_account_delete: {}
+`
+
+func TestEvaluate(t *testing.T) {
+	p, err := Parse([]byte(testPolicy))
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		name      string
+		tool      string
+		arguments map[string]any
+		err       string
+	}{
+		{
+			name:      "allowed value",
+			tool:      "threatintel_suspicious_objects_add",
+			arguments: map[string]any{"type": "domain", "value": "example.com", "scanAction": "LOG"},
+		},
+		{
+			name:      "value not allowed",
+			tool:      "threatintel_suspicious_objects_add",
+			arguments: map[string]any{"scanAction": "block"},
+			err:       "threatintel_suspicious_objects_add is denied by the policy: scanAction may not be \"block\", allowed values are log",
+		},
+		{
+			name:      "required argument",
+			tool:      "threatintel_suspicious_objects_add",
+			arguments: map[string]any{},
+			err:       "threatintel_suspicious_objects_add is denied by the policy: scanAction must be set, allowed values are log",
+		},
+		{
+			name: "nested values",
+			tool: "threatintel_suspicious_objects_bulk_add",
+			arguments: map[string]any{"objects": []any{
+				map[string]any{"value": "a.example.com", "scanAction": "log"},
+				map[string]any{"value": "b.example.com"},
+			}},
+		},
+		{
+			name: "every problem",
+			tool: "threatintel_suspicious_objects_bulk_add",
+			arguments: map[string]any{"filePath": "/tmp/iocs.csv", "objects": []any{
+				map[string]any{"value": "a.example.com", "scanAction": "block"},
+			}},
+			err: "threatintel_suspicious_objects_bulk_add is denied by the policy: filePath may not be set\nobjects[].scanAction may not be \"block\", allowed values are log",
+		},
+		{
+			name:      "denied value",
+			tool:      "iam_account_update",
+			arguments: map[string]any{"accountId": "a1", "role": "master administrator"},
+			err:       "iam_account_update is denied by the policy: role may not be \"master administrator\"",
+		},
+		{
+			name:      "optional argument",
+			tool:      "iam_account_update",
+			arguments: map[string]any{"accountId": "a1", "status": "disabled"},
+		},
+		{
+			name:      "tool without rules",
+			tool:      "iam_account_delete",
+			arguments: map[string]any{"accountId": "a1"},
+		},
+		{
+			name: "tool not granted",
+			tool: "iam_api_keys_delete",
+			err:  "iam_api_keys_delete is not granted by the policy",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := p.Evaluate(tc.tool, tc.arguments)
+			if tc.err == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.err)
+			}
+		})
+	}
+}
+
+func TestParse(t *testing.T) {
+	t.Run("should accept JSON", func(t *testing.T) {
+		p, err := Parse([]byte(`{"tools": {"iam_account_update": {"arguments": {"role": {"deny": ["Master Administrator"]}}}}}`))
+		require.NoError(t, err)
+		require.Equal(t, []string{"Master Administrator"}, p.Tools["iam_account_update"].Arguments["role"].Deny)
+	})
+
+	t.Run("should reject unknown fields", func(t *testing.T) {
+		_, err := Parse([]byte("tools:\n  iam_account_update:\n    arguments:\n      role: {denied: [x]}\n"))
+		require.ErrorContains(t, err, "field denied not found")
+	})
+
+	t.Run("should reject empty and conflicting rules", func(t *testing.T) {
+		_, err := Parse([]byte("tools:\n  iam_account_update:\n    arguments:\n      role: {}\n"))
+		require.EqualError(t, err, "tools.iam_account_update.arguments.role: expected allow, deny, required or forbidden")
+
+		_, err = Parse([]byte("tools:\n  iam_account_update:\n    arguments:\n      role: {forbidden: true, deny: [x]}\n"))
+		require.EqualError(t, err, "tools.iam_account_update.arguments.role: forbidden cannot be combined with other rules")
+	})
+
+	t.Run("should load files", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "policy.yaml")
+		require.NoError(t, os.WriteFile(path, []byte(testPolicy), 0o600))
+
+		p, err := Load(path)
+		require.NoError(t, err)
+		require.Equal(t, path, p.Source)
+		require.Len(t, p.Tools, 5)
+
+		_, err = Load(filepath.Join(t.TempDir(), "missing.yaml"))
+		require.Error(t, err)
+	})
+}
+
+func TestValidate(t *testing.T) {
+	p, err := Parse([]byte("tools:\n  iam_account_update:\n    arguments:\n      rol: {deny: [x]}\n  iam_account_remove: {}\n"))
+	require.NoError(t, err)
+
+	err = p.Validate([]mcp.Tool{
+		mcp.NewTool("iam_account_update", mcp.WithString("accountId"), mcp.WithString("role")),
+	})
+	require.EqualError(t, err, "tools.iam_account_remove: unknown write tool\ntools.iam_account_update.arguments.rol: iam_account_update has no argument rol")
+}
@@ -0,0 +1,52 @@
+package v1mcp
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPolicy(t *testing.T) {
+	dir := t.TempDir()
+	writePolicy := func(policy string) string {
+		path := filepath.Join(dir, "policy.yaml")
+		require.NoError(t, os.WriteFile(path, []byte(policy), 0o600))
+		return path
+	}
+
+	t.Run("should deny calls before the tool is run", func(t *testing.T) {
+		s, err := NewMcpServer(ServerConfig{
+			Region:     "us",
+			ReadOnly:   false,
+			DryRun:     true,
+			AuditLog:   filepath.Join(dir, "audit.jsonl"),
+			PolicyFile: writePolicy("tools:\n  threatintel_suspicious_objects_add:\n    arguments:\n      scanAction: {required: true, allow: [log]}\n"),
+		})
+		require.NoError(t, err)
+
+		result := callToolResult(t, s.HandleMessage, "threatintel_suspicious_objects_add", map[string]any{"type": "domain", "value": "example.com", "scanAction": "block"})
+		require.True(t, result.IsError)
+		require.Equal(t, `threatintel_suspicious_objects_add is denied by the policy: scanAction may not be "block", allowed values are log`, result.Content[0].(mcp.TextContent).Text)
+
+		result = callToolResult(t, s.HandleMessage, "threatintel_suspicious_objects_add", map[string]any{"type": "domain", "value": "example.com", "scanAction": "log"})
+		require.False(t, result.IsError)
+		require.Contains(t, result.Content[0].(mcp.TextContent).Text, "Dry run")
+
+		result = callToolResult(t, s.HandleMessage, "iam_account_delete", map[string]any{"accountId": "a1"})
+		require.True(t, result.IsError)
+		require.Equal(t, "iam_account_delete is not granted by the policy", result.Content[0].(mcp.TextContent).Text)
+	})
+
+	t.Run("should reject policies of unknown tools", func(t *testing.T) {
+		_, err := NewMcpServer(ServerConfig{
+			Region:     "us",
+			ReadOnly:   false,
+			AuditLog:   filepath.Join(dir, "audit.jsonl"),
+			PolicyFile: writePolicy("tools:\n  iam_accounts_delete: {}\n"),
+		})
+		require.ErrorContains(t, err, "tools.iam_accounts_delete: unknown write tool")
+	})
+}
@@ -0,0 +1,201 @@
+// Package prompts provides MCP prompts for common SOC workflows.
+//
+// Each prompt is a Markdown file with a YAML front matter declaring its name,
+// description and arguments. The body is a text/template rendered with the
+// argument values, e.g. {{.alertId}}. Built-in prompts are embedded in the
+// binary and can be overridden, or extended, by the prompts of a local
+// directory.
+package prompts
+
+import (
+	"bytes"
+	"context"
+	"embed"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"slices"
+	"strings"
+	"text/template"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	"gopkg.in/yaml.v3"
+)
+
+//go:embed builtin/*.md
+var builtin embed.FS
+
+const frontMatterDelimiter = "---"
+
+type Argument struct {
+	Name        string `yaml:"name"`
+	Description string `yaml:"description"`
+	Required    bool   `yaml:"required"`
+}
+
+type Prompt struct {
+	Name        string     `yaml:"name"`
+	Description string     `yaml:"description"`
+	Arguments   []Argument `yaml:"arguments"`
+
+	// Source is the file the prompt was loaded from.
+	Source string `yaml:"-"`
+
+	template *template.Template
+}
+
+// Load returns the built-in prompts, overridden by the prompts in dir when dir
+// is set. A prompt in dir replaces the built-in prompt with the same name.
+// Prompts are sorted by name.
+func Load(dir string) ([]Prompt, error) {
+	prompts := map[string]Prompt{}
+
+	if err := loadFS(builtin, "builtin", prompts); err != nil {
+		return nil, err
+	}
+
+	if dir != "" {
+		info, err := os.Stat(dir)
+		if err != nil {
+			return nil, err
+		}
+		if !info.IsDir() {
+			return nil, fmt.Errorf("%s is not a directory", dir)
+		}
+		if err := loadFS(os.DirFS(dir), ".", prompts); err != nil {
+			return nil, err
+		}
+	}
+
+	names := make([]string, 0, len(prompts))
+	for name := range prompts {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	result := make([]Prompt, 0, len(names))
+	for _, name := range names {
+		result = append(result, prompts[name])
+	}
+	return result, nil
+}
+
+func loadFS(fsys fs.FS, dir string, prompts map[string]Prompt) error {
+	files, err := fs.Glob(fsys, path.Join(dir, "*.md"))
+	if err != nil {
+		return err
+	}
+
+	for _, file := range files {
+		data, err := fs.ReadFile(fsys, file)
+		if err != nil {
+			return fmt.Errorf("failed to read prompt %s: %w", file, err)
+		}
+
+		p, err := Parse(strings.TrimSuffix(path.Base(file), ".md"), data)
+		if err != nil {
+			return fmt.Errorf("invalid prompt %s: %w", file, err)
+		}
+		p.Source = file
+
+		prompts[p.Name] = p
+	}
+
+	return nil
+}
+
+// Parse reads a prompt file. The name is used when the front matter does not
+// declare one.
+func Parse(name string, data []byte) (Prompt, error) {
+	text := strings.ReplaceAll(string(data), "\r\n", "\n")
+
+	p := Prompt{Name: name}
+	if rest, ok := strings.CutPrefix(text, frontMatterDelimiter+"\n"); ok {
+		frontMatter, body, ok := strings.Cut(rest, "\n"+frontMatterDelimiter+"\n")
+		if !ok {
+			return Prompt{}, fmt.Errorf("front matter is not terminated by %q", frontMatterDelimiter)
+		}
+		if err := yaml.Unmarshal([]byte(frontMatter), &p); err != nil {
+			return Prompt{}, fmt.Errorf("invalid front matter: %w", err)
+		}
+		text = body
+	}
+
+	if p.Name == "" {
+		return Prompt{}, fmt.Errorf("missing prompt name")
+	}
+
+	for i, arg := range p.Arguments {
+		if arg.Name == "" {
+			return Prompt{}, fmt.Errorf("argument %d has no name", i)
+		}
+	}
+
+	tmpl, err := template.New(p.Name).Option("missingkey=error").Parse(strings.TrimSpace(text))
+	if err != nil {
+		return Prompt{}, err
+	}
+	p.template = tmpl
+
+	// Render once with every argument set so references to undeclared
+	// arguments are reported when loading rather than when the prompt is used.
+	if _, err := p.Render(nil); err != nil {
+		return Prompt{}, err
+	}
+
+	return p, nil
+}
+
+// Render executes the prompt template. Arguments that are not set render as
+// empty strings.
+func (p Prompt) Render(arguments map[string]string) (string, error) {
+	values := map[string]string{}
+	for _, arg := range p.Arguments {
+		values[arg.Name] = arguments[arg.Name]
+	}
+
+	var b bytes.Buffer
+	if err := p.template.Execute(&b, values); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
+
+// ServerPrompt returns the MCP prompt and its handler.
+func (p Prompt) ServerPrompt() mcpserver.ServerPrompt {
+	opts := []mcp.PromptOption{
+		mcp.WithPromptDescription(p.Description),
+	}
+	for _, arg := range p.Arguments {
+		argOpts := []mcp.ArgumentOption{mcp.ArgumentDescription(arg.Description)}
+		if arg.Required {
+			argOpts = append(argOpts, mcp.RequiredArgument())
+		}
+		opts = append(opts, mcp.WithArgument(arg.Name, argOpts...))
+	}
+
+	return mcpserver.ServerPrompt{
+		Prompt: mcp.NewPrompt(p.Name, opts...),
+		Handler: func(ctx context.Context, request mcp.GetPromptRequest) (*mcp.GetPromptResult, error) {
+			for _, arg := range p.Arguments {
+				if arg.Required && request.Params.Arguments[arg.Name] == "" {
+					return nil, fmt.Errorf("missing required argument: %s", arg.Name)
+				}
+			}
+
+			text, err := p.Render(request.Params.Arguments)
+			if err != nil {
+				return nil, err
+			}
+
+			return mcp.NewGetPromptResult(
+				p.Description,
+				[]mcp.PromptMessage{
+					mcp.NewPromptMessage(mcp.RoleUser, mcp.NewTextContent(text)),
+				},
+			), nil
+		},
+	}
+}
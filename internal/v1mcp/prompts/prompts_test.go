@@ -0,0 +1,77 @@
+package prompts
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	t.Run("should read the front matter", func(t *testing.T) {
+		p, err := Parse("file_name", []byte("---\r\nname: triage\r\ndescription: Triage\r\narguments:\r\n  - name: alertId\r\n    required: true\r\n---\r\nTriage {{.alertId}}\r\n"))
+		require.NoError(t, err)
+		require.Equal(t, "triage", p.Name)
+		require.Equal(t, "Triage", p.Description)
+		require.Equal(t, []Argument{{Name: "alertId", Required: true}}, p.Arguments)
+
+		text, err := p.Render(map[string]string{"alertId": "WB-1", "ignored": "x"})
+		require.NoError(t, err)
+		require.Equal(t, "Triage WB-1", text)
+	})
+
+	t.Run("should default the name to the file name", func(t *testing.T) {
+		p, err := Parse("file_name", []byte("No front matter"))
+		require.NoError(t, err)
+		require.Equal(t, "file_name", p.Name)
+	})
+
+	t.Run("should reject undeclared arguments", func(t *testing.T) {
+		_, err := Parse("p", []byte("---\nname: p\n---\nTriage {{.alertId}}"))
+		require.ErrorContains(t, err, "alertId")
+	})
+
+	t.Run("should reject unterminated front matter", func(t *testing.T) {
+		_, err := Parse("p", []byte("---\nname: p\nTriage"))
+		require.Error(t, err)
+	})
+}
+
+func TestLoad(t *testing.T) {
+	builtinPrompts, err := Load("")
+	require.NoError(t, err)
+	require.NotEmpty(t, builtinPrompts)
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "triage_workbench_alert.md"), []byte("---\ndescription: Custom triage\narguments:\n  - name: alertId\n    required: true\n---\nOur triage of {{.alertId}}"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "custom.md"), []byte("A custom prompt"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "notes.txt"), []byte("ignored"), 0o600))
+
+	loaded, err := Load(dir)
+	require.NoError(t, err)
+	require.Len(t, loaded, len(builtinPrompts)+1)
+
+	byName := map[string]Prompt{}
+	for _, p := range loaded {
+		byName[p.Name] = p
+	}
+	require.Contains(t, byName, "custom")
+	require.Equal(t, "Custom triage", byName["triage_workbench_alert"].Description)
+
+	handler := byName["triage_workbench_alert"].ServerPrompt().Handler
+
+	request := mcp.GetPromptRequest{}
+	_, err = handler(context.Background(), request)
+	require.EqualError(t, err, "missing required argument: alertId")
+
+	request.Params.Arguments = map[string]string{"alertId": "WB-1"}
+	result, err := handler(context.Background(), request)
+	require.NoError(t, err)
+	require.Equal(t, "Our triage of WB-1", result.Messages[0].Content.(mcp.TextContent).Text)
+
+	_, err = Load(filepath.Join(dir, "missing"))
+	require.Error(t, err)
+}
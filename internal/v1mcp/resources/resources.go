@@ -0,0 +1,177 @@
+// Package resources exposes Vision One objects as MCP resource templates, so
+// clients can attach them as context without the model calling a tool.
+package resources
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	"github.com/trendmicro/vision-one-mcp-server/internal/v1client"
+)
+
+var ResourceTemplates = []func(*v1client.V1ApiClient) mcpserver.ServerResourceTemplate{
+	resourceWorkbenchAlert,
+	resourceEndpoint,
+	resourceIntelligenceReport,
+	resourceCAMAWSAccount,
+	resourceCAMAlibabaAccount,
+	resourceCAMGCPAccount,
+	resourceContainerK8Cluster,
+	resourceContainerECSCluster,
+}
+
+func resourceWorkbenchAlert(client *v1client.V1ApiClient) mcpserver.ServerResourceTemplate {
+	return newResourceTemplate(
+		"v1://workbench/alerts/{id}",
+		"Workbench alert",
+		"The details of a Workbench alert, including its impact scope and indicators",
+		"id",
+		client.WorkbenchGetAlertDetails,
+	)
+}
+
+func resourceEndpoint(client *v1client.V1ApiClient) mcpserver.ServerResourceTemplate {
+	return newResourceTemplate(
+		"v1://endpoints/{agentGuid}",
+		"Endpoint",
+		"The details of an endpoint managed by Endpoint Security",
+		"agentGuid",
+		client.EndpointSecurityGetEndpoint,
+	)
+}
+
+func resourceIntelligenceReport(client *v1client.V1ApiClient) mcpserver.ServerResourceTemplate {
+	return newResourceTemplate(
+		"v1://threatintel/reports/{id}",
+		"Custom intelligence report",
+		"A custom intelligence report as a STIX bundle",
+		"id",
+		client.ThreatIntelGetIntelligenceReport,
+	)
+}
+
+func resourceCAMAWSAccount(client *v1client.V1ApiClient) mcpserver.ServerResourceTemplate {
+	return newResourceTemplate(
+		"v1://cam/aws/{accountId}",
+		"AWS account",
+		"The details of an AWS account connected to Cloud Account Management",
+		"accountId",
+		client.CAMGetAWSAccount,
+	)
+}
+
+func resourceCAMAlibabaAccount(client *v1client.V1ApiClient) mcpserver.ServerResourceTemplate {
+	return newResourceTemplate(
+		"v1://cam/alibaba/{accountId}",
+		"Alibaba Cloud account",
+		"The details of an Alibaba Cloud account connected to Cloud Account Management",
+		"accountId",
+		client.CAMGetAlibabaAccountDetails,
+	)
+}
+
+func resourceCAMGCPAccount(client *v1client.V1ApiClient) mcpserver.ServerResourceTemplate {
+	return newResourceTemplate(
+		"v1://cam/gcp/{projectId}",
+		"GCP project",
+		"The details of a GCP project connected to Cloud Account Management",
+		"projectId",
+		client.CAMGetGCPAccountDetails,
+	)
+}
+
+func resourceContainerK8Cluster(client *v1client.V1ApiClient) mcpserver.ServerResourceTemplate {
+	return newResourceTemplate(
+		"v1://container/kubernetes/{clusterId}",
+		"Kubernetes cluster",
+		"The details of a Kubernetes cluster protected by Container Security",
+		"clusterId",
+		client.ContainerSecurityGetK8ClusterDetails,
+	)
+}
+
+func resourceContainerECSCluster(client *v1client.V1ApiClient) mcpserver.ServerResourceTemplate {
+	return newResourceTemplate(
+		"v1://container/ecs/{clusterId}",
+		"Amazon ECS cluster",
+		"The details of an Amazon ECS cluster protected by Container Security",
+		"clusterId",
+		client.ContainerSecurityGetECSClusterDetails,
+	)
+}
+
+// newResourceTemplate builds a JSON resource template with a single variable
+// that is read with get.
+func newResourceTemplate(
+	uriTemplate, name, description, variable string,
+	get func(string) (*http.Response, error),
+) mcpserver.ServerResourceTemplate {
+	return mcpserver.ServerResourceTemplate{
+		Template: mcp.NewResourceTemplate(
+			uriTemplate,
+			name,
+			mcp.WithTemplateDescription(description),
+			mcp.WithTemplateMIMEType("application/json"),
+		),
+		Handler: func(ctx context.Context, request mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+			value, err := templateVariable(variable, request.Params.Arguments)
+			if err != nil {
+				return nil, err
+			}
+
+			return readResource(request.Params.URI, fmt.Sprintf("failed to read %s", strings.ToLower(name)), func() (*http.Response, error) {
+				return get(url.PathEscape(value))
+			})
+		},
+	}
+}
+
+// templateVariable returns the value of a URI template variable. Values
+// are matched as string lists, a single non-empty value is expected.
+func templateVariable(name string, arguments map[string]any) (string, error) {
+	switch v := arguments[name].(type) {
+	case string:
+		if v != "" {
+			return v, nil
+		}
+	case []string:
+		if len(v) == 1 && v[0] != "" {
+			return v[0], nil
+		}
+	}
+	return "", fmt.Errorf("missing required URI variable: %s", name)
+}
+
+func readResource(uri, msg string, get func() (*http.Response, error)) ([]mcp.ResourceContents, error) {
+	resp, err := get()
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: %s", msg, string(body))
+	}
+
+	return []mcp.ResourceContents{
+		mcp.TextResourceContents{
+			URI:      uri,
+			MIMEType: "application/json",
+			Text:     string(body),
+		},
+	}, nil
+}
@@ -0,0 +1,79 @@
+package resources
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+)
+
+func httpResponse(status int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: status,
+		Body:       io.NopCloser(strings.NewReader(body)),
+	}
+}
+
+func TestNewResourceTemplate(t *testing.T) {
+	requested := ""
+	template := newResourceTemplate(
+		"v1://workbench/alerts/{id}",
+		"Workbench alert",
+		"",
+		"id",
+		func(id string) (*http.Response, error) {
+			requested = id
+			if id == "missing" {
+				return httpResponse(http.StatusNotFound, `{"error": {"code": "NotFound"}}`), nil
+			}
+			return httpResponse(http.StatusOK, `{"id": "WB-1"}`), nil
+		},
+	)
+
+	read := func(uri string) ([]mcp.ResourceContents, error) {
+		vars := template.Template.URITemplate.Match(uri)
+		require.NotNil(t, vars, "uri %q should match", uri)
+
+		request := mcp.ReadResourceRequest{}
+		request.Params.URI = uri
+		request.Params.Arguments = map[string]any{}
+		for name, value := range vars {
+			request.Params.Arguments[name] = value.V
+		}
+		return template.Handler(context.Background(), request)
+	}
+
+	contents, err := read("v1://workbench/alerts/WB-1")
+	require.NoError(t, err)
+	require.Equal(t, "WB-1", requested)
+	require.Equal(t, []mcp.ResourceContents{
+		mcp.TextResourceContents{URI: "v1://workbench/alerts/WB-1", MIMEType: "application/json", Text: `{"id": "WB-1"}`},
+	}, contents)
+
+	_, err = read("v1://workbench/alerts/missing")
+	require.ErrorContains(t, err, "failed to read workbench alert")
+
+	_, err = read("v1://workbench/alerts/a%2F..%2Fb")
+	require.NoError(t, err)
+	require.Equal(t, "a%2F..%2Fb", requested)
+}
+
+func TestTemplateVariable(t *testing.T) {
+	v, err := templateVariable("id", map[string]any{"id": []string{"WB-1"}})
+	require.NoError(t, err)
+	require.Equal(t, "WB-1", v)
+
+	v, err = templateVariable("id", map[string]any{"id": "WB-1"})
+	require.NoError(t, err)
+	require.Equal(t, "WB-1", v)
+
+	_, err = templateVariable("id", map[string]any{"id": []string{""}})
+	require.Error(t, err)
+
+	_, err = templateVariable("id", map[string]any{})
+	require.Error(t, err)
+}
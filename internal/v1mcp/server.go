@@ -3,12 +3,24 @@ package v1mcp
 import (
 	"context"
 	"fmt"
+	"maps"
+	"net/http"
+	"net/url"
 	"os"
 	"os/signal"
+	"path/filepath"
+	"slices"
+	"strings"
 	"syscall"
+	"time"
 
+	"github.com/mark3labs/mcp-go/mcp"
 	mcpserver "github.com/mark3labs/mcp-go/server"
 	"github.com/trendmicro/vision-one-mcp-server/internal/v1client"
+	"github.com/trendmicro/vision-one-mcp-server/internal/v1mcp/completion"
+	"github.com/trendmicro/vision-one-mcp-server/internal/v1mcp/policy"
+	"github.com/trendmicro/vision-one-mcp-server/internal/v1mcp/prompts"
+	"github.com/trendmicro/vision-one-mcp-server/internal/v1mcp/resources"
 	"github.com/trendmicro/vision-one-mcp-server/internal/v1mcp/tools"
 )
 
@@ -18,43 +30,269 @@ type ServerConfig struct {
 	Version  string
 	Region   string
 	Host     string
+
+	// Toolsets limits the tools of the server to these toolsets. Every
+	// toolset is available when empty.
+	Toolsets []string
+
+	// RequestTimeout limits the time of a Vision One request including its
+	// retries. No limit when zero.
+	RequestTimeout time.Duration
+	// MaxRetries is how often rate limited and failed read requests to
+	// Vision One are retried.
+	MaxRetries int
+	// RateLimit is the maximum number of requests per second sent to Vision
+	// One. No limit when zero.
+	RateLimit float64
+	// Proxy is the URL of the proxy requests to Vision One are sent
+	// through. The proxy environment variables are used when empty.
+	Proxy string
+
+	// DefaultTop is the page size of list tools when the model doesn't set
+	// top, ToolsetDefaultTop overrides it by toolset. Zero keeps the
+	// default of the API.
+	DefaultTop        int
+	ToolsetDefaultTop map[string]int
+
+	// GuardrailsApplicationName enables AI Guard evaluation of tool results
+	// when set. It is the application name the evaluations are reported under.
+	GuardrailsApplicationName string
+	// GuardrailsCheckArguments additionally evaluates tool arguments before
+	// the tool is run.
+	GuardrailsCheckArguments bool
+
+	// PromptsDir is a directory of prompt files that override or extend
+	// the built-in prompts.
+	PromptsDir string
+
+	// ExportDir enables the export_to_file tool when set. Exports are
+	// written to this directory.
+	ExportDir string
+
+	// ImportDir is the directory the bulk import tools read the files of
+	// their filePath argument from. Files can't be imported when empty.
+	ImportDir string
+
+	// AuditLog is a file that every tool call and Vision One request is
+	// recorded to as JSON lines, or AuditLogStderr. Write tool calls are
+	// always recorded, to stderr when AuditLog is not set.
+	AuditLog string
+
+	// DryRun makes write tools return the requests they would send to
+	// Vision One instead of sending them. Read tools are not affected.
+	DryRun bool
+
+	// PolicyFile is a YAML or JSON file that grants write tools
+	// individually and constrains their arguments. Without it every write
+	// tool is allowed when ReadOnly is false.
+	PolicyFile string
+
+	// LazyToolsets only registers the tool discovery and enable_toolset
+	// tools at start, toolsets are added when the model enables them.
+	LazyToolsets bool
+	// ToolsetIdleTimeout removes toolsets enabled in lazy mode again when
+	// none of their tools was called for this long. Zero keeps them.
+	ToolsetIdleTimeout time.Duration
 }
 
+// NewMcpServer creates the server of cfg. The audit log file of cfg, if
+// any, stays open for the lifetime of the process.
 func NewMcpServer(cfg ServerConfig) (*mcpserver.MCPServer, error) {
-	s := mcpserver.NewMCPServer(
-		"v1mcp",
-		cfg.Version,
-		mcpserver.WithLogging(),
-	)
+	audit, err := newAuditLog(cfg)
+	if err != nil {
+		return nil, err
+	}
+	client, err := newClient(cfg, audit)
+	if err != nil {
+		return nil, err
+	}
+	return newMcpServer(cfg, client, audit)
+}
+
+func newClient(cfg ServerConfig, audit *auditLog) (*v1client.V1ApiClient, error) {
+	transport, err := baseTransport(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return newClientWithTransport(cfg, audit.clientTransport(transport))
+}
 
+// baseTransport returns the transport that sends requests to Vision One,
+// nil for http.DefaultTransport.
+func baseTransport(cfg ServerConfig) (http.RoundTripper, error) {
+	if cfg.Proxy == "" {
+		return nil, nil
+	}
+	proxy, err := url.Parse(cfg.Proxy)
+	if err != nil || proxy.Host == "" {
+		return nil, fmt.Errorf("invalid proxy %q", cfg.Proxy)
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = http.ProxyURL(proxy)
+	return transport, nil
+}
+
+func newClientWithTransport(cfg ServerConfig, transport http.RoundTripper) (*v1client.V1ApiClient, error) {
 	client, err := v1client.NewV1ApiClient(v1client.ClientOptions{
-		Host:   cfg.Host,
-		Region: cfg.Region,
-		ApiKey: cfg.ApiKey,
+		Host:      cfg.Host,
+		Region:    cfg.Region,
+		ApiKey:    cfg.ApiKey,
+		Transport: transport,
+
+		Timeout:    cfg.RequestTimeout,
+		MaxRetries: cfg.MaxRetries,
+		RateLimit:  cfg.RateLimit,
 	})
 	if err != nil {
 		return nil, err
 	}
 	client.UserAgent = fmt.Sprintf("trend-vision-one-mcp-server/%s", cfg.Version)
+	return client, nil
+}
+
+func newMcpServer(cfg ServerConfig, client *v1client.V1ApiClient, audit *auditLog) (*mcpserver.MCPServer, error) {
+	serverOptions := []mcpserver.ServerOption{
+		mcpserver.WithLogging(),
+		mcpserver.WithElicitation(),
+		// The tools change when toolsets are enabled in lazy mode.
+		mcpserver.WithToolCapabilities(true),
+	}
+
+	// The audit middleware is added first so that it records the result
+	// the client receives, after every other middleware.
+	if audit != nil {
+		serverOptions = append(serverOptions, mcpserver.WithToolHandlerMiddleware(audit.middleware()))
+	}
+
+	var writePolicy *policy.Policy
+	if cfg.PolicyFile != "" {
+		p, err := policy.Load(cfg.PolicyFile)
+		if err != nil {
+			return nil, err
+		}
+		writePolicy = p
+		serverOptions = append(serverOptions, mcpserver.WithToolHandlerMiddleware(policyMiddleware(writePolicy)))
+	}
+
+	if cfg.GuardrailsApplicationName != "" {
+		serverOptions = append(
+			serverOptions,
+			mcpserver.WithToolHandlerMiddleware(
+				guardrailsMiddleware(client, cfg.GuardrailsApplicationName, cfg.GuardrailsCheckArguments),
+			),
+		)
+	}
+
+	s := mcpserver.NewMCPServer(
+		"v1mcp",
+		cfg.Version,
+		serverOptions...,
+	)
+
+	factory := toolFactory{
+		client:            client,
+		confirmations:     tools.NewConfirmations(),
+		defaultTop:        cfg.DefaultTop,
+		toolsetDefaultTop: cfg.ToolsetDefaultTop,
+	}
+	if cfg.ImportDir != "" {
+		dir, err := filepath.Abs(cfg.ImportDir)
+		if err != nil {
+			return nil, err
+		}
+		info, err := os.Stat(dir)
+		if err != nil {
+			return nil, fmt.Errorf("invalid import directory: %w", err)
+		}
+		if !info.IsDir() {
+			return nil, fmt.Errorf("invalid import directory: %s is not a directory", dir)
+		}
+		factory.importDir = dir
+	}
+	if cfg.DryRun {
+		transport, err := baseTransport(cfg)
+		if err != nil {
+			return nil, err
+		}
+		factory.dryRun = &dryRunner{cfg: cfg, transport: audit.clientTransport(transport)}
+	}
+
+	toolsets := tools.Toolsets(tools.Registry)
+	for _, toolset := range slices.Concat(cfg.Toolsets, slices.Sorted(maps.Keys(cfg.ToolsetDefaultTop))) {
+		if !slices.Contains(toolsets, toolset) {
+			return nil, fmt.Errorf("unknown toolset %q, expected one of %s", toolset, strings.Join(toolsets, ", "))
+		}
+	}
+
+	available := []tools.Registration{}
+	for _, r := range tools.Registry {
+		if len(cfg.Toolsets) > 0 && !slices.Contains(cfg.Toolsets, r.Toolset) {
+			continue
+		}
+		if r.Mode() == tools.ModeRead || !cfg.ReadOnly {
+			available = append(available, r)
+		}
+	}
 
-	addReadOnlyToolset(s, client, tools.ToolsetsReadOnlyIAM)
-	addReadOnlyToolset(s, client, tools.ToolsetsReadOnlyCREM)
-	addReadOnlyToolset(s, client, tools.ToolsetsReadOnlyCloudPosture)
-	addReadOnlyToolset(s, client, tools.ToolsetsReadOnlyCloudRiskManagement)
-	addReadOnlyToolset(s, client, tools.ToolsetsReadOnlyCloudPostureBeta)
-	addReadOnlyToolset(s, client, tools.ToolsetsReadOnlyWorkench)
-	addReadOnlyToolset(s, client, tools.ToolsetsReadOnlyCAM)
-	addReadOnlyToolset(s, client, tools.ToolsetsReadOnlyEmail)
-	addReadOnlyToolset(s, client, tools.ToolsetsReadOnlyContainer)
-	addReadOnlyToolset(s, client, tools.ToolsetsReadOnlyEndpoint)
-	addReadOnlyToolset(s, client, tools.ToolsetsReadOnlyAISecurity)
-	addReadOnlyToolset(s, client, tools.ToolsetsReadOnlyThreatIntel)
-
-	if !cfg.ReadOnly {
-		addWriteToolset(s, client, tools.ToolsetsWriteCloudPosture)
-		addWriteToolset(s, client, tools.ToolsetsWriteCloudPostureBeta)
-		addWriteToolset(s, client, tools.ToolsetsWriteIAM)
-		addWriteToolset(s, client, tools.ToolsetsWriteThreatIntel)
+	describeTool := tools.NewDescribeTool(available)
+	if cfg.LazyToolsets {
+		if err := lintRegistrations(available, factory.tool); err != nil {
+			return nil, fmt.Errorf("error adding tools: %w", err)
+		}
+		loader := newToolsetLoader(s, factory, available, cfg.ToolsetIdleTimeout)
+		describeTool.Tool.Description += ". Tools that are not enabled have to be enabled with " + tools.EnableToolsetToolName + " first"
+		addReadTools(s, describeTool, loader.enableToolsetTool())
+	} else {
+		if _, err := addRegistrations(s, available, factory.tool); err != nil {
+			return nil, fmt.Errorf("error adding tools: %w", err)
+		}
+		addReadTools(s, describeTool)
+	}
+
+	if cfg.ExportDir != "" {
+		// The export tool exports the list tools of every available
+		// toolset, whether it is enabled or not.
+		listTools := map[string]*mcpserver.ServerTool{}
+		for _, r := range available {
+			if r.Mode() == tools.ModeRead {
+				tool := factory.tool(r)
+				listTools[tool.Tool.Name] = &tool
+			}
+		}
+		exportTool, err := tools.NewExportTool(cfg.ExportDir, listTools)
+		if err != nil {
+			return nil, fmt.Errorf("error creating export tool: %w", err)
+		}
+		// Exports write local files but never change Vision One, the tool
+		// is available in read-only mode as well.
+		addWriteTools(s, exportTool)
+	}
+
+	if writePolicy != nil {
+		// In lazy mode the policy may grant tools of toolsets that are not
+		// enabled yet.
+		policyTools := []mcp.Tool{}
+		for _, tool := range s.ListTools() {
+			policyTools = append(policyTools, tool.Tool)
+		}
+		for _, r := range available {
+			if tool := factory.tool(r); s.GetTool(tool.Tool.Name) == nil {
+				policyTools = append(policyTools, tool.Tool)
+			}
+		}
+		if err := validatePolicy(writePolicy, policyTools); err != nil {
+			return nil, fmt.Errorf("invalid policy %s:\n%w", writePolicy.Source, err)
+		}
+	}
+
+	addResourceTemplates(s, client, resources.ResourceTemplates)
+
+	serverPrompts, err := prompts.Load(cfg.PromptsDir)
+	if err != nil {
+		return nil, fmt.Errorf("error loading prompts: %w", err)
+	}
+	for _, p := range serverPrompts {
+		s.AddPrompts(p.ServerPrompt())
 	}
 
 	return s, nil
@@ -64,7 +302,20 @@ func RunMcpStdioServer(cfg ServerConfig) error {
 	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
 	defer stop()
 
-	s, err := NewMcpServer(cfg)
+	audit, err := newAuditLog(cfg)
+	if err != nil {
+		return fmt.Errorf("error creating mcp server: %w", err)
+	}
+	defer func() {
+		_ = audit.Close()
+	}()
+
+	client, err := newClient(cfg, audit)
+	if err != nil {
+		return fmt.Errorf("error creating mcp server: %w", err)
+	}
+
+	s, err := newMcpServer(cfg, client, audit)
 	if err != nil {
 		return fmt.Errorf("error creating mcp server: %w", err)
 	}
@@ -73,7 +324,7 @@ func RunMcpStdioServer(cfg ServerConfig) error {
 
 	serverError := make(chan error)
 	go func() {
-		serverError <- stdioServer.Listen(ctx, os.Stdin, os.Stdout)
+		serverError <- listenWithCompletions(ctx, stdioServer, completion.New(client), os.Stdin, os.Stdout)
 	}()
 
 	fmt.Fprintf(os.Stderr, "server listening...\n")
@@ -88,40 +339,114 @@ func RunMcpStdioServer(cfg ServerConfig) error {
 	return nil
 }
 
-func addReadOnlyToolset(
+// toolFactory creates the tools of the registry as they are added to the
+// server.
+type toolFactory struct {
+	client        *v1client.V1ApiClient
+	confirmations *tools.Confirmations
+	// dryRun runs write tools in dry run mode when it is not nil.
+	dryRun *dryRunner
+
+	// defaultTop is the top of list tools the model doesn't set it for,
+	// toolsetDefaultTop overrides it by toolset.
+	defaultTop        int
+	toolsetDefaultTop map[string]int
+
+	// importDir is the directory write tools import files from, see
+	// tools.WithImportDir.
+	importDir string
+}
+
+// tool returns the tool of r. Read tools get the arguments of
+// withReadArguments and the default top, write tools run in dry run mode
+// or ask for confirmation and import files from the import directory.
+func (f toolFactory) tool(r tools.Registration) mcpserver.ServerTool {
+	tool := r.New(f.client)
+	switch {
+	case r.Mode() == tools.ModeRead:
+		top, ok := f.toolsetDefaultTop[r.Toolset]
+		if !ok {
+			top = f.defaultTop
+		}
+		return withReadArguments(tools.WithDefaultTop(tool, top))
+	case f.dryRun != nil:
+		return tools.WithImportDir(f.dryRun.tool(tool, r.New), f.importDir)
+	default:
+		return tools.WithImportDir(f.confirmations.WithConfirmation(tool, f.client), f.importDir)
+	}
+}
+
+// addRegistrations adds the tools of registrations to s at once, so that
+// clients are notified of the new tools once. It returns the names of the
+// tools. No tool is added when one of them fails lintTool.
+func addRegistrations(
 	s *mcpserver.MCPServer,
-	client *v1client.V1ApiClient,
-	servertools []func(*v1client.V1ApiClient) mcpserver.ServerTool,
-) {
-	for _, getTool := range servertools {
-		addReadTools(s, getTool(client))
+	registrations []tools.Registration,
+	getTool func(tools.Registration) mcpserver.ServerTool,
+) ([]string, error) {
+	serverTools := []mcpserver.ServerTool{}
+	names := []string{}
+	for _, r := range registrations {
+		tool := getTool(r)
+		if err := lintTool(s, tool.Tool, r.Mode() == tools.ModeRead); err != nil {
+			return nil, err
+		}
+		if slices.Contains(names, tool.Tool.Name) {
+			return nil, fmt.Errorf("tool %q: a tool with this name is already registered", tool.Tool.Name)
+		}
+		serverTools = append(serverTools, tool)
+		names = append(names, tool.Tool.Name)
 	}
+	s.AddTools(serverTools...)
+	return names, nil
 }
 
-func addWriteToolset(
+// lintRegistrations returns the problems of the tools of registrations
+// without adding them to a server. In lazy mode the tools are only added
+// once their toolset is enabled, they are linted at start so that a broken
+// tool stops the server from starting instead of failing enable_toolset.
+func lintRegistrations(registrations []tools.Registration, getTool func(tools.Registration) mcpserver.ServerTool) error {
+	_, err := addRegistrations(mcpserver.NewMCPServer("lint", "1"), registrations, getTool)
+	return err
+}
+
+func addResourceTemplates(
 	s *mcpserver.MCPServer,
 	client *v1client.V1ApiClient,
-	servertools []func(*v1client.V1ApiClient) mcpserver.ServerTool,
+	templates []func(*v1client.V1ApiClient) mcpserver.ServerResourceTemplate,
 ) {
-	for _, getTool := range servertools {
-		addWriteTools(s, getTool(client))
+	for _, getTemplate := range templates {
+		s.AddResourceTemplates(getTemplate(client))
 	}
 }
 
+// addWriteTools adds write tools to s. Tools that fail lintTool are
+// programming errors and stop the server from starting.
 func addWriteTools(s *mcpserver.MCPServer, serverTools ...mcpserver.ServerTool) {
 	for _, tool := range serverTools {
-		if *tool.Tool.Annotations.ReadOnlyHint {
-			panic(fmt.Sprintf("tool %q shouldn't be marked as being readonly", tool.Tool.Name))
+		if err := lintTool(s, tool.Tool, false); err != nil {
+			panic(err.Error())
 		}
+		s.AddTool(tool.Tool, tool.Handler)
 	}
-	s.AddTools(serverTools...)
 }
 
+// addReadTools adds read tools to s, see addWriteTools.
 func addReadTools(s *mcpserver.MCPServer, serverTools ...mcpserver.ServerTool) {
 	for _, tool := range serverTools {
-		if !*tool.Tool.Annotations.ReadOnlyHint {
-			panic(fmt.Sprintf("tool %q should be marked as readonly", tool.Tool.Name))
+		if err := lintTool(s, tool.Tool, true); err != nil {
+			panic(err.Error())
 		}
+		tool = withReadArguments(tool)
+		s.AddTool(tool.Tool, tool.Handler)
 	}
-	s.AddTools(serverTools...)
+}
+
+// withReadArguments adds the response shaping, list format, where, filter
+// validation and time range arguments to a read tool. Shaping comes last so
+// that maxBytes limits the formatted result.
+func withReadArguments(tool mcpserver.ServerTool) mcpserver.ServerTool {
+	return tools.WithResponseShaping(tools.WithListFormat(
+		tools.WithWhere(tools.WithFilterValidation(tools.WithTimeRange(tool))),
+	))
 }
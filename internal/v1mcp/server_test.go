@@ -0,0 +1,103 @@
+package v1mcp
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	"github.com/stretchr/testify/require"
+	"github.com/trendmicro/vision-one-mcp-server/internal/v1mcp/prompts"
+	"github.com/trendmicro/vision-one-mcp-server/internal/v1mcp/tools"
+)
+
+// toolReferenceRegexp matches the tool names quoted in prompt texts.
+var toolReferenceRegexp = regexp.MustCompile("`([a-z0-9]+(?:_[a-z0-9]+)+)`")
+
+func TestPromptsReferenceRegisteredTools(t *testing.T) {
+	s, err := NewMcpServer(ServerConfig{Region: "us", ReadOnly: false})
+	require.NoError(t, err)
+
+	registered := s.ListTools()
+
+	builtinPrompts, err := prompts.Load("")
+	require.NoError(t, err)
+
+	for _, p := range builtinPrompts {
+		arguments := map[string]string{}
+		for _, arg := range p.Arguments {
+			arguments[arg.Name] = "x"
+		}
+
+		text, err := p.Render(arguments)
+		require.NoError(t, err)
+
+		references := toolReferenceRegexp.FindAllStringSubmatch(text, -1)
+		require.NotEmpty(t, references, "prompt %q does not reference any tool", p.Name)
+
+		for _, m := range references {
+			_, ok := registered[m[1]]
+			require.True(t, ok, "prompt %q references the unknown tool %q", p.Name, m[1])
+		}
+	}
+}
+
+func TestExportToolIsOptIn(t *testing.T) {
+	s, err := NewMcpServer(ServerConfig{Region: "us", ReadOnly: true})
+	require.NoError(t, err)
+	require.Nil(t, s.GetTool("export_to_file"))
+
+	s, err = NewMcpServer(ServerConfig{Region: "us", ReadOnly: true, ExportDir: t.TempDir()})
+	require.NoError(t, err)
+	require.NotNil(t, s.GetTool("export_to_file"))
+
+	_, err = NewMcpServer(ServerConfig{Region: "us", ExportDir: "does-not-exist"})
+	require.Error(t, err)
+}
+
+func TestDescribeToolListsRegisteredTools(t *testing.T) {
+	type described struct {
+		Name      string   `json:"name"`
+		Arguments []string `json:"arguments"`
+	}
+	describe := func(s *mcpserver.MCPServer, arguments map[string]any) []described {
+		result := callToolResult(t, s.HandleMessage, tools.DescribeToolName, arguments)
+		require.False(t, result.IsError)
+		page := struct {
+			Tools []described `json:"tools"`
+		}{}
+		require.NoError(t, json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &page))
+		return page.Tools
+	}
+
+	s, err := NewMcpServer(ServerConfig{Region: "us", ReadOnly: true})
+	require.NoError(t, err)
+	require.Empty(t, describe(s, map[string]any{"capabilities": []any{"write"}}))
+
+	accounts := describe(s, map[string]any{"query": "iam_accounts_list"})
+	require.Len(t, accounts, 1)
+	require.Contains(t, accounts[0].Arguments, "fields")
+
+	s, err = NewMcpServer(ServerConfig{Region: "us", ReadOnly: false, AuditLog: filepath.Join(t.TempDir(), "audit.jsonl")})
+	require.NoError(t, err)
+	write := describe(s, map[string]any{"capabilities": []any{"write"}})
+	require.NotEmpty(t, write)
+	for _, tool := range write {
+		require.NotNil(t, s.GetTool(tool.Name))
+	}
+}
+
+func TestServerToolsets(t *testing.T) {
+	s, err := NewMcpServer(ServerConfig{Region: "us", ReadOnly: true, Toolsets: []string{"iam"}, DefaultTop: 50})
+	require.NoError(t, err)
+	require.Nil(t, s.GetTool("container_security_k8_clusters_list"))
+
+	accounts := s.GetTool("iam_accounts_list")
+	require.NotNil(t, accounts)
+	require.Contains(t, accounts.Tool.InputSchema.Properties["top"].(map[string]any)["description"], "Defaults to 50.")
+
+	_, err = NewMcpServer(ServerConfig{Region: "us", ReadOnly: true, Toolsets: []string{"unknown"}})
+	require.Error(t, err)
+}
@@ -4,54 +4,46 @@ var NextLink = "The link used to retrieve the next page of results"
 
 var DefaultTop = "The number of records to display per page."
 
-var CAMListGCPProjectsFilterDescription = `
+var CAMListGCPProjectsFilterDescription = Filter{
+	Text: `
 string <= 254 characters
-Examples:
-
-    state eq 'managed' or state eq 'outdated' - List Google Cloud projects with statuses of 'managed' or 'outdated'.
-    state eq 'managed' and (contains(name, 'lab') or contains(id, '123')) - List managed Google Cloud projects with names containing 'lab' or IDs containing '123'.
 
 The filter for retrieving a list of a subset of connected Google Cloud projects.
 
-Supported fields:
-Field 	Description 	Possible values
-id 	The Google Cloud project number used as the ID for managing the connected Google Cloud project in Cloud Accounts. 	Any value
-name 	The name of the Google Cloud project. 	Any value
-state 	The status of the Google Cloud project. 	managed, outdated, failed
-workloadIdentityPoolId 	The workload identity pool ID of the Google Cloud project. 	Any value
-oidcProviderId 	The OIDC provider ID of the Google Cloud project. 	Any value
-serviceAccountId 	The service account ID of the Google Cloud project. 	Any value
-featureId 	The features enabled for the Google Cloud project. 	cloud-sentry
-gcpRegion 	The region where Cloud Accounts is deployed. 	Any supported region value
-Supported operators: 		
-Operator 	Description 	
---------- 	--------- 	
-eq 	Operator 'equal to' 	
-and 	Operator 'and' 	
-or 	Operator 'or' 	
-not 	Operator 'not' 	
-( ) 	Symbols for grouping operands with their correct operator. 	
-contains 	Operator that allows you to search for a specified string in a field 	
+Supported operators:
+Operator 	Description
+--------- 	---------
+eq 	Operator 'equal to'
+and 	Operator 'and'
+or 	Operator 'or'
+not 	Operator 'not'
+( ) 	Symbols for grouping operands with their correct operator.
+contains 	Operator that allows you to search for a specified string in a field
 
 Note: Include this parameter in every request that generates paginated output.
-`
-
-var FilterAWSAccounts = `
+`,
+	Examples: []FilterExample{
+		{Filter: "state eq 'managed' or state eq 'outdated'", Description: "List Google Cloud projects with statuses of 'managed' or 'outdated'."},
+		{Filter: "state eq 'managed' and (contains(name, 'lab') or contains(id, '123'))", Description: "List managed Google Cloud projects with names containing 'lab' or IDs containing '123'."},
+	},
+	Fields: []FilterField{
+		{Name: "id", Description: "The Google Cloud project number used as the ID for managing the connected Google Cloud project in Cloud Accounts."},
+		{Name: "name", Description: "The name of the Google Cloud project."},
+		{Name: "state", Description: "The status of the Google Cloud project.", Values: []string{"managed", "outdated", "failed"}},
+		{Name: "workloadIdentityPoolId", Description: "The workload identity pool ID of the Google Cloud project."},
+		{Name: "oidcProviderId", Description: "The OIDC provider ID of the Google Cloud project."},
+		{Name: "serviceAccountId", Description: "The service account ID of the Google Cloud project."},
+		{Name: "featureId", Description: "The features enabled for the Google Cloud project.", Values: []string{"cloud-sentry"}},
+		{Name: "gcpRegion", Description: "The region where Cloud Accounts is deployed."},
+	},
+}
+
+var FilterAWSAccounts = Filter{
+	Text: `
 string <= 254 characters
-Examples:
-
-    state eq 'managed' or state eq 'outdated' - List AWS accounts with states of 'managed' or 'outdated'.
-    state eq 'managed' and (contains(name, 'lab') or contains(id, '123')) - List managed AWS accounts with names containing 'lab' or IDs containing '123'.
 
 The filter for retrieving a list of a subset of connected AWS accounts.
 
-Supported fields:
-Field 	Description 	Supported values
-name 	The name of the AWS account. 	Any value
-state 	The state of the AWS account. 	managed, outdated, failed
-featureId 	The features enabled for the AWS account. 	container-security, cloud-response
-id 	The ID of the AWS account. 	Any value
-
 Supported operators:
 Operator 	Description
 eq 	Operator 'equal to'
@@ -62,59 +54,53 @@ not 	Operator 'not'
 contains 	Operator that allows you to search for a specified string in a field
 
 Note: Include this parameter in every request that generates paginated output.
-`
-var FilterAlibabaAccounts = `
+`,
+	Examples: []FilterExample{
+		{Filter: "state eq 'managed' or state eq 'outdated'", Description: "List AWS accounts with states of 'managed' or 'outdated'."},
+		{Filter: "state eq 'managed' and (contains(name, 'lab') or contains(id, '123'))", Description: "List managed AWS accounts with names containing 'lab' or IDs containing '123'."},
+	},
+	Fields: []FilterField{
+		{Name: "name", Description: "The name of the AWS account."},
+		{Name: "state", Description: "The state of the AWS account.", Values: []string{"managed", "outdated", "failed"}},
+		{Name: "featureId", Description: "The features enabled for the AWS account.", Values: []string{"container-security", "cloud-response"}},
+		{Name: "id", Description: "The ID of the AWS account."},
+	},
+}
+var FilterAlibabaAccounts = Filter{
+	Text: `
 string <= 254 characters
-Examples:
-
-    state eq 'managed' or state eq 'outdated' - List Alibaba Cloud accounts with states of 'managed' or 'outdated'.
-    state eq 'managed' and (contains(name, 'lab') or contains(id, '123')) - List managed Alibaba Cloud accounts with names containing 'lab' or IDs containing '123'.
 
 The filter for retrieving a list of a subset of connected Alibaba Cloud accounts.
 
-Supported fields:
-Field 	Description 	Supported values
-id 	The ID of the Alibaba Cloud account. 	Any value
-name 	The name of the Alibaba Cloud account. 	Any value
-state 	The state of the Alibaba Cloud account. 	managed, outdated, failed
-Supported operators: 		
-Operator 	Description 	
---------- 	--------- 	
-eq 	Operator 'equal to' 	
-and 	Operator 'and' 	
-or 	Operator 'or' 	
-not 	Operator 'not' 	
-( ) 	Symbols for grouping operands with their correct operator. 	
-contains 	Operator that allows you to search for a specified string in a field 	
+Supported operators:
+Operator 	Description
+--------- 	---------
+eq 	Operator 'equal to'
+and 	Operator 'and'
+or 	Operator 'or'
+not 	Operator 'not'
+( ) 	Symbols for grouping operands with their correct operator.
+contains 	Operator that allows you to search for a specified string in a field
 
 Note: Include this parameter in every request that generates paginated output.
-`
-
-var FilterAttackSurfaceDevices = `
+`,
+	Examples: []FilterExample{
+		{Filter: "state eq 'managed' or state eq 'outdated'", Description: "List Alibaba Cloud accounts with states of 'managed' or 'outdated'."},
+		{Filter: "state eq 'managed' and (contains(name, 'lab') or contains(id, '123'))", Description: "List managed Alibaba Cloud accounts with names containing 'lab' or IDs containing '123'."},
+	},
+	Fields: []FilterField{
+		{Name: "id", Description: "The ID of the Alibaba Cloud account."},
+		{Name: "name", Description: "The name of the Alibaba Cloud account."},
+		{Name: "state", Description: "The state of the Alibaba Cloud account.", Values: []string{"managed", "outdated", "failed"}},
+	},
+}
+
+var FilterAttackSurfaceDevices = Filter{
+	Text: `
 string <= 1024 characters
-Examples:
-
-    (latestRiskScore ge 70) and (installedAgents eq 'Trend Vision One Agent') -
-    hassubset(installedAgents, ['Trend Micro Deep Security']) -
-    startswith(lastUser,'john') -
-    hassubset(discoveredBy, ['Trend Micro Deep Security', 'Trend Vision One Agent']) - 
 
 Filter for retrieving a subset of the device information list.
 
-Supported fields:
-Field 	Description 	Supported values
-deviceName 	Device name 	Any value
-id 	The ID of the device on the Trend Vision One platform. 	Any value
-ip 	The IP addresses of the device 	Any value
-deviceType 	Whether a device can be assessed. You can only include one device type per query 	Can be assessed, Cannot be assessed, With managed agents, Unmanaged device
-latestRiskScore 	The most recent Risk Score of the device 	Any value
-criticality 	The criticality of the device 	high, medium, low
-osPlatform 	Operating system of the device 	Android, Linux, macOS, Windows, Other
-lastUser 	The last user who signed in to the device 	Any value
-installedAgents 	The agents installed on the device 	The values in the "installedAgents" field when the request is successful.
-discoveredBy 	The data sources that discovered the device 	The values in the "discoveredBy" field when the request is successful.
-assetCustomTagIds 	The tag ID of each asset in assetCustomTags 	Any value in field.
-
 Supported operators:
 Operator 	Description 	Notes
 eq 	Operator 'equal to' 	Not applicable to discoveredBy
@@ -131,28 +117,34 @@ Additional functions:
 Function 	Description 	Notes
 startswith() 	Determines if the specified string begins with the specified characters 	Only applicable to deviceName and lastUser
 hassubset() 	Checks if the array contains a subset 	Applicable to discoveredBy, installedAgents, assetCustomTagIds and ip only
-`
-
-var FilterDomainAccounts = `
+`,
+	Examples: []FilterExample{
+		{Filter: "(latestRiskScore ge 70) and (installedAgents eq 'Trend Vision One Agent')"},
+		{Filter: "hassubset(installedAgents, ['Trend Micro Deep Security'])"},
+		{Filter: "startswith(lastUser,'john')"},
+		{Filter: "hassubset(discoveredBy, ['Trend Micro Deep Security', 'Trend Vision One Agent'])"},
+	},
+	Fields: []FilterField{
+		{Name: "deviceName", Description: "Device name"},
+		{Name: "id", Description: "The ID of the device on the Trend Vision One platform."},
+		{Name: "ip", Description: "The IP addresses of the device"},
+		{Name: "deviceType", Description: "Whether a device can be assessed. You can only include one device type per query", Values: []string{"Can be assessed", "Cannot be assessed", "With managed agents", "Unmanaged device"}},
+		{Name: "latestRiskScore", Description: "The most recent Risk Score of the device"},
+		{Name: "criticality", Description: "The criticality of the device", Values: []string{"high", "medium", "low"}},
+		{Name: "osPlatform", Description: "Operating system of the device", Values: []string{"Android", "Linux", "macOS", "Windows", "Other"}},
+		{Name: "lastUser", Description: "The last user who signed in to the device"},
+		{Name: "installedAgents", Description: "The agents installed on the device"},
+		{Name: "discoveredBy", Description: "The data sources that discovered the device"},
+		{Name: "assetCustomTagIds", Description: "The tag ID of each asset in assetCustomTags"},
+	},
+}
+
+var FilterDomainAccounts = Filter{
+	Text: `
 string <= 1024 characters
-Examples:
-
-    (latestRiskScore ge 70) and (userType eq 'member') -
-    hassubset(discoveredBy, ['Trend Micro Deep Security', 'Trend Vision One Agent']) - 
 
 The filter for retrieving a subset of the domain accounts list.
 
-Supported fields:
-Field 	Description 	Supported values
-name 	The name of the domain account 	Any value
-id 	The ID of the asset on the Trend Vision One platform. 	Any value
-type 	Whether a account can be assessed. You can only include one account type per query 	Any value
-latestRiskScore 	The most recent Risk Score of the account 	Any value
-criticality 	The criticality of the account 	high, medium, low
-location 	The location of the account 	Any value
-jobTitle 	The job title of the user 	Any value
-discoveredBy 	The data sources that discovered the account 	The values in the discoveredBy field when the request is successful.
-
 Supported operators:
 Operator 	Description 	Notes
 eq 	Operator 'equal to' 	Not applicable to discoveredBy
@@ -169,26 +161,30 @@ Additional functions:
 Function 	Description 	Notes
 startswith() 	Determines if the specified string begins with the specified characters 	Only applicable to name
 hassubset() 	Checks if the array contains a subset 	Only applicable to discoveredBy
-`
-
-var FilterFQDNS = `
+`,
+	Examples: []FilterExample{
+		{Filter: "(latestRiskScore ge 70) and (userType eq 'member')"},
+		{Filter: "hassubset(discoveredBy, ['Trend Micro Deep Security', 'Trend Vision One Agent'])"},
+	},
+	Fields: []FilterField{
+		{Name: "name", Description: "The name of the domain account"},
+		{Name: "id", Description: "The ID of the asset on the Trend Vision One platform."},
+		{Name: "type", Description: "Whether a account can be assessed. You can only include one account type per query"},
+		{Name: "latestRiskScore", Description: "The most recent Risk Score of the account"},
+		{Name: "criticality", Description: "The criticality of the account", Values: []string{"high", "medium", "low"}},
+		{Name: "location", Description: "The location of the account"},
+		{Name: "jobTitle", Description: "The job title of the user"},
+		{Name: "discoveredBy", Description: "The data sources that discovered the account"},
+		{Name: "userType", Description: "The user type of the account"},
+	},
+}
+
+var FilterFQDNS = Filter{
+	Text: `
 string <= 1024 characters
-Examples:
-
-    (latestRiskScore ge 70) and (provider eq 'Trend Vision One Agent') -
-    hassubset(discoveredBy, ['Trend Micro Deep Security', 'Trend Vision One Agent']) - 
 
 Filter for retrieving a subset of the internet-facing domains list.
 
-Supported fields:
-Field 	Description 	Supported values
-rootDomain 	The root domain 	Any value
-id 	The ID of the domain on the Trend Vision One platform. 	Any value
-provider 	The domain provider. You can only include one provider per query 	Any value
-latestRiskScore 	The most recent Risk Score of the domain 	Any value
-criticality 	The criticality of the domain 	high, medium, low
-discoveredBy 	The data sources that discovered the domain 	The values in the "discoveredBy" field when the request is successful.
-
 Supported operators:
 Operator 	Description 	Notes
 eq 	Operator 'equal to' 	Not applicable to discoveredBy
@@ -205,27 +201,27 @@ Additional functions:
 Function 	Description 	Notes
 startswith() 	Determines if the specified string begins with the specified characters 	Only applicable to rootDomain
 hassubset() 	Checks if the array contains a subset 	Applicable to discoveredBy and ipAddresses only
-`
-
-var FilterIps = `
+`,
+	Examples: []FilterExample{
+		{Filter: "(latestRiskScore ge 70) and (provider eq 'Trend Vision One Agent')"},
+		{Filter: "hassubset(discoveredBy, ['Trend Micro Deep Security', 'Trend Vision One Agent'])"},
+	},
+	Fields: []FilterField{
+		{Name: "rootDomain", Description: "The root domain"},
+		{Name: "id", Description: "The ID of the domain on the Trend Vision One platform."},
+		{Name: "provider", Description: "The domain provider. You can only include one provider per query"},
+		{Name: "latestRiskScore", Description: "The most recent Risk Score of the domain"},
+		{Name: "criticality", Description: "The criticality of the domain", Values: []string{"high", "medium", "low"}},
+		{Name: "discoveredBy", Description: "The data sources that discovered the domain"},
+	},
+}
+
+var FilterIps = Filter{
+	Text: `
 string <= 1024 characters
-Examples:
-
-    (latestRiskScore ge 70) and (provider eq 'Amazon') -
-    hassubset(provider, ['Amazon']) -
-    hassubset(discoveredBy, ['Trend Micro Deep Security', 'Trend Vision One Agent']) - 
 
 Filter for retrieving a subset of the public IP addresses list.
 
-Supported fields:
-Field 	Description 	Supported values
-ipAddress 	The public IP address 	Any value
-id 	The ID of the IP address on the Trend Vision One platform. 	Any value
-provider 	The provider of the asset. You can only include one provider per query 	Any value
-latestRiskScore 	The most recent Risk Score of the IP address 	Any value
-criticality 	The criticality of the IP address 	high, medium, low
-discoveredBy 	The data sources that discovered the IP address 	The values in the "discoveredBy" field when the request is successful.
-
 Supported operators:
 Operator 	Description 	Notes
 eq 	Operator 'equal to' 	Not applicable to discoveredBy
@@ -242,30 +238,28 @@ Additional functions:
 Function 	Description 	Notes
 startswith() 	Determines if the specified string begins with the specified characters 	Only applicable to ipAddress
 hassubset() 	Checks if the array contains a subset 	Applicable to discoveredByonly
-`
-
-var FilterCloudAssets = `
+`,
+	Examples: []FilterExample{
+		{Filter: "(latestRiskScore ge 70) and (provider eq 'Amazon')"},
+		{Filter: "hassubset(provider, ['Amazon'])"},
+		{Filter: "hassubset(discoveredBy, ['Trend Micro Deep Security', 'Trend Vision One Agent'])"},
+	},
+	Fields: []FilterField{
+		{Name: "ipAddress", Description: "The public IP address"},
+		{Name: "id", Description: "The ID of the IP address on the Trend Vision One platform."},
+		{Name: "provider", Description: "The provider of the asset. You can only include one provider per query"},
+		{Name: "latestRiskScore", Description: "The most recent Risk Score of the IP address"},
+		{Name: "criticality", Description: "The criticality of the IP address", Values: []string{"high", "medium", "low"}},
+		{Name: "discoveredBy", Description: "The data sources that discovered the IP address"},
+	},
+}
+
+var FilterCloudAssets = Filter{
+	Text: `
 string <= 1024 characters
-Example: assetType eq 'EKS Cluster'
 
 The filter for retrieving a subset of the cloud asset information list.
 
-Supported fields:
-Field 	Description 	Supported values
-id 	The ID of the cloud asset on the Trend Vision One platform 	Any value
-latestRiskScore 	The most recent Risk Score of the cloud asset 	Any value
-assetName 	The name of the cloud asset 	Any value
-assetType 	The type of the cloud asset 	Any value
-assetCategory 	The category of the cloud asset 	Any value
-criticality 	The criticality of the cloud asset 	high, medium, low
-provider 	The provider of the cloud asset 	Any value
-service 	The cloud service related to the cloud asset 	Any value
-location 	The geographical location of the cloud asset 	Any value
-region 	The cloud region where the asset is located 	Any value
-cloudAccountName 	The name of the cloud account associated with the asset 	Any value
-protectionStatus 	Indicates if the cloud asset is protected by Container Security 	enabled, not enabled, unknown
-assetCustomTagIds 	The tag ID of each asset in assetCustomTags 	Any value in field
-
 Supported operators:
 Operator 	Description 	Notes
 eq 	Operator 'equal to' 	-
@@ -281,28 +275,33 @@ lt 	Operator 'less than' 	Only applicable to latestRiskScore
 Additional functions:
 Function 	Description 	Notes
 hassubset() 	Checks if the array contains a subset 	Applicable to assetCustomTagIds only
-`
-
-var FilterHighRiskUsers = `
+`,
+	Examples: []FilterExample{
+		{Filter: "assetType eq 'EKS Cluster'"},
+	},
+	Fields: []FilterField{
+		{Name: "id", Description: "The ID of the cloud asset on the Trend Vision One platform"},
+		{Name: "latestRiskScore", Description: "The most recent Risk Score of the cloud asset"},
+		{Name: "assetName", Description: "The name of the cloud asset"},
+		{Name: "assetType", Description: "The type of the cloud asset"},
+		{Name: "assetCategory", Description: "The category of the cloud asset"},
+		{Name: "criticality", Description: "The criticality of the cloud asset", Values: []string{"high", "medium", "low"}},
+		{Name: "provider", Description: "The provider of the cloud asset"},
+		{Name: "service", Description: "The cloud service related to the cloud asset"},
+		{Name: "location", Description: "The geographical location of the cloud asset"},
+		{Name: "region", Description: "The cloud region where the asset is located"},
+		{Name: "cloudAccountName", Description: "The name of the cloud account associated with the asset"},
+		{Name: "protectionStatus", Description: "Indicates if the cloud asset is protected by Container Security", Values: []string{"enabled", "not enabled", "unknown"}},
+		{Name: "assetCustomTagIds", Description: "The tag ID of each asset in assetCustomTags"},
+	},
+}
+
+var FilterHighRiskUsers = Filter{
+	Text: `
 string <= 1024 characters
-Example: (userPrincipalName eq 'demo_account@visionone.trendmicro.com') or (userName eq 'demo_account')
 
 Filter for retrieving a subset of the at-risk users list.
 
-Supported fields and operators:
-
-    'id' - The ID of a user on the Trend Vision One platform.
-    'riskScore' - Risk score of a user.
-    'userPrincipalName' - String that identifies an account.
-    'userName' - User name.
-
-Supported fields:
-Field 	Description
-id 	The ID of a user on the Trend Vision One platform.
-riskScore 	The risk score of a user.
-userPrincipalName 	String that identifies an account.
-userName 	User name
-
 Supported operators:
 Operator 	Description
 eq 	Operator 'equal to'.
@@ -314,21 +313,24 @@ gt 	Operator 'greater than'.
 ge 	Operator 'greater than or equal'.
 le 	Operator 'less than or equal'.
 lt 	Operator 'less than'.
-`
-
-var FilterApiKeys = `
+`,
+	Examples: []FilterExample{
+		{Filter: "(userPrincipalName eq 'demo_account@visionone.trendmicro.com') or (userName eq 'demo_account')"},
+	},
+	Fields: []FilterField{
+		{Name: "id", Description: "The ID of a user on the Trend Vision One platform."},
+		{Name: "riskScore", Description: "The risk score of a user."},
+		{Name: "userPrincipalName", Description: "String that identifies an account."},
+		{Name: "userName", Description: "User name"},
+	},
+}
+
+var FilterApiKeys = Filter{
+	Text: `
 string <= 1024 characters
-Example: role eq 'Master Administrator'
 
 Filter for retrieving a subset of the API keys list.
 
-Supported fields:
-Field 	Description
-id 	The unique identifier of the API key
-name 	The unique name of an API key
-role 	The user role assigned to the API key
-status 	The status of an API key
-
 Supported operators:
 Operator 	Description
 eq 	Operator 'equal to'
@@ -338,161 +340,92 @@ not 	Operator 'not'
 () 	Symbols for grouping operands with their correct operator.
 
 Note: Include this parameter in every request that generates paginated output.
-`
-
-var FilterCloudRiskManagementAccounts = `
+`,
+	Examples: []FilterExample{
+		{Filter: "role eq 'Master Administrator'"},
+	},
+	Fields: []FilterField{
+		{Name: "id", Description: "The unique identifier of the API key"},
+		{Name: "name", Description: "The unique name of an API key"},
+		{Name: "role", Description: "The user role assigned to the API key"},
+		{Name: "status", Description: "The status of an API key"},
+	},
+}
+
+var FilterCloudRiskManagementAccounts = Filter{
+	Text: `
 string <= 1783 characters
-Example: provider eq 'aws' or provider eq 'azure'
 
 Filter for retrieving a subset of accounts.
 
-Supported field values:
-
-| Field                   | Description                                 | Possible values                                                     |
-| ----------------------- | ------------------------------------------- | ------------------------------------------------------------------- |
-| provider                | The cloud service provider                  | aws, azure, gcp, alibabaCloud, oci                                  |
-| awsAccountId            | The cloudId of the AWS provider             | Example: "123456789023"                                             |
-| azureSubscriptionId     | The cloudId of the Azure provider           | Example: "be98adad-6385-4323-bf42-c61234215c7c"                     |
-| gcpProjectId            | The cloudId of the GCP provider             | Example: "af66c906-4652-4824-a4f2-f703238af335-my-gcp-project"      |
-| ociCompartmentId        | The cloudId of the OCI provider             | Example: "ocid1.compartment.oc1..aaaaaaaaxxxxxxx"                   |
-| alibabaAccountId        | The cloudId of the Alibaba Cloud provider   | Example: "1234567890123456"                                         |
-
 Supported operators:
 
 | Operator | Description         |
 | -------- | ------------------- |
 | eq       | Operator 'equal to' |
 | or       | Operator 'or'       |
-`
-
-var FilterCloudRiskManagementScanRules = `
+`,
+	Examples: []FilterExample{
+		{Filter: "provider eq 'aws' or provider eq 'azure'"},
+	},
+	Fields: []FilterField{
+		{Name: "provider", Description: "The cloud service provider", Values: []string{"aws", "azure", "gcp", "alibabaCloud", "oci"}},
+		{Name: "awsAccountId", Description: "The cloudId of the AWS provider. Example: \"123456789023\""},
+		{Name: "azureSubscriptionId", Description: "The cloudId of the Azure provider. Example: \"be98adad-6385-4323-bf42-c61234215c7c\""},
+		{Name: "gcpProjectId", Description: "The cloudId of the GCP provider. Example: \"af66c906-4652-4824-a4f2-f703238af335-my-gcp-project\""},
+		{Name: "ociCompartmentId", Description: "The cloudId of the OCI provider. Example: \"ocid1.compartment.oc1..aaaaaaaaxxxxxxx\""},
+		{Name: "alibabaAccountId", Description: "The cloudId of the Alibaba Cloud provider. Example: \"1234567890123456\""},
+	},
+}
+
+var FilterCloudRiskManagementScanRules = Filter{
+	Text: `
 string <= 1783 characters
-Example: isCustomized eq 'true'
 
 Filter for retrieving a subset of rule settings.
 
-Supported field values:
-
-| Field        | Description                                 | Possible values       |
-| ------------ | ------------------------------------------- | --------------------- |
-| isCustomized | Indicates if the rule uses default settings | true, false           |
-
 Supported operators:
 
 | Operator | Description         |
 | -------- | ------------------- |
 | eq       | Operator 'equal to' |
-`
-
-var FilterCloudRiskManagementServices = `
+`,
+	Examples: []FilterExample{
+		{Filter: "isCustomized eq 'true'"},
+	},
+	Fields: []FilterField{
+		{Name: "isCustomized", Description: "Indicates if the rule uses default settings", Values: []string{"true", "false"}},
+	},
+}
+
+var FilterCloudRiskManagementServices = Filter{
+	Text: `
 string <= 1783 characters
-Example: provider eq 'aws'
 
 Filter for retrieving a subset of services.
 
-Supported field values:
-
-| Field    | Description                | Possible values                              |
-| -------- | -------------------------- | -------------------------------------------- |
-| provider | The cloud service provider | aws, azure, gcp, alibabaCloud, oci           |
-
 Supported operators:
 
 | Operator | Description         |
 | -------- | ------------------- |
 | eq       | Operator 'equal to' |
 | or       | Operator 'or'       |
-`
-
-var FilterCloudPostureChecks = `
+`,
+	Examples: []FilterExample{
+		{Filter: "provider eq 'aws'"},
+	},
+	Fields: []FilterField{
+		{Name: "provider", Description: "The cloud service provider", Values: []string{"aws", "azure", "gcp", "alibabaCloud", "oci"}},
+	},
+}
+
+var FilterCloudPostureChecks = Filter{
+	Text: `
 string <= 1783 characters
-Examples:
-
-    accountId eq '3c8f0d33-65f0-4802-97f3-4475bb70e43e' or accountId eq 'be08d97c-55c4-4709-976c-24955ff59c8d' - List the checks with accountId is '3c8f0d33-65f0-4802-97f3-4475bb70e43e' or 'be08d97c-55c4-4709-976c-24955ff59c8d'.
-    accountId eq '3c8f0d33-65f0-4802-97f3-4475bb70e43e' and ruleId eq 'EC2-001' - List the checks with accountId is '3c8f0d33-65f0-4802-97f3-4475bb70e43e' and ruleId is 'EC2-001'
 
 The filter for retrieving a subset of the Cloud Risk Management checks.
 
-Supported fields:
-Field	Description	Supported values
-accountId	The Cloud Risk Management IDs	
-
-    Single account ID:accountId eq '3c8f0d33-65f0-4802-97f3-4475bb70e43e'
-
-    multiple account IDs:(accountId eq '9d4kd94l-d839-3932-88d0-x9839d85jfp0' or accountId eq '38d92k21-3821-d829-s823-38d920s84kfi')
-
-region	The region of the account	For more information about regions, see
-service	The cloud service of the check to filter on.	For more information about regions, see
-categories	A list of categories of the check.	
-
-    security
-    cost-optimisation
-    reliability
-    performance-efficiency
-    operational-excellence
-    sustainability
-
-
-Example:['sustainability', 'performance-efficiency']
-riskLevel	The risk level of the check	
-
-    LOW
-    MEDIUM
-    HIGH
-    VERY_HIGH
-    EXTREME
-
-status	The status of the check	SUCCESS,FAILURE
-ruleId	The rule IDs of checks to be returned	Any value
-resource	The resource ID	Any value
-description	The check description	Any value
-suppressed	
-
-Whether the check is suppressed
-
-Default: All checks
-	true,false
-tags	The tags associated with a cloud resource	Any value
-compliances	A list of supported standard or framework IDs	
-
-    AWAF
-    AZUREWAF-2024
-    GCPWAF
-    CISAWSF-1_5_0
-    CISAWSF-2_0
-    CISAWSF-3_0
-    CISAWSF-4_0_1
-    CISAZUREF-2_0
-    CISAZUREF-2_1
-    CISGCPF-1_3_0
-    CISGCPF-2_0
-    CISGCPF-3_0
-    CISABCF-1_0
-    CIS-V8
-    NIST4
-    NIST5
-    SOC2
-    NIST-CSF
-    NIST-CSF-2_0
-    ISO27001
-    ISO27001-2022
-    AGISM
-    AGISM-2024
-    HIPAA
-    HITRUST
-    ASAE-3150
-    PCI
-    PCI-V4
-    APRA
-    FEDRAMP
-    MAS
-    GDPR
-    ENISA
-    NIS-2
-    FISC-V9
-    LGPD
-
-Example:['AWAF', 'PCI']
+Supported operators:
 Operator 	Description 	Supported fields 	Example
 eq 	Operator 'equal to' 	All 	service eq 'EC2'
 and 	Operator 'and' 	All 	service eq 'EC2' and riskLevels eq 'HIGH'
@@ -506,33 +439,33 @@ any 	Operator to filter tags. You can use the eq and contains operators to filte
     Important
 
     The and operator is not supported inside parentheses.
-`
-
-var FilterWorkbenchAlerts = `
+`,
+	Examples: []FilterExample{
+		{Filter: "accountId eq '3c8f0d33-65f0-4802-97f3-4475bb70e43e' or accountId eq 'be08d97c-55c4-4709-976c-24955ff59c8d'", Description: "List the checks with accountId is '3c8f0d33-65f0-4802-97f3-4475bb70e43e' or 'be08d97c-55c4-4709-976c-24955ff59c8d'."},
+		{Filter: "accountId eq '3c8f0d33-65f0-4802-97f3-4475bb70e43e' and ruleId eq 'EC2-001'", Description: "List the checks with accountId is '3c8f0d33-65f0-4802-97f3-4475bb70e43e' and ruleId is 'EC2-001'"},
+	},
+	Fields: []FilterField{
+		{Name: "accountId", Description: "The Cloud Risk Management IDs. Use or within parentheses for multiple account IDs"},
+		{Name: "region", Description: "The region of the account"},
+		{Name: "service", Description: "The cloud service of the check to filter on."},
+		{Name: "categories", Description: "A list of categories of the check. Example: ['sustainability', 'performance-efficiency']", Values: []string{"security", "cost-optimisation", "reliability", "performance-efficiency", "operational-excellence", "sustainability"}},
+		{Name: "riskLevel", Description: "The risk level of the check", Values: []string{"LOW", "MEDIUM", "HIGH", "VERY_HIGH", "EXTREME"}},
+		{Name: "status", Description: "The status of the check", Values: []string{"SUCCESS", "FAILURE"}},
+		{Name: "ruleId", Description: "The rule IDs of checks to be returned"},
+		{Name: "resource", Description: "The resource ID"},
+		{Name: "description", Description: "The check description"},
+		{Name: "suppressed", Description: "Whether the check is suppressed. Default: All checks", Values: []string{"true", "false"}},
+		{Name: "tags", Description: "The tags associated with a cloud resource"},
+		{Name: "compliances", Description: "A list of supported standard or framework IDs. Example: ['AWAF', 'PCI']", Values: []string{"AWAF", "AZUREWAF-2024", "GCPWAF", "CISAWSF-1_5_0", "CISAWSF-2_0", "CISAWSF-3_0", "CISAWSF-4_0_1", "CISAZUREF-2_0", "CISAZUREF-2_1", "CISGCPF-1_3_0", "CISGCPF-2_0", "CISGCPF-3_0", "CISABCF-1_0", "CIS-V8", "NIST4", "NIST5", "SOC2", "NIST-CSF", "NIST-CSF-2_0", "ISO27001", "ISO27001-2022", "AGISM", "AGISM-2024", "HIPAA", "HITRUST", "ASAE-3150", "PCI", "PCI-V4", "APRA", "FEDRAMP", "MAS", "GDPR", "ENISA", "NIS-2", "FISC-V9", "LGPD"}},
+	},
+}
+
+var FilterWorkbenchAlerts = Filter{
+	Text: `
 string <= 5000 characters
-Examples:
-
-    investigationStatus eq 'New' and contains(impactScopeEntityValue,'nimda') - Filters the list by alert status (exact match) and impacted entity (partial match).
-    impactScopeEntityValue eq 'nimda' - Filters the list by impacted entity (exact match)
-    indicatorValue eq '8.8.8.8' - Filters the list by detected indicator (exact match)
 
 Filter for retrieving a subset of the alert list.
 
-Supported fields:
-Field 	Description 	Possible values
-id 	The unique identifier of an alert 	Any value
-investigationStatus (Deprecated) 	The current status of the Workbench alert or investigation 	New, In Progress, True Positive, False Positive, Benign True Positive, Closed
-status 	The status of the case or investigation 	Open, In Progress, Closed
-investigationResult 	The findings of the case or investigation 	No Findings, Noteworthy, True Positive, False Positive, Benign True Positive, Other Findings
-alertProvider 	Source of a Workbench alert 	SAE, TI
-modelId 	ID of the detection model that triggered the alert 	Any value
-model 	The detection model that triggered the alert 	Any value
-modelType 	The type of detection model that triggered the alert 	preset, custom
-severity 	The severity assigned to a model that triggered the alert 	critical, high, medium, low
-impactScopeEntityValue 	Entities affected within the company network 	Any value
-indicatorValue 	Objects found using root cause analysis or sweeping 	Any value
-incidentId 	The unique identifier of an incident 	Any value
-
 Supported operators:
 Operator 	Description
 eq 	Operator 'equal to'
@@ -543,7 +476,27 @@ not 	Operator 'not'
 contains 	Operator that allows you to search for a specified string in a field
 
 Note: Include this parameter in every request that generates paginated output.
-`
+`,
+	Examples: []FilterExample{
+		{Filter: "investigationStatus eq 'New' and contains(impactScopeEntityValue,'nimda')", Description: "Filters the list by alert status (exact match) and impacted entity (partial match)."},
+		{Filter: "impactScopeEntityValue eq 'nimda'", Description: "Filters the list by impacted entity (exact match)"},
+		{Filter: "indicatorValue eq '8.8.8.8'", Description: "Filters the list by detected indicator (exact match)"},
+	},
+	Fields: []FilterField{
+		{Name: "id", Description: "The unique identifier of an alert"},
+		{Name: "investigationStatus", Description: "The current status of the Workbench alert or investigation", Values: []string{"New", "In Progress", "True Positive", "False Positive", "Benign True Positive", "Closed"}, Deprecated: true},
+		{Name: "status", Description: "The status of the case or investigation", Values: []string{"Open", "In Progress", "Closed"}},
+		{Name: "investigationResult", Description: "The findings of the case or investigation", Values: []string{"No Findings", "Noteworthy", "True Positive", "False Positive", "Benign True Positive", "Other Findings"}},
+		{Name: "alertProvider", Description: "Source of a Workbench alert", Values: []string{"SAE", "TI"}},
+		{Name: "modelId", Description: "ID of the detection model that triggered the alert"},
+		{Name: "model", Description: "The detection model that triggered the alert"},
+		{Name: "modelType", Description: "The type of detection model that triggered the alert", Values: []string{"preset", "custom"}},
+		{Name: "severity", Description: "The severity assigned to a model that triggered the alert", Values: []string{"critical", "high", "medium", "low"}},
+		{Name: "impactScopeEntityValue", Description: "Entities affected within the company network"},
+		{Name: "indicatorValue", Description: "Objects found using root cause analysis or sweeping"},
+		{Name: "incidentId", Description: "The unique identifier of an incident"},
+	},
+}
 
 var WorkbenchOrderBy = `
 string <= 200 characters
@@ -573,27 +526,14 @@ Available values:
     firstInvestigatedDateTime
 `
 
-var ObservedAttackFilter = `
+var ObservedAttackFilter = Filter{
+	Text: `
 string <= 4000 characters
-Example: (riskLevel eq 'high') and (endpointName eq 'my-computer')
 
 Filter for retrieving a subset of the collected Observed Attack Techniques events. Include this parameter in every request that generates paginated output.
 
 Important: The name of the containerName field might change depending on the products you purchase and the supported products in your region.
 
-Supported fields:
-Field 	Description
-uuid 	The ID of an Observed Attack Techniques event.
-riskLevel 	The severity of a detection. Possible values: undefined, info, low, medium, high, critical
-filterName 	The detection filter that triggered the event
-filterMitreTacticId 	The ID of the MITRE ATT&CK tactic associated with an event.
-filterMitreTechniqueId 	The ID of the MITRE ATT&CK technique or sub-technique associated with an event.
-endpointName 	The name of an endpoint
-agentGuid 	The ID of the installed agent
-endpointIp 	The IP address of the endpoint
-productCode 	Product that generated the alert
-containerName 	The name of the container.
-
 Supported operators:
 Operator 	Description
 eq 	Operator 'equal to'
@@ -601,49 +541,54 @@ and 	Operator 'and'
 or 	Operator 'or'
 not 	Operator 'not'
 () 	Symbols for grouping operands with their correct operator
-`
-
-var FilterUserAccounts = `
+`,
+	Examples: []FilterExample{
+		{Filter: "(riskLevel eq 'high') and (endpointName eq 'my-computer')"},
+	},
+	Fields: []FilterField{
+		{Name: "uuid", Description: "The ID of an Observed Attack Techniques event."},
+		{Name: "riskLevel", Description: "The severity of a detection.", Values: []string{"undefined", "info", "low", "medium", "high", "critical"}},
+		{Name: "filterName", Description: "The detection filter that triggered the event"},
+		{Name: "filterMitreTacticId", Description: "The ID of the MITRE ATT&CK tactic associated with an event."},
+		{Name: "filterMitreTechniqueId", Description: "The ID of the MITRE ATT&CK technique or sub-technique associated with an event."},
+		{Name: "endpointName", Description: "The name of an endpoint"},
+		{Name: "agentGuid", Description: "The ID of the installed agent"},
+		{Name: "endpointIp", Description: "The IP address of the endpoint"},
+		{Name: "productCode", Description: "Product that generated the alert"},
+		{Name: "containerName", Description: "The name of the container."},
+	},
+}
+
+var FilterUserAccounts = Filter{
+	Text: `
 string <= 256 characters
-Example: status eq 'enabled' and authType eq 'local'
 
 Filter for retrieving a subset of the retrieved user list.
 
-Supported fields:
-Field 	Description
-id 	The unique identifier of a user.
-email 	The email address of a user
-authType 	The type of the user account. Available values: local, saml, samlGroup
-status 	The status of an account. Available values: enabled, disabled, invited
-
 Supported operators:
 Operator 	Description
 eq 	Operator 'equal to'
 and 	Operator 'and'
 or 	Operator 'or'
 not 	Operator 'not'
-`
-
-var FilterServiceAccounts = `
+`,
+	Examples: []FilterExample{
+		{Filter: "status eq 'enabled' and authType eq 'local'"},
+	},
+	Fields: []FilterField{
+		{Name: "id", Description: "The unique identifier of a user."},
+		{Name: "email", Description: "The email address of a user"},
+		{Name: "authType", Description: "The type of the user account.", Values: []string{"local", "saml", "samlGroup"}},
+		{Name: "status", Description: "The status of an account.", Values: []string{"enabled", "disabled", "invited"}},
+	},
+}
+
+var FilterServiceAccounts = Filter{
+	Text: `
 string <= 1024 characters
-Examples:
-
-    (latestRiskScore ge 70) and (type eq 'application') -
-    hassubset(discoveredBy, ['Trend Micro Deep Security', 'Trend Vision One Agent']) - 
 
 Filter for retrieving a subset of the service accounts list.
 
-Supported fields:
-Field 	Description 	Supported values
-name 	The name of the service Account 	Any value
-id 	The ID of the asset on the Trend Vision One platform. 	Any value
-type 	Whether a service account can be assessed. You can only include one service account type per query 	Any value
-latestRiskScore 	The most recent Risk Score of the service account 	Any value
-criticality 	The criticality of the account 	high, medium, low
-source 	The source of the service account 	Any value
-status 	The status of the service account 	Disabled, Enabled
-discoveredBy 	The data sources that discovered the account 	The values in the "discoveredBy" field when the request is successful.
-
 Supported operators:
 Operator 	Description 	Notes
 eq 	Operator 'equal to' 	Not applicable to discoveredBy
@@ -660,22 +605,29 @@ Additional functions:
 Function 	Description 	Notes
 startswith() 	Determines if the specified string begins with the specified characters 	Only applicable to name
 hassubset() 	Checks if the array contains a subset 	Applicable to discoveredBy only
-`
-
-var FilterCloudAssetRiskIndicators = `
+`,
+	Examples: []FilterExample{
+		{Filter: "(latestRiskScore ge 70) and (type eq 'application')"},
+		{Filter: "hassubset(discoveredBy, ['Trend Micro Deep Security', 'Trend Vision One Agent'])"},
+	},
+	Fields: []FilterField{
+		{Name: "name", Description: "The name of the service Account"},
+		{Name: "id", Description: "The ID of the asset on the Trend Vision One platform."},
+		{Name: "type", Description: "Whether a service account can be assessed. You can only include one service account type per query"},
+		{Name: "latestRiskScore", Description: "The most recent Risk Score of the service account"},
+		{Name: "criticality", Description: "The criticality of the account", Values: []string{"high", "medium", "low"}},
+		{Name: "source", Description: "The source of the service account"},
+		{Name: "status", Description: "The status of the service account", Values: []string{"Disabled", "Enabled"}},
+		{Name: "discoveredBy", Description: "The data sources that discovered the account"},
+	},
+}
+
+var FilterCloudAssetRiskIndicators = Filter{
+	Text: `
 string <= 1024 characters
-Example: riskLevel eq 'high'
 
 The filter for retrieving a subset of the cloud asset information list.
 
-Supported fields:
-Field 	Description 	Supported values
-id 	The ID of a risk event 	Any value
-riskLevel 	The risk level of the risk event 	high, medium, low
-riskFactor 	The risk factor of the risk event 	Threat detection, Security configuration, System configuration, Vulnerability detection, Anomaly detection, Account compromise, Cloud app activity, XDR detection
-status 	The status of the risk event 	new, inProgress, remediated, dismissed, accepted, mitigated
-detectedDateTime 	The time the event was detected 	Any value
-
 Supported operators:
 Operator 	Description 	Notes
 eq 	Operator 'equal to' 	-
@@ -687,25 +639,25 @@ gt 	Operator 'greater than' 	Only applicable to detectedDateTime
 ge 	Operator 'greater than or equal' 	Only applicable to detectedDateTime
 le 	Operator 'less than or equal' 	Only applicable to detectedDateTime
 lt 	Operator 'less than' 	Only applicable to detectedDateTime
-`
-
-var FilterLocalApps = `
+`,
+	Examples: []FilterExample{
+		{Filter: "riskLevel eq 'high'"},
+	},
+	Fields: []FilterField{
+		{Name: "id", Description: "The ID of a risk event"},
+		{Name: "riskLevel", Description: "The risk level of the risk event", Values: []string{"high", "medium", "low"}},
+		{Name: "riskFactor", Description: "The risk factor of the risk event", Values: []string{"Threat detection", "Security configuration", "System configuration", "Vulnerability detection", "Anomaly detection", "Account compromise", "Cloud app activity", "XDR detection"}},
+		{Name: "status", Description: "The status of the risk event", Values: []string{"new", "inProgress", "remediated", "dismissed", "accepted", "mitigated"}},
+		{Name: "detectedDateTime", Description: "The time the event was detected"},
+	},
+}
+
+var FilterLocalApps = Filter{
+	Text: `
 string <= 1024 characters
-Example: operatingSystem eq 'Linux'
 
 The filter for retrieving a subset of the local application information list.
 
-Supported fields:
-Field 	Description 	Supported values
-id 	The ID of the local application on the Trend Vision One platform 	Any value
-name 	The name of the local application 	Any value
-osPlatform 	The operating system of the local application 	Windows, Linux, iOS, Android, macOS, Other
-latestRiskScore 	The most recent Risk Score of the local application 	Any value
-vendor 	The vendor of the local application 	Any value
-permissionStatus 	The permission status of the local application 	allowed, blocked
-firstSeenDateTime 	The first time Attack Surface Discovery detected the local application 	Any value
-lastDetectedDateTime 	The last time a highly-exploitable CVE was detected on the local application 	Any value
-
 Supported operators:
 Operator 	Description 	Notes
 eq 	Operator 'equal to' 	-
@@ -721,22 +673,29 @@ lt 	Operator 'less than' 	Only applicable to 'latestRiskScore','firstSeenDateTim
 Additional functions:
 Function 	Description 	Notes
 contains() 	Checks if the string contains the specified value 	Applicable to vendor only
-`
-
-var FilterLocalAppRiskIndicators = `
+`,
+	Examples: []FilterExample{
+		{Filter: "operatingSystem eq 'Linux'"},
+	},
+	Fields: []FilterField{
+		{Name: "id", Description: "The ID of the local application on the Trend Vision One platform"},
+		{Name: "name", Description: "The name of the local application"},
+		{Name: "osPlatform", Description: "The operating system of the local application", Values: []string{"Windows", "Linux", "iOS", "Android", "macOS", "Other"}},
+		{Name: "latestRiskScore", Description: "The most recent Risk Score of the local application"},
+		{Name: "vendor", Description: "The vendor of the local application"},
+		{Name: "permissionStatus", Description: "The permission status of the local application", Values: []string{"allowed", "blocked"}},
+		{Name: "firstSeenDateTime", Description: "The first time Attack Surface Discovery detected the local application"},
+		{Name: "lastDetectedDateTime", Description: "The last time a highly-exploitable CVE was detected on the local application"},
+		{Name: "operatingSystem", Description: "The operating system of the local application"},
+	},
+}
+
+var FilterLocalAppRiskIndicators = Filter{
+	Text: `
 string <= 1024 characters
-Example: riskLevel eq 'high'
 
 The filter for retrieving a subset of the local application information list.
 
-Supported fields:
-Field 	Description 	Supported values
-id 	The ID of a risk event 	Any value
-riskLevel 	The risk level of the risk event 	high, medium, low
-riskFactor 	The risk factor of the risk event 	Threat detection, Security configuration, System configuration, Vulnerability detection, Anomaly detection, Account compromise, Cloud app activity, XDR detection
-status 	The status of the risk event 	new, inProgress, remediated, dismissed, accepted, mitigated
-detectedDateTime 	The time the event was detected 	Any value
-
 Supported operators:
 Operator 	Description 	Notes
 eq 	Operator 'equal to' 	-
@@ -748,20 +707,25 @@ gt 	Operator 'greater than' 	Only applicable to detectedDateTime
 ge 	Operator 'greater than or equal' 	Only applicable to detectedDateTime
 le 	Operator 'less than or equal' 	Only applicable to detectedDateTime
 lt 	Operator 'less than' 	Only applicable to detectedDateTime
-`
-
-var FilterLocalAppDevices = `
+`,
+	Examples: []FilterExample{
+		{Filter: "riskLevel eq 'high'"},
+	},
+	Fields: []FilterField{
+		{Name: "id", Description: "The ID of a risk event"},
+		{Name: "riskLevel", Description: "The risk level of the risk event", Values: []string{"high", "medium", "low"}},
+		{Name: "riskFactor", Description: "The risk factor of the risk event", Values: []string{"Threat detection", "Security configuration", "System configuration", "Vulnerability detection", "Anomaly detection", "Account compromise", "Cloud app activity", "XDR detection"}},
+		{Name: "status", Description: "The status of the risk event", Values: []string{"new", "inProgress", "remediated", "dismissed", "accepted", "mitigated"}},
+		{Name: "detectedDateTime", Description: "The time the event was detected"},
+	},
+}
+
+var FilterLocalAppDevices = Filter{
+	Text: `
 string <= 1024 characters
-Example: latestRiskScore eq 'high'
 
 The filter for retrieving a subset of the device information list.
 
-Supported fields:
-Field 	Description 	Supported values
-id 	The ID of the device 	Any value
-name 	The name of the device 	Any value
-latestRiskScore 	The most recent Risk Score of the device 	Any value
-
 Supported operators:
 Operator 	Description 	Notes
 eq 	Operator 'equal to' 	-
@@ -777,22 +741,23 @@ lt 	Operator 'less than' 	Only applicable to latestRiskScore
 Additional functions:
 Function 	Description 	Notes
 contains() 	Checks if the string contains the specified value 	Applicable to name only
-`
-
-var FilterLocalAppExecutables = `
+`,
+	Examples: []FilterExample{
+		{Filter: "latestRiskScore eq 'high'"},
+	},
+	Fields: []FilterField{
+		{Name: "id", Description: "The ID of the device"},
+		{Name: "name", Description: "The name of the device"},
+		{Name: "latestRiskScore", Description: "The most recent Risk Score of the device"},
+	},
+}
+
+var FilterLocalAppExecutables = Filter{
+	Text: `
 string <= 1024 characters
-Example: name eq 'abc.exe'
 
 The filter for retrieving a subset of the executable file information list.
 
-Supported fields:
-Field 	Description 	Supported values
-name 	The name of the executable file 	Any value
-productName 	The name of the product associated with the executable file 	Any value
-language 	The language of the executable file 	Any value
-firstSeenDateTime 	The first time the executable file was detected, ISO 8601 format 	Any value
-lastDetectedDateTime 	The last time the executable file was detected, ISO 8601 format 	Any value
-
 Supported operators:
 Operator 	Description 	Notes
 eq 	Operator 'equal to' 	-
@@ -808,58 +773,74 @@ lt 	Operator 'less than' 	Only applicable to firstSeenDateTime, lastDetectedDate
 Additional functions:
 Function 	Description 	Notes
 contains() 	Checks if the string contains the specified value 	Applicable to productName only
-`
-
-var FilterCustomTags = `
+`,
+	Examples: []FilterExample{
+		{Filter: "name eq 'abc.exe'"},
+	},
+	Fields: []FilterField{
+		{Name: "name", Description: "The name of the executable file"},
+		{Name: "productName", Description: "The name of the product associated with the executable file"},
+		{Name: "language", Description: "The language of the executable file"},
+		{Name: "firstSeenDateTime", Description: "The first time the executable file was detected, ISO 8601 format"},
+		{Name: "lastDetectedDateTime", Description: "The last time the executable file was detected, ISO 8601 format"},
+	},
+}
+
+var FilterCustomTags = Filter{
+	Text: `
 string <= 1024 characters
-Example: id eq 'qVQz+Y3HL1GQ56qTeSKhtFxYAIM=-01'
 
 The filter for retrieving a subset of the cloud asset information list.
 
-Supported fields:
-Field 	Description 	Supported values
-id 	The tag ID of each asset in assetCustomTags 	Any value in field
-key 	The key of each asset in assetCustomTags 	Any value in field
-value 	The tag value of each asset in assetCustomTags 	Any value in field
-Supported operators: 		
+Supported operators:
 Operator 	Description
 eq 	Operator 'equal to'
 and 	Operator 'and'
 or 	Operator 'or'
 not 	Operator 'not'
 () 	Symbols for grouping operands with their correct operator
-`
-
-var FilterEmailAccounts = `
+`,
+	Examples: []FilterExample{
+		{Filter: "id eq 'qVQz+Y3HL1GQ56qTeSKhtFxYAIM=-01'"},
+	},
+	Fields: []FilterField{
+		{Name: "id", Description: "The tag ID of each asset in assetCustomTags"},
+		{Name: "key", Description: "The key of each asset in assetCustomTags"},
+		{Name: "value", Description: "The tag value of each asset in assetCustomTags"},
+	},
+}
+
+var FilterEmailAccounts = Filter{
+	Text: `
 string <= 512 characters
-Example: sensorDetectionStatus eq 'Enabled' and mailService eq 'Exchange Online'
 
 The filter used to retrieve a subset of email accounts from a generated paginated list.
 
-Supported fields:
-Field 	Description 	Supported values
-sensorDetectionStatus 	The account's email sensor detection status. 	Enabled, Disabled
-protectionPolicyStatus 	The account's Cloud Email and Collaboration Protection policy status. 	'Disabled', 'Fully enabled', 'Partially enabled'
-mailService 	The account's mail service (iam) type. 	'Exchange Online', 'Gmail', 'Unknown'
-
 Supported operators:
 Operator 	Description 	Notes
 eq 	Operator "equal to" 	-
 and 	Operator "and" 	-
 
 Only support eq, and operators.
-`
-
-var FilterWorkbenchNotes = `
+`,
+	Examples: []FilterExample{
+		{Filter: "sensorDetectionStatus eq 'Enabled' and mailService eq 'Exchange Online'"},
+	},
+	Fields: []FilterField{
+		{Name: "sensorDetectionStatus", Description: "The account's email sensor detection status.", Values: []string{"Enabled", "Disabled"}},
+		{Name: "protectionPolicyStatus", Description: "The account's Cloud Email and Collaboration Protection policy status.", Values: []string{"Disabled", "Fully enabled", "Partially enabled"}},
+		{Name: "mailService", Description: "The account's mail service (iam) type.", Values: []string{"Exchange Online", "Gmail", "Unknown"}},
+	},
+}
+
+var FilterWorkbenchNotes = Filter{
+	Text: `
 string <= 5000 characters
-Example: creatorName eq 'John Doe'
 
-Filter for retrieving a subset of Workbench alert notes. Supported fields and operators:
+Filter for retrieving a subset of Workbench alert notes.
+
+Supported operators:
 
-    id - Numeric string that identifies a Workbench alert note
-    creatorMailAddress - Email address of the user that created a Workbench alert note
-    creatorName - User that created a Workbench alert note
-    lastUpdatedBy - Parameter that indicates the user who last modified a Workbench alert note
     'eq' - Abbreviation of the operator 'equal to'
     'and' - Operator 'and'
     'or' - Operator 'or'
@@ -867,46 +848,47 @@ Filter for retrieving a subset of Workbench alert notes. Supported fields and op
     '( )' - Symbols for grouping operands with their correct operator.
 
 Note: Include this parameter in every request that generates paginated output.
-`
-
-var FilterContainerVuln = `
+`,
+	Examples: []FilterExample{
+		{Filter: "creatorName eq 'John Doe'"},
+	},
+	Fields: []FilterField{
+		{Name: "id", Description: "Numeric string that identifies a Workbench alert note"},
+		{Name: "creatorMailAddress", Description: "Email address of the user that created a Workbench alert note"},
+		{Name: "creatorName", Description: "User that created a Workbench alert note"},
+		{Name: "lastUpdatedBy", Description: "Parameter that indicates the user who last modified a Workbench alert note"},
+	},
+}
+
+var FilterContainerVuln = Filter{
+	Text: `
 string <= 1024 characters
-Example: riskLevel eq 'high'
 
 The filter for retrieving a subset of the image vulnerabilities list. Include this header in every request that generates paginated output.
 
-Supported fields and operators:
-Field 	Description 	Allowed values
-clusterType 	The type of cluster 	kubernetes, amazonecs
-name 	The name of the vulnerability 	Any value
-clusterId 	The ID of the cluster 	Any value
-imageId 	The The ID of the container image 	Any value
-riskLevel 	The risk level of the vulnerability 	high, medium, low
-
 Supported operators:
 
     eq - Operator "equal to"
     and - Operator "and"
-`
-
-var FilterK8s = `
+`,
+	Examples: []FilterExample{
+		{Filter: "riskLevel eq 'high'"},
+	},
+	Fields: []FilterField{
+		{Name: "clusterType", Description: "The type of cluster", Values: []string{"kubernetes", "amazonecs"}},
+		{Name: "name", Description: "The name of the vulnerability"},
+		{Name: "clusterId", Description: "The ID of the cluster"},
+		{Name: "imageId", Description: "The The ID of the container image"},
+		{Name: "riskLevel", Description: "The risk level of the vulnerability", Values: []string{"high", "medium", "low"}},
+	},
+}
+
+var FilterK8s = Filter{
+	Text: `
 string <= 1024 characters
-Example: name eq 'example_cluster'
 
 The filter for retrieving a subset of the Kubernetes cluster list, which is included in every request that generates paginated output.
 
-Supported fields:
-
-    launchType - The launch type of the cluster. Support values: [ EC2, FARGATE, NODEPOOL, VIRTUALNODE, STANDARD, AUTOPILOT, MANAGED ]
-    name - The name of the Kubernetes cluster
-    orchestrator - The orchestrator of the Cluster. Support values: [ Self-managed, Amazon EKS, Microsoft AKS, Google GKE, Alibaba Cloud ACK ]
-    policyId - The ID of the policy associated with the cluster
-    protectionStatus - The protection status of the cluster. Supported values: [ UNKNOWN, HEALTHY, UNHEALTHY, WARNING ]
-    runtimeSecurityEnabled - Whether Runtime Security is enabled for the cluster. Supported values: [ true, false ]
-    vulnerabilityScanEnabled - Whether Runtime Vulnerability Scanning is enabled for the cluster. Supported values: [ true, false ]
-    malwareScanEnabled - Whether Runtime Malware Scanning is enabled for the cluster. Supported values: [ true, false ]
-    groupId | The ID of the group associated with the cluster.
-
 Supported operators:
 
     eq - Operator "equal to"
@@ -914,40 +896,50 @@ Supported operators:
     not - Operator "not"
     or - Operator "or"
     () - Symbols for grouping operands
-`
-
-var FilterECS = `
+`,
+	Examples: []FilterExample{
+		{Filter: "name eq 'example_cluster'"},
+	},
+	Fields: []FilterField{
+		{Name: "launchType", Description: "The launch type of the cluster.", Values: []string{"EC2", "FARGATE", "NODEPOOL", "VIRTUALNODE", "STANDARD", "AUTOPILOT", "MANAGED"}},
+		{Name: "name", Description: "The name of the Kubernetes cluster"},
+		{Name: "orchestrator", Description: "The orchestrator of the Cluster.", Values: []string{"Self-managed", "Amazon EKS", "Microsoft AKS", "Google GKE", "Alibaba Cloud ACK"}},
+		{Name: "policyId", Description: "The ID of the policy associated with the cluster"},
+		{Name: "protectionStatus", Description: "The protection status of the cluster.", Values: []string{"UNKNOWN", "HEALTHY", "UNHEALTHY", "WARNING"}},
+		{Name: "runtimeSecurityEnabled", Description: "Whether Runtime Security is enabled for the cluster.", Values: []string{"true", "false"}},
+		{Name: "vulnerabilityScanEnabled", Description: "Whether Runtime Vulnerability Scanning is enabled for the cluster.", Values: []string{"true", "false"}},
+		{Name: "malwareScanEnabled", Description: "Whether Runtime Malware Scanning is enabled for the cluster.", Values: []string{"true", "false"}},
+		{Name: "groupId", Description: "The ID of the group associated with the cluster."},
+	},
+}
+
+var FilterECS = Filter{
+	Text: `
 string <= 1024 characters
-Example: name eq 'example_cluster'
 
 The filter for retrieving a subset of Amazon ECS clusters. Include this parameter in every request that generates paginated output
 
-Supported fields:
-
-    name - The name of the Amazon ECS cluster
-
 Supported operators:
 
     eq - Operator "equal to"
     contains - String partial match
     not - Operator "not"
     or - Operator "or"
-`
-
-var FilterK8Images = `
+`,
+	Examples: []FilterExample{
+		{Filter: "name eq 'example_cluster'"},
+	},
+	Fields: []FilterField{
+		{Name: "name", Description: "The name of the Amazon ECS cluster"},
+	},
+}
+
+var FilterK8Images = Filter{
+	Text: `
 string <= 1024 characters
-Example: id eq 'imageId_1' and clusterId eq 'clusterId_1'
 
 The filter for retrieving a subset of the Kubernetes image list. Include this parameter in every request that generates paginated output
 
-Supported fields:
-Field 	Description
-id 	The ID of the Kubernetes image
-clusterid 	The ID of the Kubernetes cluster
-digest 	The container image digest
-repository 	The repository of the container image
-registry 	The registry of the container image
-
 Supported operators:
 
     eq - Operator "equal to"
@@ -955,317 +947,25 @@ Supported operators:
     or - Operator "or"
     not - Operator "not"
     () - Symbols for grouping operands
-`
-
-var FilterEndpoints = `
+`,
+	Examples: []FilterExample{
+		{Filter: "id eq 'imageId_1' and clusterId eq 'clusterId_1'"},
+	},
+	Fields: []FilterField{
+		{Name: "id", Description: "The ID of the Kubernetes image"},
+		{Name: "clusterid", Description: "The ID of the Kubernetes cluster"},
+		{Name: "digest", Description: "The container image digest"},
+		{Name: "repository", Description: "The repository of the container image"},
+		{Name: "registry", Description: "The registry of the container image"},
+	},
+}
+
+var FilterEndpoints = Filter{
+	Text: `
 string <= 1024 characters
-Example: not (osName eq 'Windows') and eppAgentAntiMalwareScans eq 'enabled'
 
 Filter for retrieving a subset of the endpoint information list.
 
-Supported fields:
-
-Field
-	
-
-Description
-	
-
-Supported values
-
-endpointName
-	
-
-The name of the endpoint.
-	
-
-Any value
-
-agentGuid
-	
-
-The ID of the endpoint on the Trend Vision One platform.
-	
-
-Any value
-
-type
-	
-
-The type of endpoint.
-	
-
-desktop,server
-
-edrSensorAdvancedRiskTelemetryStatus
-	
-
-The status of the advanced risk telemetry on the endpoint.
-	
-
-enabled,disabled,enabling,disabling,unknown
-
-edrSensorConnectivity
-	
-
-The connectivity of the sensor installed on the endpoint.
-	
-
-connected,disconnected
-
-eppAgentProtectionManager
-	
-
-The name of your protection manager.
-	
-
-Any value
-
-eppAgentEndpointGroup
-	
-
-The name of the endpoint group.
-	
-
-Any value
-
-osName
-	
-
-The operating system of the endpoint.
-	
-
-Any value
-
-osPlatform
-	
-
-The platform of the operating system of the endpoint.
-	
-
-windows,mac,linux,unix,unknown
-
-serviceGatewayOrProxy
-	
-
-The endpoints routed through a Service Gateway or Proxy
-	
-
-Any value that follows the format described in the 'serviceGatewayOrProxy' field
-
-osArchitecture
-	
-
-The type of operating system running on the endpoint.
-	
-
-x86,x86_64,ppc64le,sparc,powerpc,aarch64
-
-versionControlPolicy
-	
-
-The name of the version control policy.
-	
-
-Any value
-
-agentUpdateStatus
-	
-
-The status of the agent update policy.
-	
-
-onSchedule,pause,disable,notSupported
-
-agentUpdatePolicy
-	
-
-The agent update policy version used for version control policy APIs.
-	
-
-Any value
-
-creditAllocatedLicenses
-	
-
-The features enabled on the endpoint
-	
-
-Endpoint sensor detection and response,Advanced Endpoint Security,Advanced Server & Workload Protection,SAP Scanner for Trend Vision One - Endpoint Security (Pro)
-
-securityPolicy
-	
-
-The name of the security policy applied to the endpoint
-	
-
-Any value
-
-securityPolicyOverriddenStatus
-	
-
-Whether the endpoint security policy was overridden
-	
-
-enabled,disabled
-
-edrSensorStatus
-	
-
-The status of the endpoint sensor detection and response.
-	
-
-enabled,disabled,enabling,disabling,unknown
-
-edrSensorComponentUpdatePolicy
-	
-
-The version control update policy for the module/pattern of the sensor installed on the endpoint.
-	
-
-nrepresents the latest version.n - xrepresents x snapshots prior (Example:n - 2).
-
-edrSensorComponentUpdateStatus
-	
-
-The status of the module/pattern updates of the sensor installed on the endpoint.
-	
-
-pause,onSchedule,notSupported
-
-eppAgentStatus
-	
-
-The connectivity status of the endpoint protection agent.
-	
-
-on,off,unknown
-
-eppAgentPolicyName
-	
-
-The name of a policy from your protection manager.
-	
-
-Any value
-
-isolationStatus
-	
-
-Indicates if an endpoint is isolated.
-	
-
-on,off,unknown
-
-eppAgentComponentVersion
-	
-
-The agent component version.
-	
-
-outdatedVersion,latestVersion,unknownVersions,controlledLatestVersion
-
-eppAgentComponentUpdatePolicy
-	
-
-The update policy for the module/pattern of the agent installed on the endpoint.
-	
-
-nrepresents the latest version.n - xrepresents x snapshots prior (Example:n - 2).
-
-eppAgentComponentUpdateStatus
-	
-
-The status of the module/pattern updates of the agent installed on the endpoint.
-	
-
-pause,onSchedule,notSupported
-
-    eppAgentAntiMalwareScans
-
-    eppAgentBehaviorMonitoring
-
-    eppAgentPredictiveMachineLearning
-
-    eppAgentWebReputation
-
-    eppAgentSuspiciousConnectionSettings
-
-    eppAgentVulnerabilityProtection
-
-    eppAgentDeviceControl
-
-    eppAgentApplicationControl
-
-    eppAgentFirewall
-
-    eppAgentIntegratedEndpointSensor
-
-    eppAgentDataLossPrevention
-
-    eppAgentSmartFeedback
-
-    eppAgentAntiMalware
-
-    eppAgentActivityMonitoring
-
-    eppAgentIntrusionPreventionSystem
-
-    eppAgentLogInspection
-
-    eppAgentIntegrityMonitoring
-
-    eppAgentAgentSelfProtection
-
-    eppAgentSAPScanner
-
-    eppAgentSecurityAgentPasswordUnlock
-
-	
-
-The status of the features supported by the endpoint protection agent.
-	
-
-enabled,disabled,notSupported
-
-availableActions
-	
-
-Allows you to select the discoverable endpoints that may require attention.
-	
-
-    immediateActionRequired: An issue occurred on the endpoint that requires user intervention.
-
-    unmanaged: The endpoints are discoverable on your network but do not have any available protection or sensor agent program installed.
-
-    sensorUpdateRequired: The endpoint has an older version of the Endpoint Sensor component installed (including Activity Monitoring and Apex One Endpoint Sensor) and should update to the latest Trend Vision One version.
-
-    sepMaintenanceRecommended: The Standard Endpoint Protection endpoint does not have XDR Endpoint Sensor. The Detection and Response feature is off.
-
-    a1MaintenanceRecommended: The Apex One endpoint does not have XDR Endpoint Sensor. The Detection and Response feature is off.
-
-    swpMaintenanceRecommended: The Server & Workload Protection endpoint does not have XDR Endpoint Sensor. The Detection and Response feature is off.
-
-    c1MaintenanceRecommended: The Cloud One endpoint does not have XDR Endpoint Sensor. The Detection and Response feature is off.
-
-    sensorDisabled: The Managed Endpoint and Detection and Response features are off. The endpoint has XDR Endpoint Sensor.
-
-securityDeployment
-	
-
-Allows you to select the endpoints that have a protection or sensor agent installed.
-	
-
-    managed: All endpoints on your network that have a protection or sensor agent installed
-
-    userProtection: All endpoints that have the Standard Endpoint Protection agent installed (includes endpoints that also have the Endpoint sensor detection and response feature enabled)
-
-    workloadProtection: All endpoints that have the Server & Workload agent installed (includes endpoints that also have the Endpoint sensor detection and response feature enabled)
-
-    sensorOnly: All endpoints that only have the Sensor agent installed (endpoints that are not protected by a Standard Endpoint Protection or Server & Workload Protection agent)
-
-    connectedEndpointProtection: All endpoints that have protection or sensor agent installed from a connected product.
-
 Supported operators:
 Operator 	Description
 eq 	Operator 'equal to'
@@ -1286,21 +986,86 @@ Note:
     If a query contains both eppAgent<FeatureName> and eppComponentVersion, only one value of eppComponentVersion is included in the results.
     availableActions and securityDeployment are not returned in response.
 
-`
+Values of availableActions:
+
+    immediateActionRequired: An issue occurred on the endpoint that requires user intervention.
+    unmanaged: The endpoints are discoverable on your network but do not have any available protection or sensor agent program installed.
+    sensorUpdateRequired: The endpoint has an older version of the Endpoint Sensor component installed (including Activity Monitoring and Apex One Endpoint Sensor) and should update to the latest Trend Vision One version.
+    sepMaintenanceRecommended: The Standard Endpoint Protection endpoint does not have XDR Endpoint Sensor. The Detection and Response feature is off.
+    a1MaintenanceRecommended: The Apex One endpoint does not have XDR Endpoint Sensor. The Detection and Response feature is off.
+    swpMaintenanceRecommended: The Server & Workload Protection endpoint does not have XDR Endpoint Sensor. The Detection and Response feature is off.
+    c1MaintenanceRecommended: The Cloud One endpoint does not have XDR Endpoint Sensor. The Detection and Response feature is off.
+    sensorDisabled: The Managed Endpoint and Detection and Response features are off. The endpoint has XDR Endpoint Sensor.
+
+Values of securityDeployment:
 
-var FilterEndpointTasks = `
+    managed: All endpoints on your network that have a protection or sensor agent installed
+    userProtection: All endpoints that have the Standard Endpoint Protection agent installed (includes endpoints that also have the Endpoint sensor detection and response feature enabled)
+    workloadProtection: All endpoints that have the Server & Workload agent installed (includes endpoints that also have the Endpoint sensor detection and response feature enabled)
+    sensorOnly: All endpoints that only have the Sensor agent installed (endpoints that are not protected by a Standard Endpoint Protection or Server & Workload Protection agent)
+    connectedEndpointProtection: All endpoints that have protection or sensor agent installed from a connected product.
+`,
+	Examples: []FilterExample{
+		{Filter: "not (osName eq 'Windows') and eppAgentAntiMalwareScans eq 'enabled'"},
+	},
+	Fields: []FilterField{
+		{Name: "endpointName", Description: "The name of the endpoint."},
+		{Name: "agentGuid", Description: "The ID of the endpoint on the Trend Vision One platform."},
+		{Name: "type", Description: "The type of endpoint.", Values: []string{"desktop", "server"}},
+		{Name: "edrSensorAdvancedRiskTelemetryStatus", Description: "The status of the advanced risk telemetry on the endpoint.", Values: []string{"enabled", "disabled", "enabling", "disabling", "unknown"}},
+		{Name: "edrSensorConnectivity", Description: "The connectivity of the sensor installed on the endpoint.", Values: []string{"connected", "disconnected"}},
+		{Name: "eppAgentProtectionManager", Description: "The name of your protection manager."},
+		{Name: "eppAgentEndpointGroup", Description: "The name of the endpoint group."},
+		{Name: "osName", Description: "The operating system of the endpoint."},
+		{Name: "osPlatform", Description: "The platform of the operating system of the endpoint.", Values: []string{"windows", "mac", "linux", "unix", "unknown"}},
+		{Name: "serviceGatewayOrProxy", Description: "The endpoints routed through a Service Gateway or Proxy"},
+		{Name: "osArchitecture", Description: "The type of operating system running on the endpoint.", Values: []string{"x86", "x86_64", "ppc64le", "sparc", "powerpc", "aarch64"}},
+		{Name: "versionControlPolicy", Description: "The name of the version control policy."},
+		{Name: "agentUpdateStatus", Description: "The status of the agent update policy.", Values: []string{"onSchedule", "pause", "disable", "notSupported"}},
+		{Name: "agentUpdatePolicy", Description: "The agent update policy version used for version control policy APIs."},
+		{Name: "creditAllocatedLicenses", Description: "The features enabled on the endpoint", Values: []string{"Endpoint sensor detection and response", "Advanced Endpoint Security", "Advanced Server & Workload Protection", "SAP Scanner for Trend Vision One - Endpoint Security (Pro)"}},
+		{Name: "securityPolicy", Description: "The name of the security policy applied to the endpoint"},
+		{Name: "securityPolicyOverriddenStatus", Description: "Whether the endpoint security policy was overridden", Values: []string{"enabled", "disabled"}},
+		{Name: "edrSensorStatus", Description: "The status of the endpoint sensor detection and response.", Values: []string{"enabled", "disabled", "enabling", "disabling", "unknown"}},
+		{Name: "edrSensorComponentUpdatePolicy", Description: "The version control update policy for the module/pattern of the sensor installed on the endpoint."},
+		{Name: "edrSensorComponentUpdateStatus", Description: "The status of the module/pattern updates of the sensor installed on the endpoint.", Values: []string{"pause", "onSchedule", "notSupported"}},
+		{Name: "eppAgentStatus", Description: "The connectivity status of the endpoint protection agent.", Values: []string{"on", "off", "unknown"}},
+		{Name: "eppAgentPolicyName", Description: "The name of a policy from your protection manager."},
+		{Name: "isolationStatus", Description: "Indicates if an endpoint is isolated.", Values: []string{"on", "off", "unknown"}},
+		{Name: "eppAgentComponentVersion", Description: "The agent component version.", Values: []string{"outdatedVersion", "latestVersion", "unknownVersions", "controlledLatestVersion"}},
+		{Name: "eppAgentComponentUpdatePolicy", Description: "The update policy for the module/pattern of the agent installed on the endpoint."},
+		{Name: "eppAgentComponentUpdateStatus", Description: "The status of the module/pattern updates of the agent installed on the endpoint.", Values: []string{"pause", "onSchedule", "notSupported"}},
+		{Name: "eppAgentAntiMalwareScans", Description: "The status of the features supported by the endpoint protection agent.", Values: []string{"enabled", "disabled", "notSupported"}},
+		{Name: "eppAgentBehaviorMonitoring", Description: "The status of the features supported by the endpoint protection agent.", Values: []string{"enabled", "disabled", "notSupported"}},
+		{Name: "eppAgentPredictiveMachineLearning", Description: "The status of the features supported by the endpoint protection agent.", Values: []string{"enabled", "disabled", "notSupported"}},
+		{Name: "eppAgentWebReputation", Description: "The status of the features supported by the endpoint protection agent.", Values: []string{"enabled", "disabled", "notSupported"}},
+		{Name: "eppAgentSuspiciousConnectionSettings", Description: "The status of the features supported by the endpoint protection agent.", Values: []string{"enabled", "disabled", "notSupported"}},
+		{Name: "eppAgentVulnerabilityProtection", Description: "The status of the features supported by the endpoint protection agent.", Values: []string{"enabled", "disabled", "notSupported"}},
+		{Name: "eppAgentDeviceControl", Description: "The status of the features supported by the endpoint protection agent.", Values: []string{"enabled", "disabled", "notSupported"}},
+		{Name: "eppAgentApplicationControl", Description: "The status of the features supported by the endpoint protection agent.", Values: []string{"enabled", "disabled", "notSupported"}},
+		{Name: "eppAgentFirewall", Description: "The status of the features supported by the endpoint protection agent.", Values: []string{"enabled", "disabled", "notSupported"}},
+		{Name: "eppAgentIntegratedEndpointSensor", Description: "The status of the features supported by the endpoint protection agent.", Values: []string{"enabled", "disabled", "notSupported"}},
+		{Name: "eppAgentDataLossPrevention", Description: "The status of the features supported by the endpoint protection agent.", Values: []string{"enabled", "disabled", "notSupported"}},
+		{Name: "eppAgentSmartFeedback", Description: "The status of the features supported by the endpoint protection agent.", Values: []string{"enabled", "disabled", "notSupported"}},
+		{Name: "eppAgentAntiMalware", Description: "The status of the features supported by the endpoint protection agent.", Values: []string{"enabled", "disabled", "notSupported"}},
+		{Name: "eppAgentActivityMonitoring", Description: "The status of the features supported by the endpoint protection agent.", Values: []string{"enabled", "disabled", "notSupported"}},
+		{Name: "eppAgentIntrusionPreventionSystem", Description: "The status of the features supported by the endpoint protection agent.", Values: []string{"enabled", "disabled", "notSupported"}},
+		{Name: "eppAgentLogInspection", Description: "The status of the features supported by the endpoint protection agent.", Values: []string{"enabled", "disabled", "notSupported"}},
+		{Name: "eppAgentIntegrityMonitoring", Description: "The status of the features supported by the endpoint protection agent.", Values: []string{"enabled", "disabled", "notSupported"}},
+		{Name: "eppAgentAgentSelfProtection", Description: "The status of the features supported by the endpoint protection agent.", Values: []string{"enabled", "disabled", "notSupported"}},
+		{Name: "eppAgentSAPScanner", Description: "The status of the features supported by the endpoint protection agent.", Values: []string{"enabled", "disabled", "notSupported"}},
+		{Name: "eppAgentSecurityAgentPasswordUnlock", Description: "The status of the features supported by the endpoint protection agent.", Values: []string{"enabled", "disabled", "notSupported"}},
+		{Name: "availableActions", Description: "Allows you to select the discoverable endpoints that may require attention.", Values: []string{"immediateActionRequired", "unmanaged", "sensorUpdateRequired", "sepMaintenanceRecommended", "a1MaintenanceRecommended", "swpMaintenanceRecommended", "c1MaintenanceRecommended", "sensorDisabled"}},
+		{Name: "securityDeployment", Description: "Allows you to select the endpoints that have a protection or sensor agent installed.", Values: []string{"managed", "userProtection", "workloadProtection", "sensorOnly", "connectedEndpointProtection"}},
+	},
+}
+
+var FilterEndpointTasks = Filter{
+	Text: `
 string <= 1024 characters
-Example: status eq 'succeeded'
 
 The filter for retrieving a subset of the task list.
 
-Supported fields:
-Field 	Description 	Supported values
-id 	The ID of the task 	Any value
-agentGuid 	The ID of the endpoint on the Trend Vision One platform 	Any value
-status 	The status of the task 	running,succeeded,failed
-action 	The type of the task 	export,delete
-
 Supported operators:
 Operator 	Description
 eq 	Operator 'equal to'
@@ -1308,26 +1073,24 @@ and 	Operator 'and'
 or 	Operator 'or'
 not 	Operator 'not'
 () 	Symbols for grouping operands
-`
-
-var FilterSuspiciousObjects = `
+`,
+	Examples: []FilterExample{
+		{Filter: "status eq 'succeeded'"},
+	},
+	Fields: []FilterField{
+		{Name: "id", Description: "The ID of the task"},
+		{Name: "agentGuid", Description: "The ID of the endpoint on the Trend Vision One platform"},
+		{Name: "status", Description: "The status of the task", Values: []string{"running", "succeeded", "failed"}},
+		{Name: "action", Description: "The type of the task", Values: []string{"export", "delete"}},
+	},
+}
+
+var FilterSuspiciousObjects = Filter{
+	Text: `
 string <= 4000 characters
-Example: type eq 'url' AND riskLevel eq 'high'
 
 Filter for retrieving a subset of the Suspicious Object List.
 
-Supported fields:
-Field 	Description 	Possible values
-type 	The type of a suspicious object 	url, domain, senderMailAddress, ip, fileSha1, fileSha256
-url 	Suspicious URL 	Any value
-domain 	Suspicious domain name 	Any value
-ip 	Suspicious IP address 	Any value
-senderMailAddress 	Suspicious email address 	Any value
-fileSha1 	SHA1 hash associated to a suspicious file 	Any value
-fileSha256 	SHA256 hash associated to a suspicious file 	Any value
-scanAction 	Action that connected products apply after detecting a suspicious object 	block, log
-riskLevel 	Risk level of a suspicious object 	high, medium, low
-
 Supported operators:
 Operator 	Description
 eq 	Operator 'equal to'
@@ -1335,24 +1098,29 @@ and 	Operator 'and'
 or 	Operator 'or'
 not 	Operator 'not'
 () 	Symbols for grouping operands with their correct operator
-`
-
-var FilterSuspiciousObjectExceptions = `
+`,
+	Examples: []FilterExample{
+		{Filter: "type eq 'url' AND riskLevel eq 'high'"},
+	},
+	Fields: []FilterField{
+		{Name: "type", Description: "The type of a suspicious object", Values: []string{"url", "domain", "senderMailAddress", "ip", "fileSha1", "fileSha256"}},
+		{Name: "url", Description: "Suspicious URL"},
+		{Name: "domain", Description: "Suspicious domain name"},
+		{Name: "ip", Description: "Suspicious IP address"},
+		{Name: "senderMailAddress", Description: "Suspicious email address"},
+		{Name: "fileSha1", Description: "SHA1 hash associated to a suspicious file"},
+		{Name: "fileSha256", Description: "SHA256 hash associated to a suspicious file"},
+		{Name: "scanAction", Description: "Action that connected products apply after detecting a suspicious object", Values: []string{"block", "log"}},
+		{Name: "riskLevel", Description: "Risk level of a suspicious object", Values: []string{"high", "medium", "low"}},
+	},
+}
+
+var FilterSuspiciousObjectExceptions = Filter{
+	Text: `
 string <= 4000 characters
-Example: type eq 'url' AND url eq '*.example.com'
 
 Filter for retrieving a subset of the Exception List.
 
-Supported fields:
-Field 	Description 	Possible values
-type 	The type of a suspicious object 	url, domain, senderMailAddress, ip, fileSha1, fileSha256
-url 	Exception URL 	Any value
-domain 	Exception domain name 	Any value
-ip 	Exception IP address 	Any value
-senderMailAddress 	Exception email address 	Any value
-fileSha1 	SHA1 hash identifying a file exception 	Any value
-fileSha256 	SHA256 hash identifying a file exception 	Any value
-
 Supported operators:
 Operator 	Description
 eq 	Operator 'equal to'
@@ -1360,19 +1128,27 @@ and 	Operator 'and'
 or 	Operator 'or'
 not 	Operator 'not'
 () 	Symbols for grouping operands with their correct operator
-`
-
-var FilterIntelligenceReports = `
+`,
+	Examples: []FilterExample{
+		{Filter: "type eq 'url' AND url eq '*.example.com'"},
+	},
+	Fields: []FilterField{
+		{Name: "type", Description: "The type of a suspicious object", Values: []string{"url", "domain", "senderMailAddress", "ip", "fileSha1", "fileSha256"}},
+		{Name: "url", Description: "Exception URL"},
+		{Name: "domain", Description: "Exception domain name"},
+		{Name: "ip", Description: "Exception IP address"},
+		{Name: "senderMailAddress", Description: "Exception email address"},
+		{Name: "fileSha1", Description: "SHA1 hash identifying a file exception"},
+		{Name: "fileSha256", Description: "SHA256 hash identifying a file exception"},
+	},
+}
+
+var FilterIntelligenceReports = Filter{
+	Text: `
 string <= 4000 characters
-Example: id eq 'report--2c1091ba-a7d2-46b2-bf97-4137916c30cb' AND name eq 'Report1'
 
 Filter for retrieving a subset of the custom intelligence reports list.
 
-Supported fields:
-Field 	Description
-id 	Unique alphanumeric string that identifies a custom intelligence report
-name 	Title of a custom intelligence report (needs to be included with single quotation marks)
-
 Supported operators:
 Operator 	Description
 eq 	Operator 'equal to'
@@ -1380,21 +1156,22 @@ and 	Operator 'and'
 or 	Operator 'or'
 not 	Operator 'not'
 () 	Symbols for grouping operands with their correct operator
-`
-
-var FilterThreatIntelTasks = `
+`,
+	Examples: []FilterExample{
+		{Filter: "id eq 'report--2c1091ba-a7d2-46b2-bf97-4137916c30cb' AND name eq 'Report1'"},
+	},
+	Fields: []FilterField{
+		{Name: "id", Description: "Unique alphanumeric string that identifies a custom intelligence report"},
+		{Name: "name", Description: "Title of a custom intelligence report (needs to be included with single quotation marks)"},
+	},
+}
+
+var FilterThreatIntelTasks = Filter{
+	Text: `
 string <= 4000 characters
-Example: sweepType eq 'manual' AND isHit eq true
 
 Filter for retrieving a subset of the sweeping task list.
 
-Supported fields:
-Field 	Description 	Possible values
-id 	Unique alphanumeric string that identifies a sweeping task 	Any value
-sweepType 	Type of sweeping task 	schedule, manual, stixShifter
-isHit 	States whether indicators were matched during a sweeping task 	true, false
-status 	Status of a sweeping task 	notstarted, running, succeeded, failed
-
 Supported operators:
 Operator 	Description
 eq 	Operator 'equal to'
@@ -1402,19 +1179,24 @@ and 	Operator 'and'
 or 	Operator 'or'
 not 	Operator 'not'
 () 	Symbols for grouping operands with their correct operator
-`
-
-var FilterThreatIntelFeeds = `
+`,
+	Examples: []FilterExample{
+		{Filter: "sweepType eq 'manual' AND isHit eq true"},
+	},
+	Fields: []FilterField{
+		{Name: "id", Description: "Unique alphanumeric string that identifies a sweeping task"},
+		{Name: "sweepType", Description: "Type of sweeping task", Values: []string{"schedule", "manual", "stixShifter"}},
+		{Name: "isHit", Description: "States whether indicators were matched during a sweeping task", Values: []string{"true", "false"}},
+		{Name: "status", Description: "Status of a sweeping task", Values: []string{"notstarted", "running", "succeeded", "failed"}},
+	},
+}
+
+var FilterThreatIntelFeeds = Filter{
+	Text: `
 string <= 4000 characters
-Example: (location eq 'Brazil' or location eq 'No specified locations') and (industry in ('Finance', 'Health', 'No specified industries'))
 
 Defines the criteria for retrieving specific subsets of intelligence objects from the Trend Threat Intelligence Feed by applying contextual relationship-based filtering.
 
-Supported fields:
-Field 	Description
-location 	Filters intelligence reports based on the associated location (e.g., 'Canada', 'United States of America', 'No specified locations')
-industry 	Filters intelligence reports based on the associated industry sector (e.g., 'Finance', 'Technology', 'Government', 'No specified industries')
-
 Supported operators:
 Operator 	Description
 eq 	Equals
@@ -1430,4 +1212,12 @@ Example queries:
 - AND condition: location eq 'France' and industry eq 'Technology'
 - NOT condition: not (location eq 'Germany')
 - IN operator: industry in ('Financial Services', 'Insurance', 'Healthcare')
-`
+`,
+	Examples: []FilterExample{
+		{Filter: "(location eq 'Brazil' or location eq 'No specified locations') and (industry in ('Finance', 'Health', 'No specified industries'))"},
+	},
+	Fields: []FilterField{
+		{Name: "location", Description: "Filters intelligence reports based on the associated location (e.g., 'Canada', 'United States of America', 'No specified locations')"},
+		{Name: "industry", Description: "Filters intelligence reports based on the associated industry sector (e.g., 'Finance', 'Technology', 'Government', 'No specified industries')"},
+	},
+}
@@ -0,0 +1,69 @@
+package tooldescriptions
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Filter documents the filter argument of a tool. The description of the
+// argument is generated from it, so the fields the server validates and
+// enumerates are always the ones the model is shown.
+type Filter struct {
+	// Text describes the filter, its operators and notes.
+	Text     string
+	Examples []FilterExample
+	// Fields are the supported fields of the filter.
+	Fields []FilterField
+}
+
+// FilterExample is an example filter with an optional explanation.
+type FilterExample struct {
+	Filter      string
+	Description string
+}
+
+// FilterField is a supported field of a filter.
+type FilterField struct {
+	Name        string
+	Description string
+	// Values are the supported values of the field. Empty when any value is supported.
+	Values     []string
+	Deprecated bool
+}
+
+// Description returns the description of the filter argument: the text
+// followed by the examples and the table of the supported fields.
+func (f Filter) Description() string {
+	var b strings.Builder
+	b.WriteString("\n")
+	b.WriteString(strings.TrimSpace(f.Text))
+	b.WriteString("\n")
+
+	if len(f.Examples) > 0 {
+		b.WriteString("\nExamples:\n\n")
+		for _, example := range f.Examples {
+			if example.Description == "" {
+				fmt.Fprintf(&b, "    %s\n", example.Filter)
+			} else {
+				fmt.Fprintf(&b, "    %s - %s\n", example.Filter, example.Description)
+			}
+		}
+	}
+
+	if len(f.Fields) > 0 {
+		b.WriteString("\nSupported fields:\nField \tDescription \tSupported values\n")
+		for _, field := range f.Fields {
+			name := field.Name
+			if field.Deprecated {
+				name += " (Deprecated)"
+			}
+			values := "Any value"
+			if len(field.Values) > 0 {
+				values = strings.Join(field.Values, ", ")
+			}
+			fmt.Fprintf(&b, "%s \t%s \t%s\n", name, field.Description, values)
+		}
+	}
+
+	return b.String()
+}
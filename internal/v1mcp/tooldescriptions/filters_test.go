@@ -0,0 +1,34 @@
+package tooldescriptions
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilterDescription(t *testing.T) {
+	filter := Filter{
+		Text:     "Filter for retrieving a subset of the alert list.\n",
+		Examples: []FilterExample{{Filter: "severity eq 'high'"}, {Filter: "id eq 'WB-1'", Description: "Filters by ID"}},
+		Fields: []FilterField{
+			{Name: "id", Description: "The unique identifier of an alert"},
+			{Name: "severity", Description: "The severity of the alert", Values: []string{"high", "low"}},
+			{Name: "investigationStatus", Description: "The status of the alert", Deprecated: true},
+		},
+	}
+
+	require.Equal(t, `
+Filter for retrieving a subset of the alert list.
+
+Examples:
+
+    severity eq 'high'
+    id eq 'WB-1' - Filters by ID
+
+Supported fields:
+Field 	Description 	Supported values
+id 	The unique identifier of an alert 	Any value
+severity 	The severity of the alert 	high, low
+investigationStatus (Deprecated) 	The status of the alert 	Any value
+`, filter.Description())
+}
@@ -2,6 +2,8 @@ package tools
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"net/http"
 
 	"github.com/mark3labs/mcp-go/mcp"
@@ -10,17 +12,61 @@ import (
 	mcpserver "github.com/mark3labs/mcp-go/server"
 )
 
-var ToolsetsReadOnlyAISecurity = []func(*v1client.V1ApiClient) mcpserver.ServerTool{
-	toolAISecurityApplyGuardrails,
+const (
+	requestTypeSimple                 = "SimpleRequestGuard"
+	requestTypeChatCompletionRequest  = "OpenAIChatCompletionRequestV1"
+	requestTypeChatCompletionResponse = "OpenAIChatCompletionResponseV1"
+)
+
+var aiSecurityToolCallSchema = map[string]any{
+	"type": "object",
+	"properties": map[string]any{
+		"id": map[string]any{
+			"type":        "string",
+			"description": "The ID of the tool call",
+		},
+		"type": map[string]any{
+			"type": "string",
+			"enum": []string{"function"},
+		},
+		"function": map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"name": map[string]any{
+					"type":        "string",
+					"description": "The name of the function to call",
+				},
+				"arguments": map[string]any{
+					"type":        "string",
+					"description": "The JSON encoded arguments generated by the model",
+				},
+			},
+			"required": []string{"name", "arguments"},
+		},
+	},
+	"required": []string{"id", "type", "function"},
+}
+
+// aiSecurityGuardrailsDecision is the structured result of the aisecurity_guardrails_apply tool.
+type aiSecurityGuardrailsDecision struct {
+	ID      string   `json:"id,omitempty" jsonschema:"description=The ID of the evaluation"`
+	Action  string   `json:"action" jsonschema:"description=The recommended action,enum=Allow,enum=Block"`
+	Reasons []string `json:"reasons" jsonschema:"description=The policy violations that led to a Block action"`
+	// Details holds the remaining fields of a detailed (return=representation) evaluation.
+	Details map[string]any `json:"details,omitempty" jsonschema:"description=Detailed findings such as harmful content and prompt attacks when prefer is return=representation"`
 }
 
 func toolAISecurityApplyGuardrails(client *v1client.V1ApiClient) mcpserver.ServerTool {
 	return mcpserver.ServerTool{
 		Tool: mcp.NewTool(
 			"aisecurity_guardrails_apply",
-			mcp.WithDescription("Evaluates prompts against AI guard policies and returns the recommended action (Allow/Block) with reasons for any policy violations detected"),
+			mcp.WithDescription("Evaluates prompts, chat completion requests or chat completion responses against AI guard policies and returns the recommended action (Allow/Block) with reasons for any policy violations detected"),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
-				ReadOnlyHint: toPtr(true),
+				Title:           "Apply AI Guard Guardrails",
+				ReadOnlyHint:    toPtr(true),
+				DestructiveHint: toPtr(false),
+				IdempotentHint:  toPtr(true),
+				OpenWorldHint:   toPtr(false),
 			}),
 			mcp.WithString("applicationName",
 				mcp.Required(),
@@ -30,34 +76,87 @@ func toolAISecurityApplyGuardrails(client *v1client.V1ApiClient) mcpserver.Serve
 				mcp.Description("A simple text prompt to evaluate (max 1024 characters). Use this for SimpleRequestGuard request type."),
 			),
 			mcp.WithArray("messages",
-				mcp.Description("A list of chat messages for OpenAI chat completion format. Each message should have 'role' (system/user/assistant) and 'content' fields. Use this for OpenAIChatCompletionRequestV1 request type."),
+				mcp.Description("A list of chat messages for OpenAI chat completion format. Each message should have 'role' (system/user/assistant/tool) and 'content' fields. Assistant messages may contain 'tool_calls' and tool messages must reference the call with 'tool_call_id'. Use this for OpenAIChatCompletionRequestV1 request type."),
 				mcp.Items(map[string]any{
 					"type": "object",
 					"properties": map[string]any{
 						"role": map[string]any{
 							"type":        "string",
-							"enum":        []string{"system", "user", "assistant"},
+							"enum":        []string{"system", "user", "assistant", "tool"},
 							"description": "The role of the entity that creates the message",
 						},
 						"content": map[string]any{
 							"type":        "string",
 							"description": "The text content of the message",
 						},
+						"name": map[string]any{
+							"type":        "string",
+							"description": "An optional name for the participant",
+						},
+						"tool_calls": map[string]any{
+							"type":        "array",
+							"description": "The tool calls requested by an assistant message",
+							"items":       aiSecurityToolCallSchema,
+						},
+						"tool_call_id": map[string]any{
+							"type":        "string",
+							"description": "The tool call that a tool message is responding to",
+						},
+					},
+					"required": []string{"role"},
+				}),
+			),
+			mcp.WithObject("response",
+				mcp.Description("A complete OpenAI chat completion response object as returned by the model, including 'choices' and optionally 'id', 'object', 'created', 'model' and 'usage'. Use this for OpenAIChatCompletionResponseV1 request type."),
+				mcp.Properties(map[string]any{
+					"id":                 map[string]any{"type": "string"},
+					"object":             map[string]any{"type": "string"},
+					"created":            map[string]any{"type": "integer"},
+					"model":              map[string]any{"type": "string"},
+					"system_fingerprint": map[string]any{"type": "string"},
+					"choices": map[string]any{
+						"type": "array",
+						"items": map[string]any{
+							"type": "object",
+							"properties": map[string]any{
+								"index": map[string]any{"type": "integer"},
+								"message": map[string]any{
+									"type": "object",
+									"properties": map[string]any{
+										"role":       map[string]any{"type": "string", "enum": []string{"assistant"}},
+										"content":    map[string]any{"type": "string"},
+										"refusal":    map[string]any{"type": "string"},
+										"tool_calls": map[string]any{"type": "array", "items": aiSecurityToolCallSchema},
+									},
+									"required": []string{"role"},
+								},
+								"finish_reason": map[string]any{"type": "string"},
+							},
+							"required": []string{"message"},
+						},
+					},
+					"usage": map[string]any{
+						"type": "object",
+						"properties": map[string]any{
+							"prompt_tokens":     map[string]any{"type": "integer"},
+							"completion_tokens": map[string]any{"type": "integer"},
+							"total_tokens":      map[string]any{"type": "integer"},
+						},
 					},
-					"required": []string{"role", "content"},
 				}),
 			),
 			mcp.WithString("model",
-				mcp.Description("The AI model identifier when using OpenAI chat completion format (e.g., 'us.meta.llama3-1-70b-instruct-v1:0')"),
+				mcp.Description("The AI model identifier when using OpenAI chat completion request format (e.g., 'us.meta.llama3-1-70b-instruct-v1:0')"),
 			),
 			mcp.WithString("requestType",
-				mcp.Description("The type of request being evaluated"),
-				mcp.Enum("SimpleRequestGuard", "OpenAIChatCompletionRequestV1", "OpenAIChatCompletionResponseV1"),
+				mcp.Description("The type of request being evaluated. Inferred from the provided prompt, messages or response when omitted."),
+				mcp.Enum(requestTypeSimple, requestTypeChatCompletionRequest, requestTypeChatCompletionResponse),
 			),
 			mcp.WithString("prefer",
 				mcp.Description("Controls response detail level. 'return=representation' for detailed evaluation including harmful content, sensitive information, and prompt attacks. 'return=minimal' for shorter response with just action and reasons."),
 				mcp.Enum("return=representation", "return=minimal"),
 			),
+			mcp.WithOutputSchema[aiSecurityGuardrailsDecision](),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			applicationName, err := requiredValue[string]("applicationName", request.GetArguments())
@@ -85,41 +184,43 @@ func toolAISecurityApplyGuardrails(client *v1client.V1ApiClient) mcpserver.Serve
 				return mcp.NewToolResultError(err.Error()), nil
 			}
 
-			// Parse messages if provided
 			var messages []v1client.AISecurityChatMessage
-			if rawMessages, ok := request.GetArguments()["messages"].([]any); ok && len(rawMessages) > 0 {
-				for _, rawMsg := range rawMessages {
-					msgMap, ok := rawMsg.(map[string]any)
-					if !ok {
-						return mcp.NewToolResultError("each message must be an object with 'role' and 'content' fields"), nil
-					}
-
-					role, ok := msgMap["role"].(string)
-					if !ok {
-						return mcp.NewToolResultError("message 'role' must be a string"), nil
-					}
-
-					content, ok := msgMap["content"].(string)
-					if !ok {
-						return mcp.NewToolResultError("message 'content' must be a string"), nil
-					}
-
-					messages = append(messages, v1client.AISecurityChatMessage{
-						Role:    role,
-						Content: content,
-					})
-				}
+			if err := optionalJSONValue("messages", request.GetArguments(), &messages); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
 			}
 
-			// Validate that either prompt or messages is provided
-			if prompt == "" && len(messages) == 0 {
-				return mcp.NewToolResultError("either 'prompt' or 'messages' must be provided"), nil
+			var response *v1client.AISecurityChatCompletionResponse
+			if err := optionalJSONValue("response", request.GetArguments(), &response); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
 			}
 
-			input := v1client.AISecurityApplyGuardrailsInput{
-				Prompt:   prompt,
-				Model:    model,
-				Messages: messages,
+			requestType, err = guardrailsRequestType(requestType, prompt, messages, response)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			input := v1client.AISecurityApplyGuardrailsInput{}
+
+			switch requestType {
+			case requestTypeSimple:
+				input.Prompt = prompt
+			case requestTypeChatCompletionRequest:
+				if err := validateGuardrailsMessages(messages); err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+				input.Model = model
+				input.Messages = messages
+			case requestTypeChatCompletionResponse:
+				if err := validateGuardrailsResponse(response); err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+				input.ID = response.ID
+				input.Object = response.Object
+				input.Created = response.Created
+				input.Model = response.Model
+				input.SystemFingerprint = response.SystemFingerprint
+				input.Choices = response.Choices
+				input.Usage = response.Usage
 			}
 
 			opts := v1client.AISecurityApplyGuardrailsOptions{
@@ -129,7 +230,137 @@ func toolAISecurityApplyGuardrails(client *v1client.V1ApiClient) mcpserver.Serve
 			}
 
 			resp, err := client.AISecurityApplyGuardrails(input, opts)
-			return handleStatusResponse(resp, err, http.StatusOK, "failed to apply guardrails")
+			result, err := handleStatusResponse(resp, err, http.StatusOK, "failed to apply guardrails")
+			if err != nil || result.IsError {
+				return result, err
+			}
+
+			return guardrailsDecisionResult(result), nil
 		},
 	}
 }
+
+// guardrailsRequestType checks that the provided input matches the request type.
+// When no request type is given it is inferred from the input.
+func guardrailsRequestType(
+	requestType string,
+	prompt string,
+	messages []v1client.AISecurityChatMessage,
+	response *v1client.AISecurityChatCompletionResponse,
+) (string, error) {
+	provided := []string{}
+	if prompt != "" {
+		provided = append(provided, requestTypeSimple)
+	}
+	if len(messages) > 0 {
+		provided = append(provided, requestTypeChatCompletionRequest)
+	}
+	if response != nil {
+		provided = append(provided, requestTypeChatCompletionResponse)
+	}
+
+	if len(provided) == 0 {
+		return "", fmt.Errorf("one of 'prompt', 'messages' or 'response' must be provided")
+	}
+
+	if len(provided) > 1 {
+		return "", fmt.Errorf("only one of 'prompt', 'messages' or 'response' can be provided")
+	}
+
+	if requestType == "" {
+		return provided[0], nil
+	}
+
+	if requestType != provided[0] {
+		switch requestType {
+		case requestTypeSimple:
+			return "", fmt.Errorf("requestType %s requires 'prompt'", requestType)
+		case requestTypeChatCompletionRequest:
+			return "", fmt.Errorf("requestType %s requires 'messages'", requestType)
+		case requestTypeChatCompletionResponse:
+			return "", fmt.Errorf("requestType %s requires 'response'", requestType)
+		default:
+			return "", fmt.Errorf("unsupported requestType %q", requestType)
+		}
+	}
+
+	return requestType, nil
+}
+
+func validateGuardrailsMessages(messages []v1client.AISecurityChatMessage) error {
+	for i, m := range messages {
+		switch m.Role {
+		case "system", "user":
+			if m.Content == "" {
+				return fmt.Errorf("messages[%d]: %s message requires 'content'", i, m.Role)
+			}
+		case "assistant":
+			if m.Content == "" && len(m.ToolCalls) == 0 {
+				return fmt.Errorf("messages[%d]: assistant message requires 'content' or 'tool_calls'", i)
+			}
+		case "tool":
+			if m.ToolCallID == "" {
+				return fmt.Errorf("messages[%d]: tool message requires 'tool_call_id'", i)
+			}
+		default:
+			return fmt.Errorf("messages[%d]: unsupported role %q", i, m.Role)
+		}
+
+		if len(m.ToolCalls) > 0 && m.Role != "assistant" {
+			return fmt.Errorf("messages[%d]: only assistant messages can contain 'tool_calls'", i)
+		}
+	}
+	return nil
+}
+
+func validateGuardrailsResponse(response *v1client.AISecurityChatCompletionResponse) error {
+	if len(response.Choices) == 0 {
+		return fmt.Errorf("response requires at least one choice")
+	}
+
+	for i, c := range response.Choices {
+		if c.Message.Role != "assistant" {
+			return fmt.Errorf("response.choices[%d]: message role must be 'assistant'", i)
+		}
+		if c.Message.Content == "" && len(c.Message.ToolCalls) == 0 && c.Message.Refusal == nil {
+			return fmt.Errorf("response.choices[%d]: message requires 'content', 'tool_calls' or 'refusal'", i)
+		}
+	}
+	return nil
+}
+
+// guardrailsDecisionResult adds the parsed evaluation as structured content.
+// Unexpected response bodies are returned as errors, since the tool declares
+// the decision as its output.
+func guardrailsDecisionResult(result *mcp.CallToolResult) *mcp.CallToolResult {
+	text, ok := mcp.AsTextContent(result.Content[0])
+	if !ok {
+		return mcp.NewToolResultError("unexpected guardrails response without text content")
+	}
+
+	evaluation := v1client.AISecurityApplyGuardrailsResult{}
+	details := map[string]any{}
+	if err := json.Unmarshal([]byte(text.Text), &evaluation); err != nil || evaluation.Action == "" {
+		return mcp.NewToolResultError(fmt.Sprintf("unexpected guardrails response without an action: %s", text.Text))
+	}
+	if err := json.Unmarshal([]byte(text.Text), &details); err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("unexpected guardrails response: %s", err))
+	}
+	delete(details, "id")
+	delete(details, "action")
+	delete(details, "reasons")
+
+	decision := aiSecurityGuardrailsDecision{
+		ID:      evaluation.ID,
+		Action:  evaluation.Action,
+		Reasons: evaluation.Reasons,
+	}
+	if decision.Reasons == nil {
+		decision.Reasons = []string{}
+	}
+	if len(details) > 0 {
+		decision.Details = details
+	}
+
+	return mcp.NewToolResultStructured(decision, text.Text)
+}
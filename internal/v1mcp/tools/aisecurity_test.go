@@ -0,0 +1,79 @@
+package tools
+
+import (
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+	"github.com/trendmicro/vision-one-mcp-server/internal/v1client"
+)
+
+func TestGuardrailsRequestType(t *testing.T) {
+	messages := []v1client.AISecurityChatMessage{{Role: "user", Content: "hello"}}
+	response := &v1client.AISecurityChatCompletionResponse{}
+
+	t.Run("should infer request type from input", func(t *testing.T) {
+		rt, err := guardrailsRequestType("", "hello", nil, nil)
+		require.NoError(t, err)
+		require.Equal(t, requestTypeSimple, rt)
+
+		rt, err = guardrailsRequestType("", "", messages, nil)
+		require.NoError(t, err)
+		require.Equal(t, requestTypeChatCompletionRequest, rt)
+
+		rt, err = guardrailsRequestType("", "", nil, response)
+		require.NoError(t, err)
+		require.Equal(t, requestTypeChatCompletionResponse, rt)
+	})
+
+	t.Run("should error when input does not match request type", func(t *testing.T) {
+		_, err := guardrailsRequestType(requestTypeChatCompletionResponse, "", messages, nil)
+		require.EqualError(t, err, "requestType OpenAIChatCompletionResponseV1 requires 'response'")
+	})
+
+	t.Run("should error on missing or multiple inputs", func(t *testing.T) {
+		_, err := guardrailsRequestType("", "", nil, nil)
+		require.Error(t, err)
+
+		_, err = guardrailsRequestType("", "hello", messages, nil)
+		require.Error(t, err)
+	})
+}
+
+func TestValidateGuardrailsMessages(t *testing.T) {
+	t.Run("should accept tool call conversations", func(t *testing.T) {
+		err := validateGuardrailsMessages([]v1client.AISecurityChatMessage{
+			{Role: "user", Content: "what is the weather"},
+			{Role: "assistant", ToolCalls: []v1client.AISecurityToolCall{
+				{ID: "call_1", Type: "function", Function: v1client.AISecurityToolCallFunction{Name: "weather", Arguments: "{}"}},
+			}},
+			{Role: "tool", ToolCallID: "call_1", Content: "sunny"},
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("should reject tool message without tool_call_id", func(t *testing.T) {
+		err := validateGuardrailsMessages([]v1client.AISecurityChatMessage{{Role: "tool", Content: "sunny"}})
+		require.EqualError(t, err, "messages[0]: tool message requires 'tool_call_id'")
+	})
+}
+
+func TestGuardrailsDecisionResult(t *testing.T) {
+	t.Run("should return structured decision", func(t *testing.T) {
+		result := guardrailsDecisionResult(mcp.NewToolResultText(`{"id":"1","action":"Block","reasons":["Prompt attack"],"promptAttacks":[]}`))
+		decision, ok := result.StructuredContent.(aiSecurityGuardrailsDecision)
+		require.True(t, ok)
+		require.Equal(t, "Block", decision.Action)
+		require.Equal(t, []string{"Prompt attack"}, decision.Reasons)
+		require.Contains(t, decision.Details, "promptAttacks")
+	})
+
+	t.Run("should return an error for unexpected bodies", func(t *testing.T) {
+		for _, body := range []string{`not json`, `{"id":"1"}`} {
+			result := guardrailsDecisionResult(mcp.NewToolResultText(body))
+			require.True(t, result.IsError)
+			require.Nil(t, result.StructuredContent)
+			require.Contains(t, result.Content[0].(mcp.TextContent).Text, "unexpected guardrails response without an action: "+body)
+		}
+	})
+}
@@ -10,29 +10,25 @@ import (
 	"github.com/trendmicro/vision-one-mcp-server/internal/v1mcp/tooldescriptions"
 )
 
-var ToolsetsReadOnlyCAM = []func(*v1client.V1ApiClient) mcpserver.ServerTool{
-	toolCAMAwsAccountsList,
-	toolCAMAwsAccountGet,
-	toolCAMGcpAccountsList,
-	toolCAMGcpAccountGet,
-	toolCAMAlibabaAccountsList,
-	toolCAMAlibabaAccountGet,
-}
-
 func toolCAMAwsAccountsList(client *v1client.V1ApiClient) mcpserver.ServerTool {
 	return mcpserver.ServerTool{
 		Tool: mcp.NewTool(
 			"cam_aws_accounts_list",
 			mcp.WithDescription("List AWS accounts managed by Cloud Accounts Management"),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
-				ReadOnlyHint: toPtr(true),
+				Title:           "List AWS Cloud Accounts",
+				ReadOnlyHint:    toPtr(true),
+				DestructiveHint: toPtr(false),
+				IdempotentHint:  toPtr(true),
+				OpenWorldHint:   toPtr(false),
 			}),
 			mcp.WithString("top",
 				mcp.Description(tooldescriptions.DefaultTop),
 				mcp.Enum(camTop()...),
 			),
-			mcp.WithString("filter", mcp.Description(tooldescriptions.FilterAWSAccounts)),
+			mcp.WithString("filter", mcp.Description(tooldescriptions.FilterAWSAccounts.Description())),
 			mcp.WithString("nextBatchToken", mcp.Description("Token used to retrieve the next page of results")),
+			mcp.WithOutputSchema[listResponse[camAccount]](),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			top, err := optionalStrInt("top", request.GetArguments())
@@ -71,9 +67,14 @@ func toolCAMAwsAccountGet(client *v1client.V1ApiClient) mcpserver.ServerTool {
 			"cam_aws_account_get",
 			mcp.WithDescription("Get the details of an AWS account managed by Cloud Account Management"),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
-				ReadOnlyHint: toPtr(true),
+				Title:           "Get AWS Cloud Account",
+				ReadOnlyHint:    toPtr(true),
+				DestructiveHint: toPtr(false),
+				IdempotentHint:  toPtr(true),
+				OpenWorldHint:   toPtr(false),
 			}),
 			mcp.WithString("accountId", mcp.Required()),
+			mcp.WithOutputSchema[camAccount](),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			accountId, err := requiredValue[string]("accountId", request.GetArguments())
@@ -92,14 +93,19 @@ func toolCAMGcpAccountsList(client *v1client.V1ApiClient) mcpserver.ServerTool {
 			"cam_gcp_accounts_list",
 			mcp.WithDescription("List Google Cloud Projects managed by Cloud Account Management"),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
-				ReadOnlyHint: toPtr(true),
+				Title:           "List Google Cloud Accounts",
+				ReadOnlyHint:    toPtr(true),
+				DestructiveHint: toPtr(false),
+				IdempotentHint:  toPtr(true),
+				OpenWorldHint:   toPtr(false),
 			}),
 			mcp.WithString("top",
 				mcp.Description(tooldescriptions.DefaultTop),
 				mcp.Enum(camTop()...),
 			),
-			mcp.WithString("filter", mcp.Description(tooldescriptions.CAMListGCPProjectsFilterDescription)),
+			mcp.WithString("filter", mcp.Description(tooldescriptions.CAMListGCPProjectsFilterDescription.Description())),
 			mcp.WithString("nextBatchToken", mcp.Description("Token used to retrieve the next page of results")),
+			mcp.WithOutputSchema[listResponse[camAccount]](),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			top, err := optionalStrInt("top", request.GetArguments())
@@ -135,9 +141,14 @@ func toolCAMGcpAccountGet(client *v1client.V1ApiClient) mcpserver.ServerTool {
 			"cam_gcp_account_get",
 			mcp.WithDescription("Get the details of a GCP project managed by Cloud Account Manangement"),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
-				ReadOnlyHint: toPtr(true),
+				Title:           "Get Google Cloud Account",
+				ReadOnlyHint:    toPtr(true),
+				DestructiveHint: toPtr(false),
+				IdempotentHint:  toPtr(true),
+				OpenWorldHint:   toPtr(false),
 			}),
 			mcp.WithString("accountId", mcp.Required()),
+			mcp.WithOutputSchema[camAccount](),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			accountId, err := requiredValue[string]("accountId", request.GetArguments())
@@ -157,14 +168,19 @@ func toolCAMAlibabaAccountsList(client *v1client.V1ApiClient) mcpserver.ServerTo
 			"cam_alibaba_accounts_list",
 			mcp.WithDescription("Displays all Alibaba Cloud accounts connected to Trend Vision One in a paginated list."),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
-				ReadOnlyHint: toPtr(true),
+				Title:           "List Alibaba Cloud Accounts",
+				ReadOnlyHint:    toPtr(true),
+				DestructiveHint: toPtr(false),
+				IdempotentHint:  toPtr(true),
+				OpenWorldHint:   toPtr(false),
 			}),
 			mcp.WithString("top",
 				mcp.Description(tooldescriptions.DefaultTop),
 				mcp.Enum(camTop()...),
 			),
-			mcp.WithString("filter", mcp.Description(tooldescriptions.FilterAlibabaAccounts)),
+			mcp.WithString("filter", mcp.Description(tooldescriptions.FilterAlibabaAccounts.Description())),
 			mcp.WithString("nextBatchToken", mcp.Description("Token used to retrieve the next page of results")),
+			mcp.WithOutputSchema[listResponse[camAccount]](),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			top, err := optionalStrInt("top", request.GetArguments())
@@ -199,9 +215,14 @@ func toolCAMAlibabaAccountGet(client *v1client.V1ApiClient) mcpserver.ServerTool
 			"cam_alibaba_account_get",
 			mcp.WithDescription("Get the details of an Alibaba account managed by Cloud Account Manangement"),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
-				ReadOnlyHint: toPtr(true),
+				Title:           "Get Alibaba Cloud Account",
+				ReadOnlyHint:    toPtr(true),
+				DestructiveHint: toPtr(false),
+				IdempotentHint:  toPtr(true),
+				OpenWorldHint:   toPtr(false),
 			}),
 			mcp.WithString("accountId", mcp.Required()),
+			mcp.WithOutputSchema[camAccount](),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			accountId, err := requiredValue[string]("accountId", request.GetArguments())
@@ -10,37 +10,17 @@ import (
 	"github.com/trendmicro/vision-one-mcp-server/internal/v1mcp/tooldescriptions"
 )
 
-var ToolsetsReadOnlyCloudPosture = []func(*v1client.V1ApiClient) mcpserver.ServerTool{
-	toolCloudPostureAccountsList,
-	toolCloudPostureAccountChecksList,
-	toolCloudPostureTemplateScannerRun,
-	toolCloudPostureAccountScanSettingsGet,
-}
-
-var ToolsetsWriteCloudPosture = []func(*v1client.V1ApiClient) mcpserver.ServerTool{
-	toolCloudPostureAccountScan,
-	toolCloudPostureAccountScanSettingsUpdate,
-}
-
-var ToolsetsReadOnlyCloudPostureBeta = []func(*v1client.V1ApiClient) mcpserver.ServerTool{
-	toolCloudPostureCustomRulesList,
-	toolCloudPostureCustomRuleGet,
-	toolCloudPostureCustomRuleTest,
-}
-
-var ToolsetsWriteCloudPostureBeta = []func(*v1client.V1ApiClient) mcpserver.ServerTool{
-	toolCloudPostureCustomRuleCreate,
-	toolCloudPostureCustomRuleUpdate,
-	toolCloudPostureCustomRuleDelete,
-}
-
 func toolCloudPostureAccountsList(client *v1client.V1ApiClient) mcpserver.ServerTool {
 	return mcpserver.ServerTool{
 		Tool: mcp.NewTool(
 			"cloud_posture_accounts_list",
 			mcp.WithDescription("List CSPM Accounts."),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
-				ReadOnlyHint: toPtr(true),
+				Title:           "List Cloud Posture Accounts",
+				ReadOnlyHint:    toPtr(true),
+				DestructiveHint: toPtr(false),
+				IdempotentHint:  toPtr(true),
+				OpenWorldHint:   toPtr(false),
 			}),
 			mcp.WithNumber("top",
 				mcp.Description(tooldescriptions.DefaultTop),
@@ -49,6 +29,7 @@ func toolCloudPostureAccountsList(client *v1client.V1ApiClient) mcpserver.Server
 			),
 			mcp.WithString("skipToken",
 				mcp.Description("The token use to paginate. Used to retrieve the next page of information.")),
+			mcp.WithOutputSchema[listResponse[cloudPostureAccount]](),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			top, err := optionalIntValue("top", request.GetArguments())
@@ -83,9 +64,13 @@ func toolCloudPostureAccountChecksList(client *v1client.V1ApiClient) mcpserver.S
 			"cloud_posture_account_checks_list",
 			mcp.WithDescription("List the checks of an account."),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
-				ReadOnlyHint: toPtr(true),
+				Title:           "List Cloud Posture Account Checks",
+				ReadOnlyHint:    toPtr(true),
+				DestructiveHint: toPtr(false),
+				IdempotentHint:  toPtr(true),
+				OpenWorldHint:   toPtr(false),
 			}),
-			mcp.WithString("filter", mcp.Description(tooldescriptions.FilterCloudPostureChecks)),
+			mcp.WithString("filter", mcp.Description(tooldescriptions.FilterCloudPostureChecks.Description())),
 			mcp.WithNumber("top",
 				mcp.Description(tooldescriptions.DefaultTop),
 				mcp.Min(50),
@@ -95,6 +80,7 @@ func toolCloudPostureAccountChecksList(client *v1client.V1ApiClient) mcpserver.S
 				mcp.Description("The token use to paginate. Used to retrieve the next page of information.")),
 			mcp.WithString("startDateTime", mcp.Description("The start of the data retrieval range.")),
 			mcp.WithString("endDateTime", mcp.Description("The end of the data retrieval range.")),
+			mcp.WithOutputSchema[listResponse[cloudPostureCheck]](),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			top, err := optionalIntValue("top", request.GetArguments())
@@ -141,7 +127,11 @@ func toolCloudPostureTemplateScannerRun(client *v1client.V1ApiClient) mcpserver.
 			"cloud_posture_template_scanner_run",
 			mcp.WithDescription("Scan an infrastructure as code template using the cloud posture template scanner."),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
-				ReadOnlyHint: toPtr(true),
+				Title:           "Scan Infrastructure as Code Template",
+				ReadOnlyHint:    toPtr(true),
+				DestructiveHint: toPtr(false),
+				IdempotentHint:  toPtr(true),
+				OpenWorldHint:   toPtr(false),
 			}),
 			mcp.WithString("type",
 				mcp.Required(),
@@ -150,6 +140,7 @@ func toolCloudPostureTemplateScannerRun(client *v1client.V1ApiClient) mcpserver.
 			mcp.WithString("content",
 				mcp.Required(),
 			),
+			mcp.WithOutputSchema[objectResponse](),
 		),
 		Handler: func(ctx context.Context, ctr mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			templateType, err := requiredValue[string]("type", ctr.GetArguments())
@@ -173,11 +164,16 @@ func toolCloudPostureAccountScanSettingsGet(client *v1client.V1ApiClient) mcpser
 			"cloud_posture_account_scan_settings_get",
 			mcp.WithDescription("Get the scan settings for an account."),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
-				ReadOnlyHint: toPtr(true),
+				Title:           "Get Cloud Posture Scan Settings",
+				ReadOnlyHint:    toPtr(true),
+				DestructiveHint: toPtr(false),
+				IdempotentHint:  toPtr(true),
+				OpenWorldHint:   toPtr(false),
 			}),
 			mcp.WithString("accountId",
 				mcp.Required(),
 			),
+			mcp.WithOutputSchema[cloudPostureScanSettings](),
 		),
 		Handler: func(ctx context.Context, ctr mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			accountId, err := requiredValue[string]("accountId", ctr.GetArguments())
@@ -197,7 +193,11 @@ func toolCloudPostureAccountScan(client *v1client.V1ApiClient) mcpserver.ServerT
 			"cloud_posture_account_scan",
 			mcp.WithDescription("Start scanning cloud posture account."),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
-				ReadOnlyHint: toPtr(false),
+				Title:           "Scan Cloud Posture Account",
+				ReadOnlyHint:    toPtr(false),
+				DestructiveHint: toPtr(false),
+				IdempotentHint:  toPtr(false),
+				OpenWorldHint:   toPtr(false),
 			}),
 			mcp.WithString("accountId",
 				mcp.Required(),
@@ -221,7 +221,11 @@ func toolCloudPostureAccountScanSettingsUpdate(client *v1client.V1ApiClient) mcp
 			"cloud_posture_account_scan_settings_update",
 			mcp.WithDescription("Update an account's scan settings."),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
-				ReadOnlyHint: toPtr(false),
+				Title:           "Update Cloud Posture Scan Settings",
+				ReadOnlyHint:    toPtr(false),
+				DestructiveHint: toPtr(true),
+				IdempotentHint:  toPtr(true),
+				OpenWorldHint:   toPtr(false),
 			}),
 			mcp.WithString("accountId",
 				mcp.Required(),
@@ -264,7 +268,11 @@ func toolCloudPostureCustomRulesList(client *v1client.V1ApiClient) mcpserver.Ser
 			"cloud_posture_custom_rules_list",
 			mcp.WithDescription("Displays the custom rules of your company in a paginated list."),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
-				ReadOnlyHint: toPtr(true),
+				Title:           "List Cloud Posture Custom Rules",
+				ReadOnlyHint:    toPtr(true),
+				DestructiveHint: toPtr(false),
+				IdempotentHint:  toPtr(true),
+				OpenWorldHint:   toPtr(false),
 			}),
 			mcp.WithNumber("top",
 				mcp.Description(tooldescriptions.DefaultTop),
@@ -273,6 +281,7 @@ func toolCloudPostureCustomRulesList(client *v1client.V1ApiClient) mcpserver.Ser
 			),
 			mcp.WithString("skipToken",
 				mcp.Description("The token used to paginate. Used to retrieve the next page of information.")),
+			mcp.WithOutputSchema[listResponse[cloudPostureCustomRule]](),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			top, err := optionalIntValue("top", request.GetArguments())
@@ -307,12 +316,17 @@ func toolCloudPostureCustomRuleGet(client *v1client.V1ApiClient) mcpserver.Serve
 			"cloud_posture_custom_rule_get",
 			mcp.WithDescription("Returns the configuration of the specified custom rule."),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
-				ReadOnlyHint: toPtr(true),
+				Title:           "Get Cloud Posture Custom Rule",
+				ReadOnlyHint:    toPtr(true),
+				DestructiveHint: toPtr(false),
+				IdempotentHint:  toPtr(true),
+				OpenWorldHint:   toPtr(false),
 			}),
 			mcp.WithString("ruleId",
 				mcp.Required(),
 				mcp.Description("The Cloud Risk Management ID of the custom rule."),
 			),
+			mcp.WithOutputSchema[cloudPostureCustomRule](),
 		),
 		Handler: func(ctx context.Context, ctr mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			ruleId, err := requiredValue[string]("ruleId", ctr.GetArguments())
@@ -332,7 +346,11 @@ func toolCloudPostureCustomRuleCreate(client *v1client.V1ApiClient) mcpserver.Se
 			"cloud_posture_custom_rule_create",
 			mcp.WithDescription("Creates a custom rule for your organization. Enabled custom rules are immediately available to all your cloud accounts. Requires Master Administrator role."),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
-				ReadOnlyHint: toPtr(false),
+				Title:           "Create Cloud Posture Custom Rule",
+				ReadOnlyHint:    toPtr(false),
+				DestructiveHint: toPtr(false),
+				IdempotentHint:  toPtr(false),
+				OpenWorldHint:   toPtr(false),
 			}),
 			mcp.WithString("name",
 				mcp.Required(),
@@ -492,7 +510,11 @@ func toolCloudPostureCustomRuleUpdate(client *v1client.V1ApiClient) mcpserver.Se
 			"cloud_posture_custom_rule_update",
 			mcp.WithDescription("Updates the specified custom rule. Requires Master Administrator role."),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
-				ReadOnlyHint: toPtr(false),
+				Title:           "Update Cloud Posture Custom Rule",
+				ReadOnlyHint:    toPtr(false),
+				DestructiveHint: toPtr(true),
+				IdempotentHint:  toPtr(true),
+				OpenWorldHint:   toPtr(false),
 			}),
 			mcp.WithString("ruleId",
 				mcp.Required(),
@@ -641,7 +663,11 @@ func toolCloudPostureCustomRuleDelete(client *v1client.V1ApiClient) mcpserver.Se
 			"cloud_posture_custom_rule_delete",
 			mcp.WithDescription("Deletes the specified custom rule permanently. Requires Master Administrator role."),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
-				ReadOnlyHint: toPtr(false),
+				Title:           "Delete Cloud Posture Custom Rule",
+				ReadOnlyHint:    toPtr(false),
+				DestructiveHint: toPtr(true),
+				IdempotentHint:  toPtr(true),
+				OpenWorldHint:   toPtr(false),
 			}),
 			mcp.WithString("ruleId",
 				mcp.Required(),
@@ -666,7 +692,11 @@ func toolCloudPostureCustomRuleTest(client *v1client.V1ApiClient) mcpserver.Serv
 			"cloud_posture_custom_rule_test",
 			mcp.WithDescription("Tests the provided custom rule configuration against the specified Cloud Risk Management account or mock resource data. Requires Master Administrator role."),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
-				ReadOnlyHint: toPtr(true),
+				Title:           "Test Cloud Posture Custom Rule",
+				ReadOnlyHint:    toPtr(true),
+				DestructiveHint: toPtr(false),
+				IdempotentHint:  toPtr(true),
+				OpenWorldHint:   toPtr(false),
 			}),
 			mcp.WithString("accountId",
 				mcp.Description("The Cloud Risk Management account ID to test against. Either accountId or resource must be provided."),
@@ -678,6 +708,7 @@ func toolCloudPostureCustomRuleTest(client *v1client.V1ApiClient) mcpserver.Serv
 			mcp.WithObject("resource",
 				mcp.Description("Mock resource data to test the rule against. Either accountId or resource must be provided."),
 			),
+			mcp.WithOutputSchema[objectResponse](),
 		),
 		Handler: func(ctx context.Context, ctr mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			accountId, err := optionalValue[string]("accountId", ctr.GetArguments())
@@ -10,26 +10,25 @@ import (
 	"github.com/trendmicro/vision-one-mcp-server/internal/v1mcp/tooldescriptions"
 )
 
-var ToolsetsReadOnlyCloudRiskManagement = []func(*v1client.V1ApiClient) mcpserver.ServerTool{
-	toolCloudRiskManagementAccountsList,
-	toolCloudRiskManagementAccountScanRulesGet,
-	toolCloudRiskManagementServicesList,
-}
-
 func toolCloudRiskManagementAccountsList(client *v1client.V1ApiClient) mcpserver.ServerTool {
 	return mcpserver.ServerTool{
 		Tool: mcp.NewTool(
 			"cloud_risk_management_accounts_list",
 			mcp.WithDescription("Displays the cloud accounts you can access in a paginated list"),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
-				ReadOnlyHint: toPtr(true),
+				Title:           "List Cloud Risk Management Accounts",
+				ReadOnlyHint:    toPtr(true),
+				DestructiveHint: toPtr(false),
+				IdempotentHint:  toPtr(true),
+				OpenWorldHint:   toPtr(false),
 			}),
-			mcp.WithString("filter", mcp.Description(tooldescriptions.FilterCloudRiskManagementAccounts)),
+			mcp.WithString("filter", mcp.Description(tooldescriptions.FilterCloudRiskManagementAccounts.Description())),
 			mcp.WithNumber("top",
 				mcp.Description(tooldescriptions.DefaultTop),
 				mcp.Min(50),
 				mcp.Max(200),
 			),
+			mcp.WithOutputSchema[listResponse[cloudRiskManagementAccount]](),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			top, err := optionalIntValue("top", request.GetArguments())
@@ -63,18 +62,23 @@ func toolCloudRiskManagementAccountScanRulesGet(client *v1client.V1ApiClient) mc
 			"cloud_risk_management_account_scan_rules_get",
 			mcp.WithDescription("Displays the settings for all rules of the specified account in a paginated list"),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
-				ReadOnlyHint: toPtr(true),
+				Title:           "Get Cloud Risk Management Scan Rules",
+				ReadOnlyHint:    toPtr(true),
+				DestructiveHint: toPtr(false),
+				IdempotentHint:  toPtr(true),
+				OpenWorldHint:   toPtr(false),
 			}),
 			mcp.WithString("accountId",
 				mcp.Required(),
 				mcp.Description("The Cloud Risk Management ID of the account"),
 			),
-			mcp.WithString("filter", mcp.Description(tooldescriptions.FilterCloudRiskManagementScanRules)),
+			mcp.WithString("filter", mcp.Description(tooldescriptions.FilterCloudRiskManagementScanRules.Description())),
 			mcp.WithNumber("top",
 				mcp.Description(tooldescriptions.DefaultTop),
 				mcp.Min(50),
 				mcp.Max(200),
 			),
+			mcp.WithOutputSchema[listResponse[cloudRiskManagementScanRule]](),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			accountId, err := requiredValue[string]("accountId", request.GetArguments())
@@ -108,14 +112,19 @@ func toolCloudRiskManagementServicesList(client *v1client.V1ApiClient) mcpserver
 			"cloud_risk_management_services_list",
 			mcp.WithDescription("Retrieves a list of cloud services and their associated rules supported by Cloud Risk Management"),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
-				ReadOnlyHint: toPtr(true),
+				Title:           "List Cloud Risk Management Services",
+				ReadOnlyHint:    toPtr(true),
+				DestructiveHint: toPtr(false),
+				IdempotentHint:  toPtr(true),
+				OpenWorldHint:   toPtr(false),
 			}),
-			mcp.WithString("filter", mcp.Description(tooldescriptions.FilterCloudRiskManagementServices)),
+			mcp.WithString("filter", mcp.Description(tooldescriptions.FilterCloudRiskManagementServices.Description())),
 			mcp.WithNumber("top",
 				mcp.Description(tooldescriptions.DefaultTop),
 				mcp.Min(50),
 				mcp.Max(200),
 			),
+			mcp.WithOutputSchema[listResponse[cloudRiskManagementService]](),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			top, err := optionalIntValue("top", request.GetArguments())
@@ -0,0 +1,382 @@
+package tools
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	"github.com/trendmicro/vision-one-mcp-server/internal/v1client"
+	"github.com/trendmicro/vision-one-mcp-server/internal/v1mcp/filter"
+)
+
+// confirmationTokenArgument is added to destructive tools by
+// WithConfirmation for clients that do not support elicitation.
+const confirmationTokenArgument = "confirmationToken"
+
+// confirmationTTL is how long a confirmation token can be used.
+var confirmationTTL = 5 * time.Minute
+
+// confirmationTargets look up the objects a destructive tool changes, so
+// they can be shown to the user before the tool is run.
+var confirmationTargets = map[string]func(client *v1client.V1ApiClient, arguments map[string]any) ([]any, error){
+	"iam_account_delete": func(client *v1client.V1ApiClient, arguments map[string]any) ([]any, error) {
+		accountId, err := requiredValue[string]("accountId", arguments)
+		if err != nil {
+			return nil, err
+		}
+		return listTargets(client.IAMListAccounts(idFilter(accountId), v1client.QueryParameters{}))
+	},
+	"iam_account_update": func(client *v1client.V1ApiClient, arguments map[string]any) ([]any, error) {
+		accountId, err := requiredValue[string]("accountId", arguments)
+		if err != nil {
+			return nil, err
+		}
+		return listTargets(client.IAMListAccounts(idFilter(accountId), v1client.QueryParameters{}))
+	},
+	"iam_api_keys_delete": func(client *v1client.V1ApiClient, arguments map[string]any) ([]any, error) {
+		ids, err := requiredStringList("apiKeyIds", arguments)
+		if err != nil {
+			return nil, err
+		}
+		return listTargets(client.IAMListAPIKeys(idFilter(ids...), v1client.QueryParameters{}))
+	},
+	"threatintel_intelligence_reports_delete": func(client *v1client.V1ApiClient, arguments map[string]any) ([]any, error) {
+		ids, err := requiredStringList("reportIds", arguments)
+		if err != nil {
+			return nil, err
+		}
+		targets := []any{}
+		for _, id := range ids {
+			report, err := getTarget(client.ThreatIntelGetIntelligenceReport(id))
+			if err != nil {
+				return nil, err
+			}
+			targets = append(targets, report)
+		}
+		return targets, nil
+	},
+	"threatintel_suspicious_objects_delete": func(client *v1client.V1ApiClient, arguments map[string]any) ([]any, error) {
+		filter, err := indicatorFilter(arguments)
+		if err != nil {
+			return nil, err
+		}
+		return listTargets(client.ThreatIntelListSuspiciousObjects(filter, v1client.ThreatIntelQueryParameters{}))
+	},
+	"threatintel_exceptions_delete": func(client *v1client.V1ApiClient, arguments map[string]any) ([]any, error) {
+		filter, err := indicatorFilter(arguments)
+		if err != nil {
+			return nil, err
+		}
+		return listTargets(client.ThreatIntelListExceptions(filter, v1client.ThreatIntelQueryParameters{}))
+	},
+	"cloud_posture_custom_rule_delete": func(client *v1client.V1ApiClient, arguments map[string]any) ([]any, error) {
+		ruleId, err := requiredValue[string]("ruleId", arguments)
+		if err != nil {
+			return nil, err
+		}
+		rule, err := getTarget(client.CloudPostureGetCustomRule(ruleId))
+		if err != nil {
+			return nil, err
+		}
+		return []any{rule}, nil
+	},
+	"cloud_posture_custom_rule_update": func(client *v1client.V1ApiClient, arguments map[string]any) ([]any, error) {
+		ruleId, err := requiredValue[string]("ruleId", arguments)
+		if err != nil {
+			return nil, err
+		}
+		rule, err := getTarget(client.CloudPostureGetCustomRule(ruleId))
+		if err != nil {
+			return nil, err
+		}
+		return []any{rule}, nil
+	},
+	"cloud_posture_account_scan_settings_update": func(client *v1client.V1ApiClient, arguments map[string]any) ([]any, error) {
+		accountId, err := requiredValue[string]("accountId", arguments)
+		if err != nil {
+			return nil, err
+		}
+		settings, err := getTarget(client.CloudPostureGetAccountScanSettings(accountId))
+		if err != nil {
+			return nil, err
+		}
+		return []any{settings}, nil
+	},
+}
+
+// idFilter returns a filter that matches the objects with the given ids.
+func idFilter(ids ...string) string {
+	clauses := make([]string, 0, len(ids))
+	for _, id := range ids {
+		clauses = append(clauses, fmt.Sprintf("id eq %s", filter.Value{Kind: filter.StringValue, Text: id}))
+	}
+	return strings.Join(clauses, " or ")
+}
+
+// indicatorFilter returns a filter that matches the object of the type and
+// value arguments.
+func indicatorFilter(arguments map[string]any) (string, error) {
+	objType, err := requiredValue[string]("type", arguments)
+	if err != nil {
+		return "", err
+	}
+	if !isIndicatorType(objType) {
+		return "", fmt.Errorf("type must be one of %s", strings.Join(indicatorTypes, ", "))
+	}
+	value, err := requiredValue[string]("value", arguments)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s eq '%s'", objType, escapeFilterValue(value)), nil
+}
+
+func requiredStringList(property string, arguments map[string]any) ([]string, error) {
+	values, ok := arguments[property].([]any)
+	if !ok || len(values) == 0 {
+		return nil, fmt.Errorf("missing required parameter: %s", property)
+	}
+
+	list := make([]string, 0, len(values))
+	for _, value := range values {
+		s, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("each item of %s must be a string", property)
+		}
+		list = append(list, s)
+	}
+	return list, nil
+}
+
+func readTarget(resp *http.Response, err error, v any) error {
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("status %d: %s", resp.StatusCode, string(body))
+	}
+	return json.Unmarshal(body, v)
+}
+
+func listTargets(resp *http.Response, err error) ([]any, error) {
+	page := struct {
+		Items []any `json:"items"`
+	}{}
+	if err := readTarget(resp, err, &page); err != nil {
+		return nil, err
+	}
+	if page.Items == nil {
+		return []any{}, nil
+	}
+	return page.Items, nil
+}
+
+func getTarget(resp *http.Response, err error) (any, error) {
+	var target any
+	if err := readTarget(resp, err, &target); err != nil {
+		return nil, err
+	}
+	return target, nil
+}
+
+// pendingConfirmation is a destructive tool call waiting for its
+// confirmation token.
+type pendingConfirmation struct {
+	tool      string
+	arguments string
+	expires   time.Time
+}
+
+// Confirmations asks the user to confirm destructive tool calls. It holds
+// the confirmation tokens issued to clients that do not support elicitation.
+type Confirmations struct {
+	mu      sync.Mutex
+	pending map[string]pendingConfirmation
+}
+
+// NewConfirmations returns Confirmations without pending tokens.
+func NewConfirmations() *Confirmations {
+	return &Confirmations{pending: map[string]pendingConfirmation{}}
+}
+
+// issue returns a token that confirms a call of tool with arguments once.
+func (c *Confirmations) issue(tool, arguments string) (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	token := hex.EncodeToString(b)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := timeNow()
+	for t, p := range c.pending {
+		if now.After(p.expires) {
+			delete(c.pending, t)
+		}
+	}
+	c.pending[token] = pendingConfirmation{tool: tool, arguments: arguments, expires: now.Add(confirmationTTL)}
+	return token, nil
+}
+
+// redeem reports whether token confirms a call of tool with arguments. A
+// token can only be redeemed once.
+func (c *Confirmations) redeem(token, tool, arguments string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	p, ok := c.pending[token]
+	if !ok || p.tool != tool || p.arguments != arguments || timeNow().After(p.expires) {
+		return false
+	}
+	delete(c.pending, token)
+	return true
+}
+
+// WithConfirmation asks the user to confirm each call of a tool marked with
+// DestructiveHint. The objects the call changes are looked up first and
+// shown to the user. Clients that support elicitation ask the user
+// directly; other clients receive a confirmation token that the tool has
+// to be called again with, with the same arguments, once the user agreed.
+func (c *Confirmations) WithConfirmation(tool mcpserver.ServerTool, client *v1client.V1ApiClient) mcpserver.ServerTool {
+	if tool.Tool.Annotations.DestructiveHint == nil || !*tool.Tool.Annotations.DestructiveHint {
+		return tool
+	}
+
+	mcp.WithString(confirmationTokenArgument,
+		mcp.Description("The token returned by the first call of this tool once the user confirmed the call. Never set it without asking the user"),
+	)(&tool.Tool)
+
+	name := tool.Tool.Name
+	handler := tool.Handler
+	tool.Handler = func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		arguments := maps.Clone(request.GetArguments())
+		if arguments == nil {
+			arguments = map[string]any{}
+		}
+
+		token, err := optionalValue[string](confirmationTokenArgument, arguments)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		delete(arguments, confirmationTokenArgument)
+		request.Params.Arguments = arguments
+
+		// Maps are marshalled with sorted keys, equal arguments have equal
+		// encodings.
+		b, err := json.Marshal(arguments)
+		if err != nil {
+			return nil, err
+		}
+		key := string(b)
+
+		if token != "" {
+			if !c.redeem(token, name, key) {
+				return mcp.NewToolResultError(fmt.Sprintf(
+					"invalid confirmation token, it expired, was used already or was issued for other arguments. Call %s without %s to confirm again",
+					name, confirmationTokenArgument,
+				)), nil
+			}
+			return handler(ctx, request)
+		}
+
+		var targets []any
+		if lookup, ok := confirmationTargets[name]; ok {
+			targets, err = lookup(client, arguments)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("%s was not run, failed to look up the objects it changes: %s", name, err)), nil
+			}
+		}
+		message := confirmationMessage(name, arguments, targets)
+
+		if session, ok := elicitationSession(ctx); ok {
+			result, err := session.RequestElicitation(ctx, mcp.ElicitationRequest{
+				Params: mcp.ElicitationParams{
+					Message: message,
+					RequestedSchema: map[string]any{
+						"type": "object",
+						"properties": map[string]any{
+							"confirm": map[string]any{
+								"type":        "boolean",
+								"title":       "Confirm",
+								"description": fmt.Sprintf("Run %s", name),
+							},
+						},
+						"required": []string{"confirm"},
+					},
+				},
+			})
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("%s was not run, failed to ask the user for confirmation: %s", name, err)), nil
+			}
+
+			content, _ := result.Content.(map[string]any)
+			if result.Action != mcp.ElicitationResponseActionAccept || content["confirm"] != true {
+				return mcp.NewToolResultError(fmt.Sprintf("%s was not run, the user did not confirm it", name)), nil
+			}
+			return handler(ctx, request)
+		}
+
+		token, err = c.issue(name, key)
+		if err != nil {
+			return nil, err
+		}
+		return mcp.NewToolResultText(fmt.Sprintf(
+			"%s\n\nNothing was changed yet. Show this to the user and ask for confirmation. Once the user confirmed, call %s again with the same arguments and %s %q. The token can be used once within %s.",
+			message, name, confirmationTokenArgument, token, confirmationTTL,
+		)), nil
+	}
+	return tool
+}
+
+// elicitationSession returns the session of ctx when its client supports
+// elicitation.
+func elicitationSession(ctx context.Context) (mcpserver.SessionWithElicitation, bool) {
+	session := mcpserver.ClientSessionFromContext(ctx)
+	elicitation, ok := session.(mcpserver.SessionWithElicitation)
+	if !ok {
+		return nil, false
+	}
+	info, ok := session.(mcpserver.SessionWithClientInfo)
+	if !ok || info.GetClientCapabilities().Elicitation == nil {
+		return nil, false
+	}
+	return elicitation, true
+}
+
+func confirmationMessage(name string, arguments map[string]any, targets []any) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%s is about to run and cannot be undone.", name)
+
+	if targets == nil {
+		b, _ := json.MarshalIndent(arguments, "", "  ")
+		fmt.Fprintf(&sb, "\n\nArguments:\n%s", string(b))
+		return sb.String()
+	}
+
+	if len(targets) == 0 {
+		sb.WriteString("\n\nNo matching objects were found.")
+		return sb.String()
+	}
+	b, _ := json.MarshalIndent(targets, "", "  ")
+	fmt.Fprintf(&sb, "\n\nIt affects these objects:\n%s", string(b))
+	return sb.String()
+}
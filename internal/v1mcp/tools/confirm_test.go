@@ -0,0 +1,167 @@
+package tools
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	"github.com/stretchr/testify/require"
+	"github.com/trendmicro/vision-one-mcp-server/internal/v1client"
+)
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+type elicitationTestSession struct {
+	capabilities mcp.ClientCapabilities
+	result       *mcp.ElicitationResult
+	requests     []mcp.ElicitationRequest
+}
+
+func (s *elicitationTestSession) SessionID() string { return "test" }
+func (s *elicitationTestSession) NotificationChannel() chan<- mcp.JSONRPCNotification {
+	return make(chan mcp.JSONRPCNotification, 1)
+}
+func (s *elicitationTestSession) Initialize()                                  {}
+func (s *elicitationTestSession) Initialized() bool                            { return true }
+func (s *elicitationTestSession) GetClientInfo() mcp.Implementation            { return mcp.Implementation{} }
+func (s *elicitationTestSession) SetClientInfo(mcp.Implementation)             {}
+func (s *elicitationTestSession) SetClientCapabilities(mcp.ClientCapabilities) {}
+func (s *elicitationTestSession) GetClientCapabilities() mcp.ClientCapabilities {
+	return s.capabilities
+}
+func (s *elicitationTestSession) RequestElicitation(ctx context.Context, request mcp.ElicitationRequest) (*mcp.ElicitationResult, error) {
+	s.requests = append(s.requests, request)
+	return s.result, nil
+}
+
+// newConfirmationTestClient returns a client that answers account lookups
+// and records the accounts it deletes.
+func newConfirmationTestClient(t *testing.T, deleted *[]string) *v1client.V1ApiClient {
+	client, err := v1client.NewV1ApiClient(v1client.ClientOptions{
+		Region: "us",
+		Transport: roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+			w := httptest.NewRecorder()
+			switch r.Method {
+			case http.MethodGet:
+				require.Equal(t, "id eq 'a1'", r.Header.Get("TMV1-Filter"))
+				_, _ = w.WriteString(`{"items":[{"id":"a1","email":"user@example.com","role":"Analyst"}]}`)
+			case http.MethodDelete:
+				*deleted = append(*deleted, r.URL.Path)
+				w.WriteHeader(http.StatusNoContent)
+			}
+			return w.Result(), nil
+		}),
+	})
+	require.NoError(t, err)
+	return client
+}
+
+func TestWithConfirmation(t *testing.T) {
+	t.Run("should only change destructive tools", func(t *testing.T) {
+		tool := NewConfirmations().WithConfirmation(toolIamAccountInvite(nil), nil)
+		require.NotContains(t, tool.Tool.InputSchema.Properties, confirmationTokenArgument)
+
+		tool = NewConfirmations().WithConfirmation(toolIamAccountDelete(nil), nil)
+		require.Contains(t, tool.Tool.InputSchema.Properties, confirmationTokenArgument)
+	})
+
+	t.Run("should ask for confirmation with elicitation", func(t *testing.T) {
+		deleted := []string{}
+		client := newConfirmationTestClient(t, &deleted)
+		tool := NewConfirmations().WithConfirmation(toolIamAccountDelete(client), client)
+
+		session := &elicitationTestSession{
+			capabilities: mcp.ClientCapabilities{Elicitation: &struct{}{}},
+			result: &mcp.ElicitationResult{ElicitationResponse: mcp.ElicitationResponse{
+				Action: mcp.ElicitationResponseActionDecline,
+			}},
+		}
+		ctx := mcpserver.NewMCPServer("test", "1").WithContext(context.Background(), session)
+
+		result := callTool(ctx, t, tool, map[string]any{"accountId": "a1"})
+		require.True(t, result.IsError)
+		require.Equal(t, "iam_account_delete was not run, the user did not confirm it", result.Content[0].(mcp.TextContent).Text)
+		require.Len(t, session.requests, 1)
+		require.Contains(t, session.requests[0].Params.Message, "user@example.com")
+		require.Empty(t, deleted)
+
+		session.result = &mcp.ElicitationResult{ElicitationResponse: mcp.ElicitationResponse{
+			Action:  mcp.ElicitationResponseActionAccept,
+			Content: map[string]any{"confirm": true},
+		}}
+		result = callTool(ctx, t, tool, map[string]any{"accountId": "a1"})
+		require.False(t, result.IsError)
+		require.Equal(t, []string{"/v3.0/iam/accounts/a1"}, deleted)
+	})
+
+	t.Run("should return a confirmation token without elicitation", func(t *testing.T) {
+		deleted := []string{}
+		client := newConfirmationTestClient(t, &deleted)
+		confirmations := NewConfirmations()
+		tool := confirmations.WithConfirmation(toolIamAccountDelete(client), client)
+		ctx := mcpserver.NewMCPServer("test", "1").WithContext(context.Background(), &elicitationTestSession{})
+
+		result := callTool(ctx, t, tool, map[string]any{"accountId": "a1"})
+		require.False(t, result.IsError)
+		require.Contains(t, result.Content[0].(mcp.TextContent).Text, "user@example.com")
+		require.Contains(t, result.Content[0].(mcp.TextContent).Text, "Nothing was changed yet.")
+		require.Empty(t, deleted)
+		require.Len(t, confirmations.pending, 1)
+
+		token := ""
+		for t := range confirmations.pending {
+			token = t
+		}
+
+		result = callTool(ctx, t, tool, map[string]any{"accountId": "other", confirmationTokenArgument: token})
+		require.True(t, result.IsError)
+		require.Empty(t, deleted)
+
+		result = callTool(ctx, t, tool, map[string]any{"accountId": "a1", confirmationTokenArgument: token})
+		require.False(t, result.IsError)
+		require.Equal(t, []string{"/v3.0/iam/accounts/a1"}, deleted)
+
+		result = callTool(ctx, t, tool, map[string]any{"accountId": "a1", confirmationTokenArgument: token})
+		require.True(t, result.IsError)
+		require.Len(t, deleted, 1)
+	})
+
+	t.Run("should expire confirmation tokens", func(t *testing.T) {
+		now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+		timeNow = func() time.Time { return now }
+		defer func() { timeNow = time.Now }()
+
+		confirmations := NewConfirmations()
+		token, err := confirmations.issue("iam_account_delete", "{}")
+		require.NoError(t, err)
+
+		now = now.Add(confirmationTTL + time.Second)
+		require.False(t, confirmations.redeem(token, "iam_account_delete", "{}"))
+	})
+}
+
+func TestDestructiveToolsHaveConfirmationTargets(t *testing.T) {
+	for _, r := range Registry {
+		tool := r.New(nil).Tool
+		if destructive := tool.Annotations.DestructiveHint; destructive != nil && *destructive {
+			require.Contains(t, confirmationTargets, tool.Name)
+		}
+	}
+}
+
+func TestIndicatorFilter(t *testing.T) {
+	filter, err := indicatorFilter(map[string]any{"type": "url", "value": "https://example.com/it's"})
+	require.NoError(t, err)
+	require.Equal(t, "url eq 'https://example.com/it''s'", filter)
+
+	_, err = indicatorFilter(map[string]any{"type": "id eq 'x' or url", "value": "x"})
+	require.ErrorContains(t, err, "type must be one of url, domain")
+}
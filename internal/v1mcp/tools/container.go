@@ -10,14 +10,6 @@ import (
 	"github.com/trendmicro/vision-one-mcp-server/internal/v1mcp/tooldescriptions"
 )
 
-var ToolsetsReadOnlyContainer = []func(client *v1client.V1ApiClient) mcpserver.ServerTool{
-	toolContainerSecurityImageVulnerabilitiesList,
-	toolContainerSecurityK8ClustersList,
-	toolContainerSecurityK8ClusterGet,
-	toolContainerSecurityECSClustersList,
-	toolContainerSecurityK8ImagesList,
-}
-
 func toolContainerSecurityImageVulnerabilitiesList(client *v1client.V1ApiClient) mcpserver.ServerTool {
 	return mcpserver.ServerTool{
 		Tool: mcp.NewTool(
@@ -25,8 +17,14 @@ func toolContainerSecurityImageVulnerabilitiesList(client *v1client.V1ApiClient)
 			mcp.WithDescription(
 				"Displays the container image vulnerabilities detected in Kubernetes and Amazon ECS clusters for your account",
 			),
-			mcp.WithReadOnlyHintAnnotation(true),
-			mcp.WithString("filter", mcp.Description(tooldescriptions.FilterContainerVuln)),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:           "List Container Image Vulnerabilities",
+				ReadOnlyHint:    toPtr(true),
+				DestructiveHint: toPtr(false),
+				IdempotentHint:  toPtr(true),
+				OpenWorldHint:   toPtr(false),
+			}),
+			mcp.WithString("filter", mcp.Description(tooldescriptions.FilterContainerVuln.Description())),
 			mcp.WithString("orderBy",
 				mcp.Enum(
 					"riskLevel desc",
@@ -49,6 +47,7 @@ func toolContainerSecurityImageVulnerabilitiesList(client *v1client.V1ApiClient)
 			),
 			mcp.WithString("skipToken",
 				mcp.Description("The token use to paginate. Used to retrieve the next page of information.")),
+			mcp.WithOutputSchema[listResponse[containerImageVulnerability]](),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			filter, err := optionalValue[string]("filter", request.GetArguments())
@@ -106,7 +105,13 @@ func toolContainerSecurityK8ClustersList(client *v1client.V1ApiClient) mcpserver
 		Tool: mcp.NewTool(
 			"container_security_k8_clusters_list",
 			mcp.WithDescription("Displays all registered Kubernetes clusters"),
-			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:           "List Kubernetes Clusters",
+				ReadOnlyHint:    toPtr(true),
+				DestructiveHint: toPtr(false),
+				IdempotentHint:  toPtr(true),
+				OpenWorldHint:   toPtr(false),
+			}),
 			mcp.WithString("orderBy",
 				mcp.Enum(
 					"createdDateTime desc",
@@ -114,7 +119,8 @@ func toolContainerSecurityK8ClustersList(client *v1client.V1ApiClient) mcpserver
 				),
 				mcp.Description("The field by which the results are sorted"),
 			),
-			mcp.WithString("filter", mcp.Description(tooldescriptions.FilterK8s)),
+			mcp.WithString("filter", mcp.Description(tooldescriptions.FilterK8s.Description())),
+			mcp.WithOutputSchema[listResponse[kubernetesCluster]](),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			filter, err := optionalValue[string]("filter", request.GetArguments())
@@ -142,10 +148,17 @@ func toolContainerSecurityK8ClusterGet(client *v1client.V1ApiClient) mcpserver.S
 		Tool: mcp.NewTool(
 			"container_security_k8_cluster_get",
 			mcp.WithDescription("Displays the details of the specified Kubernetes cluster"),
-			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:           "Get Kubernetes Cluster",
+				ReadOnlyHint:    toPtr(true),
+				DestructiveHint: toPtr(false),
+				IdempotentHint:  toPtr(true),
+				OpenWorldHint:   toPtr(false),
+			}),
 			mcp.WithString("clusterID",
 				mcp.Required(),
 			),
+			mcp.WithOutputSchema[kubernetesCluster](),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			clusterID, err := requiredValue[string]("clusterID", request.GetArguments())
@@ -164,7 +177,13 @@ func toolContainerSecurityECSClustersList(client *v1client.V1ApiClient) mcpserve
 		Tool: mcp.NewTool(
 			"container_security_ecs_clusters_list",
 			mcp.WithDescription("Displays all registered Amazon Elastic Container Service (ECS) clusters in a paginated list"),
-			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:           "List Amazon ECS Clusters",
+				ReadOnlyHint:    toPtr(true),
+				DestructiveHint: toPtr(false),
+				IdempotentHint:  toPtr(true),
+				OpenWorldHint:   toPtr(false),
+			}),
 			mcp.WithString("orderBy",
 				mcp.Enum(
 					"createdDateTime desc",
@@ -172,7 +191,8 @@ func toolContainerSecurityECSClustersList(client *v1client.V1ApiClient) mcpserve
 				),
 				mcp.Description("The field by which the results are sorted"),
 			),
-			mcp.WithString("filter", mcp.Description(tooldescriptions.FilterECS)),
+			mcp.WithString("filter", mcp.Description(tooldescriptions.FilterECS.Description())),
+			mcp.WithOutputSchema[listResponse[map[string]any]](),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			filter, err := optionalValue[string]("filter", request.GetArguments())
@@ -189,7 +209,7 @@ func toolContainerSecurityECSClustersList(client *v1client.V1ApiClient) mcpserve
 				OrderBy: orderBy,
 			}
 
-			resp, err := client.ContainerSecurityListK8Clusters(filter, qp)
+			resp, err := client.ContainerSecurityListECSClusters(filter, qp)
 			return handleStatusResponse(resp, err, http.StatusOK, "failed to list ecs clusters")
 		},
 	}
@@ -200,14 +220,21 @@ func toolContainerSecurityK8ImagesList(client *v1client.V1ApiClient) mcpserver.S
 		Tool: mcp.NewTool(
 			"container_security_k8_images_list",
 			mcp.WithDescription("Displays the Kubernetes images that are running in all clusters for your account"),
-			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:           "List Kubernetes Images",
+				ReadOnlyHint:    toPtr(true),
+				DestructiveHint: toPtr(false),
+				IdempotentHint:  toPtr(true),
+				OpenWorldHint:   toPtr(false),
+			}),
 			mcp.WithString("orderBy",
 				mcp.Enum(
 					"id desc",
 				),
 				mcp.Description("The field by which the results are sorted"),
 			),
-			mcp.WithString("filter", mcp.Description(tooldescriptions.FilterK8Images)),
+			mcp.WithString("filter", mcp.Description(tooldescriptions.FilterK8Images.Description())),
+			mcp.WithOutputSchema[listResponse[containerImage]](),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			filter, err := optionalValue[string]("filter", request.GetArguments())
@@ -0,0 +1,44 @@
+package tools
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	"github.com/stretchr/testify/require"
+	"github.com/trendmicro/vision-one-mcp-server/internal/v1client"
+)
+
+func TestContainerSecurityClustersList(t *testing.T) {
+	var path string
+	client, err := v1client.NewV1ApiClient(v1client.ClientOptions{
+		Region: "us",
+		Transport: roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+			path = r.URL.Path
+			w := httptest.NewRecorder()
+			_, _ = w.WriteString(`{"items":[]}`)
+			return w.Result(), nil
+		}),
+	})
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		newTool func(*v1client.V1ApiClient) mcpserver.ServerTool
+		path    string
+	}{
+		{toolContainerSecurityK8ClustersList, "/v3.0/containerSecurity/kubernetesClusters"},
+		{toolContainerSecurityECSClustersList, "/v3.0/containerSecurity/amazonEcsClusters"},
+	} {
+		tool := tc.newTool(client)
+		t.Run(tool.Tool.Name, func(t *testing.T) {
+			path = ""
+			result, err := tool.Handler(context.Background(), mcp.CallToolRequest{})
+			require.NoError(t, err)
+			require.False(t, result.IsError)
+			require.Equal(t, tc.path, path)
+		})
+	}
+}
@@ -10,33 +10,19 @@ import (
 	"github.com/trendmicro/vision-one-mcp-server/internal/v1mcp/tooldescriptions"
 )
 
-var ToolsetsReadOnlyCREM = []func(client *v1client.V1ApiClient) mcpserver.ServerTool{
-	toolCREMAttackSurfaceDevicesList,
-	toolCREMAttackSurfaceDomainAccountsList,
-	toolCREMAttackSurfaceServiceAccountsList,
-	toolCREMAttackSurfaceGlobalFQDNsList,
-	toolCREMAttackSurfacePublicIPsList,
-	toolCREMAttackSurfaceCloudAssetsList,
-	toolCREMAttackSurfaceHighRiskUsersList,
-	toolCREMAttackSurfaceCloudAssetProfileGet,
-	toolCREMAttackSurfaceCloudAssetRiskIndicatorsList,
-	toolCREMAttackSurfaceLocalAppsList,
-	toolCREMAttackSurfaceLocalAppProfileGet,
-	toolCREMAttackSurfaceLocalAppRiskIndicatorsList,
-	toolCREMAttackSurfaceLocalAppDevicesList,
-	toolCREMAttackSurfaceLocalAppExecutableFilesList,
-	toolCREMAttackSurfaceCustomTagsList,
-}
-
 func toolCREMAttackSurfaceDevicesList(client *v1client.V1ApiClient) mcpserver.ServerTool {
 	return mcpserver.ServerTool{
 		Tool: mcp.NewTool(
 			"crem_attack_surface_devices_list",
 			mcp.WithDescription("List discovered attack surface devices"),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
-				ReadOnlyHint: toPtr(true),
+				Title:           "List Attack Surface Devices",
+				ReadOnlyHint:    toPtr(true),
+				DestructiveHint: toPtr(false),
+				IdempotentHint:  toPtr(true),
+				OpenWorldHint:   toPtr(false),
 			}),
-			mcp.WithString("filter", mcp.Description(tooldescriptions.FilterAttackSurfaceDevices)),
+			mcp.WithString("filter", mcp.Description(tooldescriptions.FilterAttackSurfaceDevices.Description())),
 			mcp.WithString("orderBy",
 				mcp.Description("The field by which the results are sorted"),
 				mcp.Enum(
@@ -60,11 +46,12 @@ func toolCREMAttackSurfaceDevicesList(client *v1client.V1ApiClient) mcpserver.Se
 			mcp.WithString("firstSeenStartDateTime",
 				mcp.Description("The start time of the data retrieval range, represented in ISO 8601 format."),
 			),
-			mcp.WithString("firstSeenStartDateTime",
+			mcp.WithString("firstSeenEndDateTime",
 				mcp.Description("The end time of the data retrieval range, represented in ISO 8601 format."),
 			),
 			mcp.WithString("skipToken",
 				mcp.Description("The token use to paginate. Used to retrieve the next page of information.")),
+			mcp.WithOutputSchema[listResponse[attackSurfaceDevice]](),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			top, err := optionalStrInt("top", request.GetArguments())
@@ -129,13 +116,17 @@ func toolCREMAttackSurfaceDomainAccountsList(client *v1client.V1ApiClient) mcpse
 			"crem_attack_surface_domain_accounts_list",
 			mcp.WithDescription("List discovered attack surface domain accounts"),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
-				ReadOnlyHint: toPtr(true),
+				Title:           "List Attack Surface Domain Accounts",
+				ReadOnlyHint:    toPtr(true),
+				DestructiveHint: toPtr(false),
+				IdempotentHint:  toPtr(true),
+				OpenWorldHint:   toPtr(false),
 			}),
 			mcp.WithString("top",
 				mcp.Description(tooldescriptions.DefaultTop),
 				mcp.Enum(cremTop()...),
 			),
-			mcp.WithString("filter", mcp.Description(tooldescriptions.FilterDomainAccounts)),
+			mcp.WithString("filter", mcp.Description(tooldescriptions.FilterDomainAccounts.Description())),
 			mcp.WithString("orderBy",
 				mcp.Description("The field by which the results are sorted"),
 				mcp.Enum(
@@ -148,6 +139,7 @@ func toolCREMAttackSurfaceDomainAccountsList(client *v1client.V1ApiClient) mcpse
 			),
 			mcp.WithString("skipToken",
 				mcp.Description("The token use to paginate. Used to retrieve the next page of information.")),
+			mcp.WithOutputSchema[listResponse[attackSurfaceAccount]](),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			top, err := optionalStrInt("top", request.GetArguments())
@@ -188,19 +180,24 @@ func toolCREMAttackSurfaceGlobalFQDNsList(client *v1client.V1ApiClient) mcpserve
 			"crem_attack_surface_global_fqdns_list",
 			mcp.WithDescription("List discovered internet facing domains (Fully Qualified Domain Names)"),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
-				ReadOnlyHint: toPtr(true),
+				Title:           "List Attack Surface Internet-Facing Domains",
+				ReadOnlyHint:    toPtr(true),
+				DestructiveHint: toPtr(false),
+				IdempotentHint:  toPtr(true),
+				OpenWorldHint:   toPtr(false),
 			}),
 			mcp.WithString("top",
 				mcp.Description(tooldescriptions.DefaultTop),
 				mcp.Enum(cremTop()...),
 			),
-			mcp.WithString("filter", mcp.Description(tooldescriptions.FilterFQDNS)),
+			mcp.WithString("filter", mcp.Description(tooldescriptions.FilterFQDNS.Description())),
 			mcp.WithString("orderBy",
 				mcp.Description("The field by which the results are sorted"),
 				mcp.Enum(withOrdering(asc_desc, "latestRiskScore")...),
 			),
 			mcp.WithString("skipToken",
 				mcp.Description("The token use to paginate. Used to retrieve the next page of information.")),
+			mcp.WithOutputSchema[listResponse[attackSurfaceFQDN]](),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			top, err := optionalStrInt("top", request.GetArguments())
@@ -241,19 +238,24 @@ func toolCREMAttackSurfacePublicIPsList(client *v1client.V1ApiClient) mcpserver.
 			"crem_attack_surface_public_ips_list",
 			mcp.WithDescription("List discovered public IP addresses"),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
-				ReadOnlyHint: toPtr(true),
+				Title:           "List Attack Surface Public IP Addresses",
+				ReadOnlyHint:    toPtr(true),
+				DestructiveHint: toPtr(false),
+				IdempotentHint:  toPtr(true),
+				OpenWorldHint:   toPtr(false),
 			}),
 			mcp.WithString("top",
 				mcp.Description(tooldescriptions.DefaultTop),
 				mcp.Enum(cremTop()...),
 			),
-			mcp.WithString("filter", mcp.Description(tooldescriptions.FilterIps)),
+			mcp.WithString("filter", mcp.Description(tooldescriptions.FilterIps.Description())),
 			mcp.WithString("orderBy",
 				mcp.Description("The field by which the results are sorted"),
 				mcp.Enum(withOrdering(asc_desc, "latestRiskScore")...),
 			),
 			mcp.WithString("skipToken",
 				mcp.Description("The token use to paginate. Used to retrieve the next page of information.")),
+			mcp.WithOutputSchema[listResponse[attackSurfacePublicIP]](),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			top, err := optionalStrInt("top", request.GetArguments())
@@ -294,13 +296,17 @@ func toolCREMAttackSurfaceCloudAssetsList(client *v1client.V1ApiClient) mcpserve
 			"crem_attack_surface_cloud_assets_list",
 			mcp.WithDescription("List discovered cloud assets"),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
-				ReadOnlyHint: toPtr(true),
+				Title:           "List Attack Surface Cloud Assets",
+				ReadOnlyHint:    toPtr(true),
+				DestructiveHint: toPtr(false),
+				IdempotentHint:  toPtr(true),
+				OpenWorldHint:   toPtr(false),
 			}),
 			mcp.WithString("top",
 				mcp.Description(tooldescriptions.DefaultTop),
 				mcp.Enum(cremTop()...),
 			),
-			mcp.WithString("filter", mcp.Description(tooldescriptions.FilterCloudAssets)),
+			mcp.WithString("filter", mcp.Description(tooldescriptions.FilterCloudAssets.Description())),
 			mcp.WithString("orderBy",
 				mcp.Description("The field by which the results are sorted"),
 				mcp.Enum(withOrdering(asc_desc, "latestRiskScore")...),
@@ -314,11 +320,12 @@ func toolCREMAttackSurfaceCloudAssetsList(client *v1client.V1ApiClient) mcpserve
 			mcp.WithString("firstSeenStartDateTime",
 				mcp.Description("The start time of the data retrieval range, represented in ISO 8601 format."),
 			),
-			mcp.WithString("firstSeenStartDateTime",
+			mcp.WithString("firstSeenEndDateTime",
 				mcp.Description("The end time of the data retrieval range, represented in ISO 8601 format."),
 			),
 			mcp.WithString("skipToken",
 				mcp.Description("The token use to paginate. Used to retrieve the next page of information.")),
+			mcp.WithOutputSchema[listResponse[attackSurfaceCloudAsset]](),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			top, err := optionalStrInt("top", request.GetArguments())
@@ -383,13 +390,17 @@ func toolCREMAttackSurfaceHighRiskUsersList(client *v1client.V1ApiClient) mcpser
 			"crem_attack_surface_high_risk_users_list",
 			mcp.WithDescription("List high risk users"),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
-				ReadOnlyHint: toPtr(true),
+				Title:           "List High Risk Users",
+				ReadOnlyHint:    toPtr(true),
+				DestructiveHint: toPtr(false),
+				IdempotentHint:  toPtr(true),
+				OpenWorldHint:   toPtr(false),
 			}),
 			mcp.WithString("top",
 				mcp.Description(tooldescriptions.DefaultTop),
 				mcp.Enum(cremTop()...),
 			),
-			mcp.WithString("filter", mcp.Description(tooldescriptions.FilterHighRiskUsers)),
+			mcp.WithString("filter", mcp.Description(tooldescriptions.FilterHighRiskUsers.Description())),
 			mcp.WithString("orderBy",
 				mcp.Description("The field by which the results are sorted"),
 				mcp.Enum(withOrdering(
@@ -400,6 +411,7 @@ func toolCREMAttackSurfaceHighRiskUsersList(client *v1client.V1ApiClient) mcpser
 			),
 			mcp.WithString("skipToken",
 				mcp.Description("The token use to paginate. Used to retrieve the next page of information.")),
+			mcp.WithOutputSchema[listResponse[highRiskUser]](),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			top, err := optionalStrInt("top", request.GetArguments())
@@ -439,13 +451,17 @@ func toolCREMAttackSurfaceServiceAccountsList(client *v1client.V1ApiClient) mcps
 			"crem_attack_surface_service_accounts_list",
 			mcp.WithDescription("List discovered service accounts"),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
-				ReadOnlyHint: toPtr(true),
+				Title:           "List Attack Surface Service Accounts",
+				ReadOnlyHint:    toPtr(true),
+				DestructiveHint: toPtr(false),
+				IdempotentHint:  toPtr(true),
+				OpenWorldHint:   toPtr(false),
 			}),
 			mcp.WithString("top",
 				mcp.Description(tooldescriptions.DefaultTop),
 				mcp.Enum(cremTop()...),
 			),
-			mcp.WithString("filter", mcp.Description(tooldescriptions.FilterServiceAccounts)),
+			mcp.WithString("filter", mcp.Description(tooldescriptions.FilterServiceAccounts.Description())),
 			mcp.WithString("orderBy",
 				mcp.Description("The field by which the results are sorted"),
 				mcp.Enum(withOrdering(
@@ -455,6 +471,7 @@ func toolCREMAttackSurfaceServiceAccountsList(client *v1client.V1ApiClient) mcps
 				)...,
 				),
 			),
+			mcp.WithOutputSchema[listResponse[attackSurfaceAccount]](),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			top, err := optionalStrInt("top", request.GetArguments())
@@ -489,9 +506,14 @@ func toolCREMAttackSurfaceCloudAssetProfileGet(client *v1client.V1ApiClient) mcp
 			"crem_attack_surface_cloud_asset_profile_get",
 			mcp.WithDescription("Get a cloud asset's profile"),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
-				ReadOnlyHint: toPtr(true),
+				Title:           "Get Cloud Asset Profile",
+				ReadOnlyHint:    toPtr(true),
+				DestructiveHint: toPtr(false),
+				IdempotentHint:  toPtr(true),
+				OpenWorldHint:   toPtr(false),
 			}),
 			mcp.WithString("cloudAssetId", mcp.Description("The ID of the cloud asset to retrieve.")),
+			mcp.WithOutputSchema[attackSurfaceCloudAsset](),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			cloudAssetId, err := requiredValue[string]("cloudAssetId", request.GetArguments())
@@ -511,14 +533,18 @@ func toolCREMAttackSurfaceCloudAssetRiskIndicatorsList(client *v1client.V1ApiCli
 			"crem_attack_surface_cloud_asset_risk_indicators_list",
 			mcp.WithDescription("List a cloud asset's risk indicators"),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
-				ReadOnlyHint: toPtr(true),
+				Title:           "List Cloud Asset Risk Indicators",
+				ReadOnlyHint:    toPtr(true),
+				DestructiveHint: toPtr(false),
+				IdempotentHint:  toPtr(true),
+				OpenWorldHint:   toPtr(false),
 			}),
 			mcp.WithString("cloudAssetId", mcp.Description("The ID of the cloud asset to retrieve.")),
 			mcp.WithString("top",
 				mcp.Description(tooldescriptions.DefaultTop),
 				mcp.Enum(cremTop()...),
 			),
-			mcp.WithString("filter", mcp.Description(tooldescriptions.FilterCloudAssetRiskIndicators)),
+			mcp.WithString("filter", mcp.Description(tooldescriptions.FilterCloudAssetRiskIndicators.Description())),
 			mcp.WithString("orderBy",
 				mcp.Description("The field by which the results are sorted"),
 				mcp.Enum(withOrdering(
@@ -528,6 +554,7 @@ func toolCREMAttackSurfaceCloudAssetRiskIndicatorsList(client *v1client.V1ApiCli
 			),
 			mcp.WithString("skipToken",
 				mcp.Description("The token use to paginate. Used to retrieve the next page of information.")),
+			mcp.WithOutputSchema[listResponse[attackSurfaceRiskIndicator]](),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			cloudAssetId, err := requiredValue[string]("cloudAssetId", request.GetArguments())
@@ -573,13 +600,17 @@ func toolCREMAttackSurfaceLocalAppsList(client *v1client.V1ApiClient) mcpserver.
 			"crem_attack_surface_local_apps_list",
 			mcp.WithDescription("List discovered local applications"),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
-				ReadOnlyHint: toPtr(true),
+				Title:           "List Local Applications",
+				ReadOnlyHint:    toPtr(true),
+				DestructiveHint: toPtr(false),
+				IdempotentHint:  toPtr(true),
+				OpenWorldHint:   toPtr(false),
 			}),
 			mcp.WithString("top",
 				mcp.Description(tooldescriptions.DefaultTop),
 				mcp.Enum(cremTop()...),
 			),
-			mcp.WithString("filter", mcp.Description(tooldescriptions.FilterLocalApps)),
+			mcp.WithString("filter", mcp.Description(tooldescriptions.FilterLocalApps.Description())),
 			mcp.WithString("orderBy",
 				mcp.Description("The field by which the results are sorted"),
 				mcp.Enum(
@@ -593,6 +624,7 @@ func toolCREMAttackSurfaceLocalAppsList(client *v1client.V1ApiClient) mcpserver.
 			),
 			mcp.WithString("skipToken",
 				mcp.Description("The token use to paginate. Used to retrieve the next page of information.")),
+			mcp.WithOutputSchema[listResponse[attackSurfaceLocalApp]](),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			top, err := optionalStrInt("top", request.GetArguments())
@@ -633,12 +665,17 @@ func toolCREMAttackSurfaceLocalAppProfileGet(client *v1client.V1ApiClient) mcpse
 			"crem_attack_surface_local_app_profile_get",
 			mcp.WithDescription("Get a local app's profile"),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
-				ReadOnlyHint: toPtr(true),
+				Title:           "Get Local Application Profile",
+				ReadOnlyHint:    toPtr(true),
+				DestructiveHint: toPtr(false),
+				IdempotentHint:  toPtr(true),
+				OpenWorldHint:   toPtr(false),
 			}),
 			mcp.WithString("appID",
 				mcp.Description("The ID of the local app to retrieve."),
 				mcp.Required(),
 			),
+			mcp.WithOutputSchema[attackSurfaceLocalApp](),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			appID, err := requiredValue[string]("appID", request.GetArguments())
@@ -658,7 +695,11 @@ func toolCREMAttackSurfaceLocalAppRiskIndicatorsList(client *v1client.V1ApiClien
 			"crem_attack_surface_local_app_risk_indicators_list",
 			mcp.WithDescription("List a local app's risk indicators"),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
-				ReadOnlyHint: toPtr(true),
+				Title:           "List Local Application Risk Indicators",
+				ReadOnlyHint:    toPtr(true),
+				DestructiveHint: toPtr(false),
+				IdempotentHint:  toPtr(true),
+				OpenWorldHint:   toPtr(false),
 			}),
 			mcp.WithString("appID",
 				mcp.Description("The ID of the local app to retrieve."),
@@ -668,13 +709,14 @@ func toolCREMAttackSurfaceLocalAppRiskIndicatorsList(client *v1client.V1ApiClien
 				mcp.Description(tooldescriptions.DefaultTop),
 				mcp.Enum(cremTop()...),
 			),
-			mcp.WithString("filter", mcp.Description(tooldescriptions.FilterLocalAppRiskIndicators)),
+			mcp.WithString("filter", mcp.Description(tooldescriptions.FilterLocalAppRiskIndicators.Description())),
 			mcp.WithString("orderBy",
 				mcp.Description("The field by which the results are sorted"),
 				mcp.Enum(withOrdering(asc_desc, "detectedDateTime")...),
 			),
 			mcp.WithString("skipToken",
 				mcp.Description("The token use to paginate. Used to retrieve the next page of information.")),
+			mcp.WithOutputSchema[listResponse[attackSurfaceRiskIndicator]](),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			appID, err := requiredValue[string]("appID", request.GetArguments())
@@ -719,7 +761,11 @@ func toolCREMAttackSurfaceLocalAppDevicesList(client *v1client.V1ApiClient) mcps
 		Tool: mcp.NewTool(
 			"crem_attack_surface_local_app_devices_list",
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
-				ReadOnlyHint: toPtr(true),
+				Title:           "List Local Application Devices",
+				ReadOnlyHint:    toPtr(true),
+				DestructiveHint: toPtr(false),
+				IdempotentHint:  toPtr(true),
+				OpenWorldHint:   toPtr(false),
 			}),
 			mcp.WithDescription("Displays the devices with the specified local application installed"),
 			mcp.WithString("appID",
@@ -730,13 +776,14 @@ func toolCREMAttackSurfaceLocalAppDevicesList(client *v1client.V1ApiClient) mcps
 				mcp.Description(tooldescriptions.DefaultTop),
 				mcp.Enum(cremTop()...),
 			),
-			mcp.WithString("filter", mcp.Description(tooldescriptions.FilterLocalAppDevices)),
+			mcp.WithString("filter", mcp.Description(tooldescriptions.FilterLocalAppDevices.Description())),
 			mcp.WithString("orderBy",
 				mcp.Description("The field by which the results are sorted"),
 				mcp.Enum(withOrdering(asc_desc, "latestRiskScore")...),
 			),
 			mcp.WithString("skipToken",
 				mcp.Description("The token use to paginate. Used to retrieve the next page of information.")),
+			mcp.WithOutputSchema[listResponse[attackSurfaceDevice]](),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			appID, err := requiredValue[string]("appID", request.GetArguments())
@@ -781,7 +828,11 @@ func toolCREMAttackSurfaceLocalAppExecutableFilesList(client *v1client.V1ApiClie
 		Tool: mcp.NewTool(
 			"crem_attack_surface_local_app_executable_files_list",
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
-				ReadOnlyHint: toPtr(true),
+				Title:           "List Local Application Executable Files",
+				ReadOnlyHint:    toPtr(true),
+				DestructiveHint: toPtr(false),
+				IdempotentHint:  toPtr(true),
+				OpenWorldHint:   toPtr(false),
 			}),
 			mcp.WithDescription("Displays the local applications installed executable files"),
 			mcp.WithString("appID",
@@ -792,13 +843,14 @@ func toolCREMAttackSurfaceLocalAppExecutableFilesList(client *v1client.V1ApiClie
 				mcp.Description(tooldescriptions.DefaultTop),
 				mcp.Enum(cremTop()...),
 			),
-			mcp.WithString("filter", mcp.Description(tooldescriptions.FilterLocalAppExecutables)),
+			mcp.WithString("filter", mcp.Description(tooldescriptions.FilterLocalAppExecutables.Description())),
 			mcp.WithString("orderBy",
 				mcp.Description("The field by which the results are sorted"),
 				mcp.Enum(withOrdering(asc_desc, "lastDetectedDateTime")...),
 			),
 			mcp.WithString("skipToken",
 				mcp.Description("The token use to paginate. Used to retrieve the next page of information.")),
+			mcp.WithOutputSchema[listResponse[map[string]any]](),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			appID, err := requiredValue[string]("appID", request.GetArguments())
@@ -843,20 +895,25 @@ func toolCREMAttackSurfaceCustomTagsList(client *v1client.V1ApiClient) mcpserver
 		Tool: mcp.NewTool(
 			"crem_attack_surface_custom_tags_list",
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
-				ReadOnlyHint: toPtr(true),
+				Title:           "List Attack Surface Custom Tags",
+				ReadOnlyHint:    toPtr(true),
+				DestructiveHint: toPtr(false),
+				IdempotentHint:  toPtr(true),
+				OpenWorldHint:   toPtr(false),
 			}),
 			mcp.WithDescription("List tag definitions"),
 			mcp.WithString("top",
 				mcp.Description(tooldescriptions.DefaultTop),
 				mcp.Enum(cremTop()...),
 			),
-			mcp.WithString("filter", mcp.Description(tooldescriptions.FilterCustomTags)),
+			mcp.WithString("filter", mcp.Description(tooldescriptions.FilterCustomTags.Description())),
 			mcp.WithString("orderBy",
 				mcp.Description("The field by which the results are sorted"),
 				mcp.Enum(withOrdering(asc_desc, "key")...),
 			),
 			mcp.WithString("skipToken",
 				mcp.Description("The token use to paginate. Used to retrieve the next page of information.")),
+			mcp.WithOutputSchema[listResponse[customTag]](),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			top, err := optionalStrInt("top", request.GetArguments())
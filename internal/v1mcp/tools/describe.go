@@ -0,0 +1,213 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	mcpserver "github.com/mark3labs/mcp-go/server"
+)
+
+// DescribeToolName is the name of the tool that searches the registry.
+const DescribeToolName = "v1mcp_tools_describe"
+
+// Capabilities a tool can be searched by.
+const (
+	capabilityRead        = "read"
+	capabilityWrite       = "write"
+	capabilityDestructive = "destructive"
+	capabilityIdempotent  = "idempotent"
+	capabilityOpenWorld   = "openWorld"
+	capabilityBeta        = "beta"
+	capabilityList        = "list"
+)
+
+var describeCapabilities = []string{
+	capabilityRead,
+	capabilityWrite,
+	capabilityDestructive,
+	capabilityIdempotent,
+	capabilityOpenWorld,
+	capabilityBeta,
+	capabilityList,
+}
+
+type describedTool struct {
+	Name              string   `json:"name"`
+	Title             string   `json:"title"`
+	Description       string   `json:"description"`
+	Toolset           string   `json:"toolset"`
+	Mode              Mode     `json:"mode"`
+	Capabilities      []string `json:"capabilities"`
+	Permissions       []string `json:"permissions" jsonschema:"description=The permissions the role of the API key needs"`
+	APIPaths          []string `json:"apiPaths" jsonschema:"description=The Vision One API endpoints the tool calls"`
+	Arguments         []string `json:"arguments"`
+	RequiredArguments []string `json:"requiredArguments"`
+	Enabled           bool     `json:"enabled" jsonschema:"description=False until the toolset of the tool is enabled with enable_toolset"`
+}
+
+type describeResult struct {
+	Tools []describedTool `json:"tools"`
+}
+
+// capabilities returns the capabilities of tool, see describeCapabilities.
+func capabilities(r Registration, tool mcp.Tool) []string {
+	c := []string{string(r.Mode())}
+	for _, hint := range []struct {
+		capability string
+		value      *bool
+	}{
+		{capabilityDestructive, tool.Annotations.DestructiveHint},
+		{capabilityIdempotent, tool.Annotations.IdempotentHint},
+		{capabilityOpenWorld, tool.Annotations.OpenWorldHint},
+	} {
+		if hint.value != nil && *hint.value {
+			c = append(c, hint.capability)
+		}
+	}
+	if r.Beta {
+		c = append(c, capabilityBeta)
+	}
+	if isListTool(tool) {
+		c = append(c, capabilityList)
+	}
+	return c
+}
+
+// describe returns the description of the tool of r. tool is the tool as
+// registered on the server, with the arguments added by its middlewares.
+func describe(r Registration, tool mcp.Tool) describedTool {
+	required := slices.Clone(tool.InputSchema.Required)
+	slices.Sort(required)
+	if required == nil {
+		required = []string{}
+	}
+	return describedTool{
+		Name:              tool.Name,
+		Title:             tool.Annotations.Title,
+		Description:       tool.Description,
+		Toolset:           r.Toolset,
+		Mode:              r.Mode(),
+		Capabilities:      capabilities(r, tool),
+		Permissions:       r.Permissions,
+		APIPaths:          r.APIPaths,
+		Arguments:         slices.Sorted(maps.Keys(tool.InputSchema.Properties)),
+		RequiredArguments: required,
+		Enabled:           true,
+	}
+}
+
+// matches returns whether every keyword is part of the name, title,
+// description, toolset, permissions or API paths of the tool.
+func (d describedTool) matches(keywords []string) bool {
+	text := strings.ToLower(strings.Join(slices.Concat(
+		[]string{d.Name, strings.ReplaceAll(d.Name, "_", " "), d.Title, d.Description, d.Toolset},
+		d.Permissions,
+		d.APIPaths,
+	), "\n"))
+	for _, keyword := range keywords {
+		if !strings.Contains(text, keyword) {
+			return false
+		}
+	}
+	return true
+}
+
+// NewDescribeTool returns the v1mcp_tools_describe tool, which searches the
+// given registrations so the model can find the right tool for a task
+// before calling it.
+func NewDescribeTool(registry []Registration) mcpserver.ServerTool {
+	toolsets := Toolsets(registry)
+
+	return mcpserver.ServerTool{
+		Tool: mcp.NewTool(
+			DescribeToolName,
+			mcp.WithDescription(
+				"Searches the tools of this server by keyword, toolset and capability. "+
+					"Returns the title, description, arguments, required Vision One permissions and API endpoints of each matching tool. "+
+					"Use it to find the tool for a task before calling it",
+			),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:           "Describe Tools",
+				ReadOnlyHint:    toPtr(true),
+				DestructiveHint: toPtr(false),
+				IdempotentHint:  toPtr(true),
+				OpenWorldHint:   toPtr(false),
+			}),
+			mcp.WithString("query",
+				mcp.Description("Keywords that must all appear in the name, title, description, toolset, permissions or API paths of the tool, e.g. \"suspicious object\" or \"kubernetes cluster\". Not case sensitive"),
+			),
+			mcp.WithString("toolset",
+				mcp.Description("Only return the tools of this toolset"),
+				mcp.Enum(toolsets...),
+			),
+			mcp.WithArray("capabilities",
+				mcp.Description("Only return the tools with all of these capabilities. list tools return pages of items, write tools change Vision One"),
+				mcp.Items(map[string]any{"type": "string", "enum": describeCapabilities}),
+			),
+			mcp.WithOutputSchema[describeResult](),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			query, err := optionalValue[string]("query", request.GetArguments())
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			toolset, err := optionalValue[string]("toolset", request.GetArguments())
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if toolset != "" && !slices.Contains(toolsets, toolset) {
+				return mcp.NewToolResultError(fmt.Sprintf("unknown toolset %q, expected one of %s", toolset, strings.Join(toolsets, ", "))), nil
+			}
+
+			wanted := []string{}
+			if err := optionalJSONValue("capabilities", request.GetArguments(), &wanted); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			for _, capability := range wanted {
+				if !slices.Contains(describeCapabilities, capability) {
+					return mcp.NewToolResultError(fmt.Sprintf("unknown capability %q, expected one of %s", capability, strings.Join(describeCapabilities, ", "))), nil
+				}
+			}
+
+			server := mcpserver.ServerFromContext(ctx)
+			keywords := strings.Fields(strings.ToLower(query))
+			result := describeResult{Tools: []describedTool{}}
+			for _, r := range registry {
+				if toolset != "" && r.Toolset != toolset {
+					continue
+				}
+
+				tool := r.New(nil).Tool
+				var registered *mcpserver.ServerTool
+				if server != nil {
+					registered = server.GetTool(tool.Name)
+					if registered != nil {
+						tool = registered.Tool
+					}
+				}
+
+				d := describe(r, tool)
+				d.Enabled = server == nil || registered != nil
+				if !d.matches(keywords) {
+					continue
+				}
+				if slices.ContainsFunc(wanted, func(c string) bool { return !slices.Contains(d.Capabilities, c) }) {
+					continue
+				}
+				result.Tools = append(result.Tools, d)
+			}
+
+			b, err := json.Marshal(result)
+			if err != nil {
+				return nil, err
+			}
+			return mcp.NewToolResultStructured(result, string(b)), nil
+		},
+	}
+}
@@ -0,0 +1,82 @@
+package tools
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+)
+
+func describeTools(t *testing.T, arguments map[string]any) []describedTool {
+	t.Helper()
+
+	request := mcp.CallToolRequest{}
+	request.Params.Arguments = arguments
+	result, err := NewDescribeTool(Registry).Handler(context.Background(), request)
+	require.NoError(t, err)
+	require.False(t, result.IsError, result.Content)
+	return result.StructuredContent.(describeResult).Tools
+}
+
+func describedNames(tools []describedTool) []string {
+	names := []string{}
+	for _, tool := range tools {
+		names = append(names, tool.Name)
+	}
+	return names
+}
+
+func TestDescribeTool(t *testing.T) {
+	t.Run("should describe every tool", func(t *testing.T) {
+		tools := describeTools(t, map[string]any{})
+		require.Len(t, tools, len(Registry))
+	})
+
+	t.Run("should search by keyword", func(t *testing.T) {
+		tools := describeTools(t, map[string]any{"query": "Kubernetes CLUSTER details"})
+		require.Equal(t, []string{"container_security_k8_cluster_get"}, describedNames(tools))
+
+		cluster := tools[0]
+		require.Equal(t, "Get Kubernetes Cluster", cluster.Title)
+		require.Equal(t, ToolsetContainer, cluster.Toolset)
+		require.Equal(t, ModeRead, cluster.Mode)
+		require.Equal(t, []string{"GET /v3.0/containerSecurity/kubernetesClusters/{clusterId}"}, cluster.APIPaths)
+		require.Equal(t, []string{"clusterID"}, cluster.Arguments)
+		require.Equal(t, []string{"clusterID"}, cluster.RequiredArguments)
+		require.Equal(t, []string{"read", "idempotent"}, cluster.Capabilities)
+	})
+
+	t.Run("should search by API path and permission", func(t *testing.T) {
+		tools := describeTools(t, map[string]any{"query": "/v3.0/oat/detections"})
+		require.Equal(t, []string{"workbench_observed_attack_techniques_list"}, describedNames(tools))
+
+		tools = describeTools(t, map[string]any{"query": "api keys: manage"})
+		require.Equal(t, []string{"iam_api_keys_delete"}, describedNames(tools))
+	})
+
+	t.Run("should filter by toolset and capabilities", func(t *testing.T) {
+		tools := describeTools(t, map[string]any{"toolset": ToolsetIAM, "capabilities": []any{"destructive"}})
+		require.Equal(t, []string{"iam_api_keys_delete", "iam_account_update", "iam_account_delete"}, describedNames(tools))
+
+		tools = describeTools(t, map[string]any{"query": "exception", "capabilities": []any{"write", "idempotent"}})
+		require.Equal(t, []string{
+			"threatintel_exceptions_add",
+			"threatintel_exceptions_bulk_add",
+			"threatintel_exceptions_delete",
+		}, describedNames(tools))
+	})
+
+	t.Run("should reject unknown toolsets and capabilities", func(t *testing.T) {
+		for _, arguments := range []map[string]any{
+			{"toolset": "containers"},
+			{"capabilities": []any{"readOnly"}},
+		} {
+			request := mcp.CallToolRequest{}
+			request.Params.Arguments = arguments
+			result, err := NewDescribeTool(Registry).Handler(context.Background(), request)
+			require.NoError(t, err)
+			require.True(t, result.IsError)
+		}
+	})
+}
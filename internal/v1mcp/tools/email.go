@@ -10,12 +10,6 @@ import (
 	"github.com/trendmicro/vision-one-mcp-server/internal/v1mcp/tooldescriptions"
 )
 
-var ToolsetsReadOnlyEmail = []func(client *v1client.V1ApiClient) mcpserver.ServerTool{
-	toolEmailSecurityAccountsList,
-	toolEmailSecurityDomainsList,
-	toolEmailSecurityServersList,
-}
-
 func toolEmailSecurityAccountsList(client *v1client.V1ApiClient) mcpserver.ServerTool {
 	return mcpserver.ServerTool{
 		Tool: mcp.NewTool(
@@ -24,14 +18,19 @@ func toolEmailSecurityAccountsList(client *v1client.V1ApiClient) mcpserver.Serve
 				"Returns all email accounts managed by an email protection solution or with email sensor detection enabled.",
 			),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
-				ReadOnlyHint: toPtr(true),
+				Title:           "List Email Security Accounts",
+				ReadOnlyHint:    toPtr(true),
+				DestructiveHint: toPtr(false),
+				IdempotentHint:  toPtr(true),
+				OpenWorldHint:   toPtr(false),
 			}),
 			mcp.WithNumber("top",
 				mcp.Description(tooldescriptions.DefaultTop),
 				mcp.Min(10),
 				mcp.Max(1000),
 			),
-			mcp.WithString("filter", mcp.Description(tooldescriptions.FilterEmailAccounts)),
+			mcp.WithString("filter", mcp.Description(tooldescriptions.FilterEmailAccounts.Description())),
+			mcp.WithOutputSchema[listResponse[map[string]any]](),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			top, err := optionalIntValue("top", request.GetArguments())
@@ -60,13 +59,18 @@ func toolEmailSecurityDomainsList(client *v1client.V1ApiClient) mcpserver.Server
 			"email_security_domains_list",
 			mcp.WithDescription("Returns all email domains managed by an email protection solution."),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
-				ReadOnlyHint: toPtr(true),
+				Title:           "List Email Security Domains",
+				ReadOnlyHint:    toPtr(true),
+				DestructiveHint: toPtr(false),
+				IdempotentHint:  toPtr(true),
+				OpenWorldHint:   toPtr(false),
 			}),
 			mcp.WithNumber("top",
 				mcp.Description(tooldescriptions.DefaultTop),
 				mcp.Min(10),
 				mcp.Max(1000),
 			),
+			mcp.WithOutputSchema[listResponse[map[string]any]](),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			top, err := optionalIntValue("top", request.GetArguments())
@@ -89,13 +93,18 @@ func toolEmailSecurityServersList(client *v1client.V1ApiClient) mcpserver.Server
 			"email_security_servers_list",
 			mcp.WithDescription("Returns all email servers managed by an on-premises email protection solution."),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
-				ReadOnlyHint: toPtr(true),
+				Title:           "List Email Security Servers",
+				ReadOnlyHint:    toPtr(true),
+				DestructiveHint: toPtr(false),
+				IdempotentHint:  toPtr(true),
+				OpenWorldHint:   toPtr(false),
 			}),
 			mcp.WithNumber("top",
 				mcp.Description(tooldescriptions.DefaultTop),
 				mcp.Min(10),
 				mcp.Max(1000),
 			),
+			mcp.WithOutputSchema[listResponse[map[string]any]](),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			top, err := optionalIntValue("top", request.GetArguments())
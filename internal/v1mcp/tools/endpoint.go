@@ -10,22 +10,19 @@ import (
 	"github.com/trendmicro/vision-one-mcp-server/internal/v1mcp/tooldescriptions"
 )
 
-var ToolsetsReadOnlyEndpoint = []func(client *v1client.V1ApiClient) mcpserver.ServerTool{
-	toolEndpointSecurityEndpointsList,
-	toolEndpointSecurityEndpointGet,
-	toolEndpointSecurityTaskList,
-	toolEndpointSecurityTaskGet,
-	toolEndpointSecurityVersionControlPoliciesList,
-	toolEndpointSecurityAgentUpdatePoliciesList,
-}
-
 func toolEndpointSecurityEndpointsList(client *v1client.V1ApiClient) mcpserver.ServerTool {
 	return mcpserver.ServerTool{
 		Tool: mcp.NewTool(
 			"endpoint_security_endpoints_list",
 			mcp.WithDescription("Displays a detailed list of your endpoints"),
-			mcp.WithReadOnlyHintAnnotation(true),
-			mcp.WithString("filter", mcp.Description(tooldescriptions.FilterEndpoints)),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:           "List Endpoints",
+				ReadOnlyHint:    toPtr(true),
+				DestructiveHint: toPtr(false),
+				IdempotentHint:  toPtr(true),
+				OpenWorldHint:   toPtr(false),
+			}),
+			mcp.WithString("filter", mcp.Description(tooldescriptions.FilterEndpoints.Description())),
 			mcp.WithString("orderBy",
 				mcp.Description("The field by which the results are sorted"),
 				mcp.Enum(
@@ -40,6 +37,7 @@ func toolEndpointSecurityEndpointsList(client *v1client.V1ApiClient) mcpserver.S
 			),
 			mcp.WithString("skipToken",
 				mcp.Description("The token use to paginate. Used to retrieve the next page of information.")),
+			mcp.WithOutputSchema[listResponse[endpoint]](),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			filter, err := optionalValue[string]("filter", request.GetArguments())
@@ -73,8 +71,15 @@ func toolEndpointSecurityEndpointGet(client *v1client.V1ApiClient) mcpserver.Ser
 		Tool: mcp.NewTool(
 			"endpoint_security_endpoint_get",
 			mcp.WithDescription("Displays the detailed profile of the specified endpoint"),
-			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:           "Get Endpoint",
+				ReadOnlyHint:    toPtr(true),
+				DestructiveHint: toPtr(false),
+				IdempotentHint:  toPtr(true),
+				OpenWorldHint:   toPtr(false),
+			}),
 			mcp.WithString("endpointID", mcp.Required()),
+			mcp.WithOutputSchema[endpoint](),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			endpointID, err := requiredValue[string]("endpointID", request.GetArguments())
@@ -93,9 +98,15 @@ func toolEndpointSecurityTaskList(client *v1client.V1ApiClient) mcpserver.Server
 		Tool: mcp.NewTool(
 			"endpoint_security_tasks_list",
 			mcp.WithDescription("Displays the tasks of your endpoints in a paginated list"),
-			mcp.WithReadOnlyHintAnnotation(true),
-
-			mcp.WithString("filter", mcp.Description(tooldescriptions.FilterEndpointTasks)),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:           "List Endpoint Security Tasks",
+				ReadOnlyHint:    toPtr(true),
+				DestructiveHint: toPtr(false),
+				IdempotentHint:  toPtr(true),
+				OpenWorldHint:   toPtr(false),
+			}),
+
+			mcp.WithString("filter", mcp.Description(tooldescriptions.FilterEndpointTasks.Description())),
 			mcp.WithString("orderBy",
 				mcp.Description("The field by which the results are sorted"),
 				mcp.Enum(
@@ -116,6 +127,7 @@ func toolEndpointSecurityTaskList(client *v1client.V1ApiClient) mcpserver.Server
 			mcp.WithString("endDateTime",
 				mcp.Description("The end time of the data retrieval range, in ISO 8601 format."),
 			),
+			mcp.WithOutputSchema[listResponse[endpointTask]](),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			filter, err := optionalValue[string]("filter", request.GetArguments())
@@ -161,8 +173,15 @@ func toolEndpointSecurityTaskGet(client *v1client.V1ApiClient) mcpserver.ServerT
 		Tool: mcp.NewTool(
 			"endpoint_security_task_get",
 			mcp.WithDescription("Displays the status of the specified task"),
-			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:           "Get Endpoint Security Task",
+				ReadOnlyHint:    toPtr(true),
+				DestructiveHint: toPtr(false),
+				IdempotentHint:  toPtr(true),
+				OpenWorldHint:   toPtr(false),
+			}),
 			mcp.WithString("taskID", mcp.Required()),
+			mcp.WithOutputSchema[endpointTask](),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			taskID, err := requiredValue[string]("taskID", request.GetArguments())
@@ -181,7 +200,14 @@ func toolEndpointSecurityVersionControlPoliciesList(client *v1client.V1ApiClient
 		Tool: mcp.NewTool(
 			"endpoint_security_version_control_policies_list",
 			mcp.WithDescription("Displays your Endpoint Version Control policies"),
-			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:           "List Agent Version Control Policies",
+				ReadOnlyHint:    toPtr(true),
+				DestructiveHint: toPtr(false),
+				IdempotentHint:  toPtr(true),
+				OpenWorldHint:   toPtr(false),
+			}),
+			mcp.WithOutputSchema[listResponse[versionControlPolicy]](),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			orderBy, err := optionalValue[string]("orderBy", request.GetArguments())
@@ -210,7 +236,14 @@ func toolEndpointSecurityAgentUpdatePoliciesList(client *v1client.V1ApiClient) m
 		Tool: mcp.NewTool(
 			"endpoint_security_agent_update_policies_list",
 			mcp.WithDescription("Displays the available agent update policies"),
-			mcp.WithReadOnlyHintAnnotation(true),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:           "List Agent Update Policies",
+				ReadOnlyHint:    toPtr(true),
+				DestructiveHint: toPtr(false),
+				IdempotentHint:  toPtr(true),
+				OpenWorldHint:   toPtr(false),
+			}),
+			mcp.WithOutputSchema[listResponse[agentUpdatePolicy]](),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			resp, err := client.EndpointSecurityListAgentUpdatePolicies()
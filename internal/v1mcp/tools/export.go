@@ -0,0 +1,333 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	mcpserver "github.com/mark3labs/mcp-go/server"
+)
+
+const (
+	// exportDefaultMaxRecords is the number of records exported when maxRecords is not set.
+	exportDefaultMaxRecords = 10000
+	// exportMaxRecords is the largest number of records a single export can write.
+	exportMaxRecords = 100000
+	// exportMaxPages stops an export of a list that never ends.
+	exportMaxPages = 1000
+)
+
+// exportIgnoredArguments are arguments of the exported tool that would
+// change the shape of its result, they are removed before each page is read.
+var exportIgnoredArguments = []string{formatArgument, shapingJSONPath, shapingMaxBytes}
+
+type exportField struct {
+	Name    string   `json:"name"`
+	Types   []string `json:"types" jsonschema:"description=The JSON types of the values of the field"`
+	Present int      `json:"present" jsonschema:"description=The number of records with the field"`
+}
+
+type exportResult struct {
+	Path     string        `json:"path" jsonschema:"description=The absolute path of the written file"`
+	Format   string        `json:"format"`
+	Records  int           `json:"records"`
+	Pages    int           `json:"pages"`
+	Complete bool          `json:"complete" jsonschema:"description=False when more records were available than were exported"`
+	Note     string        `json:"note,omitempty"`
+	Fields   []exportField `json:"fields" jsonschema:"description=The top level fields of the exported records"`
+}
+
+// NewExportTool returns the export_to_file tool. It reads every page of one
+// of the given list tools and writes the items to a file in exportDir, so
+// large exports never enter the context window. Only the path, the number of
+// records and the fields of the records are returned.
+func NewExportTool(exportDir string, serverTools map[string]*mcpserver.ServerTool) (mcpserver.ServerTool, error) {
+	root, err := filepath.Abs(exportDir)
+	if err != nil {
+		return mcpserver.ServerTool{}, err
+	}
+	info, err := os.Stat(root)
+	if err != nil {
+		return mcpserver.ServerTool{}, fmt.Errorf("invalid export directory: %w", err)
+	}
+	if !info.IsDir() {
+		return mcpserver.ServerTool{}, fmt.Errorf("invalid export directory: %s is not a directory", root)
+	}
+
+	listTools := map[string]*mcpserver.ServerTool{}
+	for name, tool := range serverTools {
+		if isListTool(tool.Tool) {
+			listTools[name] = tool
+		}
+	}
+
+	return mcpserver.ServerTool{
+		Tool: mcp.NewTool(
+			"export_to_file",
+			mcp.WithDescription(fmt.Sprintf(
+				"Runs a list tool, following every page, and writes the items to a local file in %s. "+
+					"Only the file path, the number of records and the fields of the records are returned. "+
+					"Use it for large exports that should not be read into the conversation", root)),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:           "Export List to File",
+				ReadOnlyHint:    toPtr(false),
+				DestructiveHint: toPtr(false),
+				IdempotentHint:  toPtr(false),
+				OpenWorldHint:   toPtr(false),
+			}),
+			mcp.WithString("tool",
+				mcp.Required(),
+				mcp.Description("The name of the list tool to export"),
+				mcp.Enum(slices.Sorted(maps.Keys(listTools))...),
+			),
+			mcp.WithObject("arguments",
+				mcp.Description("The arguments of the list tool such as filter, where or fields. Paging arguments are set by the export"),
+			),
+			mcp.WithString("format",
+				mcp.Description("The format of the file. Default ndjson"),
+				mcp.Enum(formatJSON, formatNDJSON, formatCSV),
+			),
+			mcp.WithString("fileName",
+				mcp.Description("The name of the file relative to the export directory. Defaults to the tool name and the current time. Existing files are not overwritten"),
+			),
+			mcp.WithNumber("maxRecords",
+				mcp.Description(fmt.Sprintf("The maximum number of records to export. Default %d, at most %d", exportDefaultMaxRecords, exportMaxRecords)),
+			),
+			mcp.WithOutputSchema[exportResult](),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			name, err := requiredValue[string]("tool", request.GetArguments())
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			tool, ok := listTools[name]
+			if !ok {
+				return mcp.NewToolResultError(fmt.Sprintf("%s is not a list tool", name)), nil
+			}
+
+			arguments := map[string]any{}
+			if err := optionalJSONValue("arguments", request.GetArguments(), &arguments); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			format, err := optionalValue[string]("format", request.GetArguments())
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if format == "" {
+				format = formatNDJSON
+			}
+
+			fileName, err := optionalValue[string]("fileName", request.GetArguments())
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if fileName == "" {
+				fileName = fmt.Sprintf("%s-%s.%s", name, time.Now().UTC().Format("20060102T150405Z"), format)
+			}
+			if !filepath.IsLocal(fileName) {
+				return mcp.NewToolResultError(fmt.Sprintf("invalid fileName %q: the file must be inside the export directory", fileName)), nil
+			}
+
+			maxRecords, err := optionalIntValue("maxRecords", request.GetArguments())
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if maxRecords <= 0 {
+				maxRecords = exportDefaultMaxRecords
+			}
+			if maxRecords > exportMaxRecords {
+				return mcp.NewToolResultError(fmt.Sprintf("maxRecords must be at most %d", exportMaxRecords)), nil
+			}
+
+			result := exportResult{Format: format, Complete: true}
+			items, err := readAllPages(ctx, tool, arguments, maxRecords, &result)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			result.Records = len(items)
+			result.Fields = exportFields(items)
+
+			fields := []string{}
+			if err := optionalJSONValue(shapingFields, arguments, &fields); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if len(fields) == 0 {
+				fields = itemFields(items)
+			}
+
+			var content string
+			switch format {
+			case formatJSON:
+				b, err := json.Marshal(items)
+				if err != nil {
+					return nil, err
+				}
+				content = string(b)
+			case formatNDJSON:
+				content, err = renderNDJSON(items, nil)
+			case formatCSV:
+				content, err = renderCSV(items, fieldColumns(fields...))
+			default:
+				return mcp.NewToolResultError(fmt.Sprintf("unsupported format: %s", format)), nil
+			}
+			if err != nil {
+				return nil, err
+			}
+
+			if err := writeExportFile(root, fileName, content); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			result.Path = filepath.Join(root, fileName)
+
+			b, err := json.Marshal(result)
+			if err != nil {
+				return nil, err
+			}
+			return mcp.NewToolResultStructured(result, string(b)), nil
+		},
+	}, nil
+}
+
+// readAllPages calls the tool until there is no next page or maxRecords
+// items were read. Tools without a skipToken argument only return their
+// first page.
+func readAllPages(ctx context.Context, tool *mcpserver.ServerTool, arguments map[string]any, maxRecords int, result *exportResult) ([]map[string]any, error) {
+	arguments = maps.Clone(arguments)
+	for _, name := range exportIgnoredArguments {
+		delete(arguments, name)
+	}
+	delete(arguments, "skipToken")
+
+	_, canPage := tool.Tool.InputSchema.Properties["skipToken"]
+
+	items := []map[string]any{}
+	for result.Pages < exportMaxPages {
+		request := mcp.CallToolRequest{}
+		request.Params.Name = tool.Tool.Name
+		request.Params.Arguments = arguments
+
+		toolResult, err := tool.Handler(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+
+		text := ""
+		if len(toolResult.Content) > 0 {
+			if content, ok := mcp.AsTextContent(toolResult.Content[0]); ok {
+				text = content.Text
+			}
+		}
+		if toolResult.IsError {
+			return nil, fmt.Errorf("failed to read page %d of %s: %s", result.Pages+1, tool.Tool.Name, text)
+		}
+
+		page := listResponse[map[string]any]{}
+		if err := json.Unmarshal([]byte(text), &page); err != nil {
+			return nil, fmt.Errorf("failed to read page %d of %s: %w", result.Pages+1, tool.Tool.Name, err)
+		}
+		result.Pages++
+
+		items = append(items, page.Items...)
+		if len(items) >= maxRecords {
+			if len(items) > maxRecords || page.NextLink != "" {
+				result.Complete = false
+				result.Note = fmt.Sprintf("only the first %d records were exported, increase maxRecords to export more", maxRecords)
+			}
+			return items[:min(len(items), maxRecords)], nil
+		}
+
+		if page.NextLink == "" {
+			return items, nil
+		}
+
+		skipToken := ""
+		if u, err := url.Parse(page.NextLink); err == nil {
+			skipToken = u.Query().Get("skipToken")
+		}
+		if !canPage || skipToken == "" {
+			result.Complete = false
+			result.Note = fmt.Sprintf("%s cannot read the next pages, only the first page was exported", tool.Tool.Name)
+			return items, nil
+		}
+		arguments["skipToken"] = skipToken
+	}
+
+	result.Complete = false
+	result.Note = fmt.Sprintf("the export stopped after %d pages", exportMaxPages)
+	return items, nil
+}
+
+// writeExportFile creates the file in the export directory. Opening the file
+// through os.Root rejects names that resolve outside the directory, for
+// example through symbolic links.
+func writeExportFile(dir, fileName, content string) error {
+	root, err := os.OpenRoot(dir)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = root.Close()
+	}()
+
+	f, err := root.OpenFile(fileName, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if errors.Is(err, os.ErrExist) {
+		return fmt.Errorf("%s already exists in the export directory, choose another fileName", fileName)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", fileName, err)
+	}
+
+	if _, err := f.WriteString(content); err != nil {
+		_ = f.Close()
+		return fmt.Errorf("failed to write %s: %w", fileName, err)
+	}
+	return f.Close()
+}
+
+// exportFields describes the top level fields of the exported records.
+func exportFields(items []map[string]any) []exportField {
+	fields := []exportField{}
+	for _, name := range itemFields(items) {
+		field := exportField{Name: name, Types: []string{}}
+		for _, item := range items {
+			value, ok := item[name]
+			if !ok {
+				continue
+			}
+			field.Present++
+			if t := jsonType(value); !slices.Contains(field.Types, t) {
+				field.Types = append(field.Types, t)
+			}
+		}
+		slices.Sort(field.Types)
+		fields = append(fields, field)
+	}
+	return fields
+}
+
+func jsonType(value any) string {
+	switch value.(type) {
+	case nil:
+		return "null"
+	case string:
+		return "string"
+	case float64:
+		return "number"
+	case bool:
+		return "boolean"
+	case []any:
+		return "array"
+	default:
+		return "object"
+	}
+}
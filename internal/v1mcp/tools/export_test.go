@@ -0,0 +1,143 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	"github.com/stretchr/testify/require"
+)
+
+// pagedListTool returns a list tool with three pages of two items.
+func pagedListTool(t *testing.T, name string, withSkipToken bool) *mcpserver.ServerTool {
+	options := []mcp.ToolOption{mcp.WithOutputSchema[listResponse[map[string]any]]()}
+	if withSkipToken {
+		options = append(options, mcp.WithString("skipToken"))
+	}
+
+	return &mcpserver.ServerTool{
+		Tool: mcp.NewTool(name, options...),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			require.NotContains(t, request.GetArguments(), formatArgument)
+
+			page := 0
+			if token, ok := request.GetArguments()["skipToken"].(string); ok {
+				_, err := fmt.Sscanf(token, "page%d", &page)
+				require.NoError(t, err)
+			}
+
+			response := listResponse[map[string]any]{TotalCount: 6}
+			for i := range 2 {
+				id := page*2 + i
+				response.Items = append(response.Items, map[string]any{"id": fmt.Sprint(id), "name": fmt.Sprintf("item %d", id), "score": id})
+			}
+			if page < 2 {
+				response.NextLink = fmt.Sprintf("https://api.xdr.trendmicro.com/v3.0/list?top=2&skipToken=page%d", page+1)
+			}
+
+			b, err := json.Marshal(response)
+			require.NoError(t, err)
+			return mcp.NewToolResultText(string(b)), nil
+		},
+	}
+}
+
+func callExportTool(t *testing.T, tool mcpserver.ServerTool, args map[string]any) (*mcp.CallToolResult, exportResult) {
+	t.Helper()
+	result := callTool(context.Background(), t, tool, args)
+
+	exported := exportResult{}
+	if !result.IsError {
+		require.NoError(t, json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &exported))
+	}
+	return result, exported
+}
+
+func TestExportTool(t *testing.T) {
+	dir := t.TempDir()
+	tool, err := NewExportTool(dir, map[string]*mcpserver.ServerTool{
+		"paged_list":   pagedListTool(t, "paged_list", true),
+		"single_page":  pagedListTool(t, "single_page", false),
+		"not_listable": {Tool: mcp.NewTool("not_listable")},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"paged_list", "single_page"}, tool.Tool.InputSchema.Properties["tool"].(map[string]any)["enum"])
+
+	t.Run("should export every page as ndjson", func(t *testing.T) {
+		result, exported := callExportTool(t, tool, map[string]any{"tool": "paged_list", "fileName": "all.ndjson", "arguments": map[string]any{"format": "markdown"}})
+		require.False(t, result.IsError)
+		require.Equal(t, filepath.Join(dir, "all.ndjson"), exported.Path)
+		require.Equal(t, 6, exported.Records)
+		require.Equal(t, 3, exported.Pages)
+		require.True(t, exported.Complete)
+		require.Equal(t, []exportField{
+			{Name: "id", Types: []string{"string"}, Present: 6},
+			{Name: "name", Types: []string{"string"}, Present: 6},
+			{Name: "score", Types: []string{"number"}, Present: 6},
+		}, exported.Fields)
+
+		b, err := os.ReadFile(exported.Path)
+		require.NoError(t, err)
+		require.Len(t, strings.Split(strings.TrimSpace(string(b)), "\n"), 6)
+		require.NotContains(t, result.Content[0].(mcp.TextContent).Text, "item 0")
+	})
+
+	t.Run("should export csv with the requested fields and stop at maxRecords", func(t *testing.T) {
+		_, exported := callExportTool(t, tool, map[string]any{
+			"tool":       "paged_list",
+			"format":     "csv",
+			"fileName":   "some.csv",
+			"maxRecords": float64(3),
+			"arguments":  map[string]any{"fields": []any{"id", "name"}},
+		})
+		require.Equal(t, 3, exported.Records)
+		require.False(t, exported.Complete)
+		require.NotEmpty(t, exported.Note)
+
+		b, err := os.ReadFile(exported.Path)
+		require.NoError(t, err)
+		require.Equal(t, "id,name\n0,item 0\n1,item 1\n2,item 2\n", string(b))
+	})
+
+	t.Run("should export the first page of tools that cannot page", func(t *testing.T) {
+		_, exported := callExportTool(t, tool, map[string]any{"tool": "single_page", "format": "json", "fileName": "first.json"})
+		require.Equal(t, 2, exported.Records)
+		require.False(t, exported.Complete)
+	})
+
+	t.Run("should not overwrite files", func(t *testing.T) {
+		result, _ := callExportTool(t, tool, map[string]any{"tool": "paged_list", "fileName": "all.ndjson"})
+		require.True(t, result.IsError)
+		require.Contains(t, result.Content[0].(mcp.TextContent).Text, "already exists")
+	})
+
+	t.Run("should reject files outside the export directory", func(t *testing.T) {
+		outside := t.TempDir()
+		require.NoError(t, os.Symlink(outside, filepath.Join(dir, "link")))
+
+		for _, fileName := range []string{"../escape.ndjson", "/tmp/escape.ndjson", "a/../../escape.ndjson", "link/escape.ndjson"} {
+			result, _ := callExportTool(t, tool, map[string]any{"tool": "paged_list", "fileName": fileName})
+			require.True(t, result.IsError, fileName)
+		}
+
+		entries, err := os.ReadDir(outside)
+		require.NoError(t, err)
+		require.Empty(t, entries)
+	})
+
+	t.Run("should reject tools that are not lists", func(t *testing.T) {
+		result, _ := callExportTool(t, tool, map[string]any{"tool": "not_listable"})
+		require.True(t, result.IsError)
+	})
+}
+
+func TestNewExportToolInvalidDirectory(t *testing.T) {
+	_, err := NewExportTool(filepath.Join(t.TempDir(), "missing"), nil)
+	require.Error(t, err)
+}
@@ -0,0 +1,98 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	"github.com/trendmicro/vision-one-mcp-server/internal/v1mcp/filter"
+	"github.com/trendmicro/vision-one-mcp-server/internal/v1mcp/tooldescriptions"
+)
+
+// toolFilters are the filters of the filter arguments of each tool. The
+// tools describe these arguments with the Description of the same filter.
+var toolFilters = map[string]map[string]tooldescriptions.Filter{
+	"cam_gcp_accounts_list":                                {"filter": tooldescriptions.CAMListGCPProjectsFilterDescription},
+	"cam_aws_accounts_list":                                {"filter": tooldescriptions.FilterAWSAccounts},
+	"cam_alibaba_accounts_list":                            {"filter": tooldescriptions.FilterAlibabaAccounts},
+	"cloud_posture_account_checks_list":                    {"filter": tooldescriptions.FilterCloudPostureChecks},
+	"cloud_risk_management_accounts_list":                  {"filter": tooldescriptions.FilterCloudRiskManagementAccounts},
+	"cloud_risk_management_account_scan_rules_get":         {"filter": tooldescriptions.FilterCloudRiskManagementScanRules},
+	"cloud_risk_management_services_list":                  {"filter": tooldescriptions.FilterCloudRiskManagementServices},
+	"container_security_ecs_clusters_list":                 {"filter": tooldescriptions.FilterECS},
+	"container_security_image_vulnerabilities_list":        {"filter": tooldescriptions.FilterContainerVuln},
+	"container_security_k8_clusters_list":                  {"filter": tooldescriptions.FilterK8s},
+	"container_security_k8_images_list":                    {"filter": tooldescriptions.FilterK8Images},
+	"crem_attack_surface_cloud_asset_risk_indicators_list": {"filter": tooldescriptions.FilterCloudAssetRiskIndicators},
+	"crem_attack_surface_cloud_assets_list":                {"filter": tooldescriptions.FilterCloudAssets},
+	"crem_attack_surface_custom_tags_list":                 {"filter": tooldescriptions.FilterCustomTags},
+	"crem_attack_surface_devices_list":                     {"filter": tooldescriptions.FilterAttackSurfaceDevices},
+	"crem_attack_surface_domain_accounts_list":             {"filter": tooldescriptions.FilterDomainAccounts},
+	"crem_attack_surface_global_fqdns_list":                {"filter": tooldescriptions.FilterFQDNS},
+	"crem_attack_surface_high_risk_users_list":             {"filter": tooldescriptions.FilterHighRiskUsers},
+	"crem_attack_surface_local_app_devices_list":           {"filter": tooldescriptions.FilterLocalAppDevices},
+	"crem_attack_surface_local_app_executable_files_list":  {"filter": tooldescriptions.FilterLocalAppExecutables},
+	"crem_attack_surface_local_app_risk_indicators_list":   {"filter": tooldescriptions.FilterLocalAppRiskIndicators},
+	"crem_attack_surface_local_apps_list":                  {"filter": tooldescriptions.FilterLocalApps},
+	"crem_attack_surface_public_ips_list":                  {"filter": tooldescriptions.FilterIps},
+	"crem_attack_surface_service_accounts_list":            {"filter": tooldescriptions.FilterServiceAccounts},
+	"email_security_accounts_list":                         {"filter": tooldescriptions.FilterEmailAccounts},
+	"endpoint_security_endpoints_list":                     {"filter": tooldescriptions.FilterEndpoints},
+	"endpoint_security_tasks_list":                         {"filter": tooldescriptions.FilterEndpointTasks},
+	"iam_accounts_list":                                    {"filter": tooldescriptions.FilterUserAccounts},
+	"iam_api_keys_list":                                    {"filter": tooldescriptions.FilterApiKeys},
+	"threatintel_exceptions_list":                          {"filter": tooldescriptions.FilterSuspiciousObjectExceptions},
+	"threatintel_feeds_list":                               {"contextualFilter": tooldescriptions.FilterThreatIntelFeeds},
+	"threatintel_intelligence_reports_list":                {"filter": tooldescriptions.FilterIntelligenceReports},
+	"threatintel_suspicious_objects_list":                  {"filter": tooldescriptions.FilterSuspiciousObjects},
+	"threatintel_tasks_list":                               {"filter": tooldescriptions.FilterThreatIntelTasks},
+	"workbench_alerts_list":                                {"filter": tooldescriptions.FilterWorkbenchAlerts},
+	"workbench_observed_attack_techniques_list":            {"filter": tooldescriptions.ObservedAttackFilter},
+}
+
+// filterFields returns the supported fields of each filter argument of the
+// tool.
+func filterFields(tool mcp.Tool) map[string][]tooldescriptions.FilterField {
+	fields := map[string][]tooldescriptions.FilterField{}
+	for name, f := range toolFilters[tool.Name] {
+		if _, ok := tool.InputSchema.Properties[name]; ok && len(f.Fields) > 0 {
+			fields[name] = f.Fields
+		}
+	}
+	return fields
+}
+
+// WithFilterValidation checks the filter arguments of a tool before the
+// request is sent. Syntax errors, unknown fields and unsupported values are
+// returned as tool errors with the position of the problem and a suggestion,
+// instead of the 400 response of the API.
+func WithFilterValidation(tool mcpserver.ServerTool) mcpserver.ServerTool {
+	fields := filterFields(tool.Tool)
+	if len(fields) == 0 {
+		return tool
+	}
+
+	handler := tool.Handler
+	tool.Handler = func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		for _, name := range slices.Sorted(maps.Keys(fields)) {
+			value, err := optionalValue[string](name, request.GetArguments())
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if strings.TrimSpace(value) == "" {
+				continue
+			}
+
+			if err := filter.Check(value, fields[name]); err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("invalid %s %q:\n%s", name, value, err)), nil
+			}
+		}
+		return handler(ctx, request)
+	}
+
+	return tool
+}
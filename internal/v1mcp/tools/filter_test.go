@@ -0,0 +1,91 @@
+package tools
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	"github.com/stretchr/testify/require"
+	"github.com/trendmicro/vision-one-mcp-server/internal/v1mcp/filter"
+	"github.com/trendmicro/vision-one-mcp-server/internal/v1mcp/tooldescriptions"
+)
+
+func TestWithFilterValidation(t *testing.T) {
+	called := false
+	tool := WithFilterValidation(mcpserver.ServerTool{
+		Tool: mcp.NewTool("workbench_alerts_list", mcp.WithString("filter", mcp.Description(tooldescriptions.FilterWorkbenchAlerts.Description()))),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			called = true
+			return mcp.NewToolResultText("{}"), nil
+		},
+	})
+
+	call := func(filter string) *mcp.CallToolResult {
+		called = false
+		request := mcp.CallToolRequest{}
+		request.Params.Arguments = map[string]any{"filter": filter}
+		result, err := tool.Handler(context.Background(), request)
+		require.NoError(t, err)
+		return result
+	}
+
+	t.Run("should send valid filters", func(t *testing.T) {
+		require.False(t, call("severity eq 'high' and status eq 'Open'").IsError)
+		require.True(t, called)
+		require.False(t, call("").IsError)
+		require.True(t, called)
+	})
+
+	t.Run("should not send invalid filters", func(t *testing.T) {
+		result := call("severity eq 'high' and modell eq 'x'")
+		require.True(t, result.IsError)
+		require.False(t, called)
+		require.Contains(t, result.Content[0].(mcp.TextContent).Text, "unknown field modell, did you mean model?")
+
+		result = call("severity eq high")
+		require.True(t, result.IsError)
+		require.Contains(t, result.Content[0].(mcp.TextContent).Text, "severity eq 'high'")
+	})
+
+	t.Run("should not wrap tools without supported fields", func(t *testing.T) {
+		other := mcpserver.ServerTool{Tool: mcp.NewTool("other", mcp.WithString("filter", mcp.Description("A filter")))}
+		require.Nil(t, WithFilterValidation(other).Handler)
+	})
+}
+
+func TestToolFilters(t *testing.T) {
+	tools := allTools(t)
+
+	for name, tool := range tools {
+		for _, argument := range []string{"filter", "contextualFilter"} {
+			property, ok := tool.InputSchema.Properties[argument].(map[string]any)
+			if !ok {
+				continue
+			}
+			filter, ok := toolFilters[name][argument]
+			require.True(t, ok, "%s has no filter for %s", name, argument)
+			require.Equal(t, filter.Description(), property["description"], "%s: %s", name, argument)
+		}
+	}
+
+	for name, filters := range toolFilters {
+		_, ok := tools[name]
+		require.True(t, ok, "no tool named %s", name)
+		for argument, f := range filters {
+			require.Contains(t, tools[name].InputSchema.Properties, argument, name)
+			require.NotEmpty(t, f.Fields, "%s: %s", name, argument)
+
+			names := map[string]bool{}
+			for _, field := range f.Fields {
+				require.NotEmpty(t, field.Description, "%s: %s", name, field.Name)
+				require.False(t, names[field.Name], "%s: duplicate field %s", name, field.Name)
+				names[field.Name] = true
+			}
+
+			for _, example := range f.Examples {
+				require.NoError(t, filter.Check(example.Filter, f.Fields), "%s: %s", name, example.Filter)
+			}
+		}
+	}
+}
@@ -0,0 +1,356 @@
+package tools
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	mcpserver "github.com/mark3labs/mcp-go/server"
+)
+
+const (
+	formatArgument = "format"
+	formatJSON     = "json"
+	formatMarkdown = "markdown"
+	formatCSV      = "csv"
+	formatNDJSON   = "ndjson"
+	formatSummary  = "summary"
+)
+
+// listColumn is a column of a list rendered as a table.
+type listColumn struct {
+	name  string
+	value func(item map[string]any) any
+}
+
+// fieldColumn is a column showing a field of the items. Nested fields are
+// separated by dots.
+func fieldColumn(path string) listColumn {
+	return listColumn{name: path, value: func(item map[string]any) any {
+		return lookupField(item, path)
+	}}
+}
+
+func fieldColumns(paths ...string) []listColumn {
+	columns := make([]listColumn, 0, len(paths))
+	for _, path := range paths {
+		columns = append(columns, fieldColumn(path))
+	}
+	return columns
+}
+
+// indicatorValueColumn shows the value of a suspicious object or exception,
+// which is stored in the field named after its type.
+var indicatorValueColumn = listColumn{name: "value", value: func(item map[string]any) any {
+	return item[stringField(item, "type")]
+}}
+
+var (
+	camAccountColumns       = fieldColumns("id", "name", "state", "lastSyncedDateTime", "description")
+	deviceColumns           = fieldColumns("id", "deviceName", "ip", "osName", "latestRiskScore", "criticality", "lastDetectedDateTime")
+	suspiciousObjectColumns = append(
+		[]listColumn{fieldColumn("type"), indicatorValueColumn},
+		fieldColumns("scanAction", "riskLevel", "description", "inExceptionList", "expiredDateTime")...,
+	)
+	exceptionColumns = append(
+		[]listColumn{fieldColumn("type"), indicatorValueColumn},
+		fieldColumns("description", "lastModifiedDateTime")...,
+	)
+)
+
+// listColumns are the default columns of tables rendered from list tools.
+// Tools without default columns show every field of the items.
+var listColumns = map[string][]listColumn{
+	"workbench_alerts_list": fieldColumns(
+		"id", "severity", "score", "model", "status", "investigationResult", "createdDateTime", "impactScope.entities.entityValue",
+	),
+	"endpoint_security_endpoints_list": fieldColumns(
+		"agentGuid", "endpointName", "type", "osName", "lastUsedIp", "eppAgent.policyName", "eppAgent.status", "edrSensor.connectivity",
+	),
+	"cam_aws_accounts_list":                      camAccountColumns,
+	"cam_gcp_accounts_list":                      camAccountColumns,
+	"cam_alibaba_accounts_list":                  camAccountColumns,
+	"crem_attack_surface_devices_list":           deviceColumns,
+	"crem_attack_surface_local_app_devices_list": deviceColumns,
+	"threatintel_suspicious_objects_list":        suspiciousObjectColumns,
+	"threatintel_exceptions_list":                exceptionColumns,
+}
+
+// WithListFormat adds the format argument to list tools. Besides json the
+// items of the page can be rendered as a Markdown table, CSV or NDJSON, and
+// tools with a summarizer can return a summary of the page instead. Only the
+// text is rendered, the structured content is the page as is and matches the
+// output schema of the tool.
+func WithListFormat(tool mcpserver.ServerTool) mcpserver.ServerTool {
+	summarize, canSummarize := listSummarizers[tool.Tool.Name]
+	if !canSummarize && !isListTool(tool.Tool) {
+		return tool
+	}
+
+	formats := []string{formatJSON, formatMarkdown, formatCSV, formatNDJSON}
+	description := "The format of the result. json returns the page as is. " +
+		"markdown and csv render the items as a table and ndjson returns one item per line"
+	if canSummarize {
+		formats = append(formats, formatSummary)
+		description += fmt.Sprintf(". summary returns aggregates of the items in the page and the top %d records", summaryTopRecords)
+	}
+
+	mcp.WithString(formatArgument,
+		mcp.Description(description),
+		mcp.Enum(formats...),
+	)(&tool.Tool)
+
+	defaultColumns := listColumns[tool.Tool.Name]
+
+	handler := tool.Handler
+	tool.Handler = func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		format, err := optionalValue[string](formatArgument, request.GetArguments())
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		fields := []string{}
+		if err := optionalJSONValue(shapingFields, request.GetArguments(), &fields); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		result, err := handler(ctx, request)
+		if err != nil || result == nil || result.IsError || format == "" || format == formatJSON {
+			return result, err
+		}
+
+		if len(result.Content) == 0 {
+			return mcp.NewToolResultError(fmt.Sprintf("the result cannot be formatted as %s", format)), nil
+		}
+		text, ok := mcp.AsTextContent(result.Content[0])
+		if !ok {
+			return mcp.NewToolResultError(fmt.Sprintf("the result cannot be formatted as %s", format)), nil
+		}
+
+		page := listResponse[map[string]any]{}
+		if err := json.Unmarshal([]byte(text.Text), &page); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("the result cannot be formatted as %s: %s", format, err)), nil
+		}
+
+		columns := defaultColumns
+		if len(fields) > 0 {
+			columns = fieldColumns(fields...)
+		} else if columns == nil {
+			columns = fieldColumns(itemFields(page.Items)...)
+		}
+
+		var formatted string
+		switch format {
+		case formatSummary:
+			if !canSummarize {
+				return mcp.NewToolResultError(fmt.Sprintf("%s does not support the summary format", tool.Tool.Name)), nil
+			}
+			formatted, err = summarizeList(page, summarize)
+		case formatMarkdown:
+			formatted = renderMarkdown(page.Items, columns)
+		case formatCSV:
+			formatted, err = renderCSV(page.Items, columns)
+		case formatNDJSON:
+			formatted, err = renderNDJSON(page.Items, fields)
+		default:
+			return mcp.NewToolResultError(fmt.Sprintf("unsupported format: %s", format)), nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		result.Content[0] = mcp.NewTextContent(formatted)
+		if note := pagingNote(page); note != "" && format != formatSummary {
+			result.Content = append(result.Content, mcp.NewTextContent(note))
+		}
+		return result, nil
+	}
+
+	return tool
+}
+
+// isListTool reports whether the output schema of the tool is a list page.
+func isListTool(tool mcp.Tool) bool {
+	items, ok := tool.OutputSchema.Properties[listItemsKey].(map[string]any)
+	return ok && items["type"] == "array"
+}
+
+// pagingNote tells the model that the rendered page is not the whole list,
+// as the paging fields are not part of a table.
+func pagingNote(page listResponse[map[string]any]) string {
+	switch {
+	case page.NextLink != "" && page.TotalCount > 0:
+		return fmt.Sprintf("Showing %d of %d items. The next page is available at %s", len(page.Items), page.TotalCount, page.NextLink)
+	case page.NextLink != "":
+		return fmt.Sprintf("Showing %d items. The next page is available at %s", len(page.Items), page.NextLink)
+	case page.TotalCount > len(page.Items):
+		return fmt.Sprintf("Showing %d of %d items", len(page.Items), page.TotalCount)
+	}
+	return ""
+}
+
+// itemFields returns the top level fields of the items, id first and the
+// others sorted by name.
+func itemFields(items []map[string]any) []string {
+	names := map[string]bool{}
+	for _, item := range items {
+		for name := range item {
+			names[name] = true
+		}
+	}
+
+	fields := slices.Sorted(maps.Keys(names))
+	if i := slices.Index(fields, "id"); i > 0 {
+		fields = slices.Insert(slices.Delete(fields, i, i+1), 0, "id")
+	}
+	return fields
+}
+
+// lookupField returns the value of a nested field. Arrays on the way are
+// traversed and the values of each element are returned.
+func lookupField(value any, path string) any {
+	if path == "" {
+		return value
+	}
+
+	name, rest, _ := strings.Cut(path, ".")
+	switch v := value.(type) {
+	case map[string]any:
+		field, ok := v[name]
+		if !ok {
+			return nil
+		}
+		return lookupField(field, rest)
+	case []any:
+		values := []any{}
+		for _, element := range v {
+			switch found := lookupField(element, path).(type) {
+			case nil:
+			case []any:
+				values = append(values, found...)
+			default:
+				values = append(values, found)
+			}
+		}
+		return values
+	}
+	return nil
+}
+
+// cellText renders a value as the text of a table cell. Arrays of scalars
+// are joined, other nested values are rendered as compact JSON.
+func cellText(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	case []any:
+		values := make([]string, 0, len(v))
+		for _, element := range v {
+			switch element.(type) {
+			case map[string]any, []any:
+				b, _ := json.Marshal(v)
+				return string(b)
+			}
+			values = append(values, cellText(element))
+		}
+		return strings.Join(values, ", ")
+	}
+
+	b, _ := json.Marshal(value)
+	return string(b)
+}
+
+var markdownCellReplacer = strings.NewReplacer("|", `\|`, "\r\n", "<br>", "\n", "<br>")
+
+func renderMarkdown(items []map[string]any, columns []listColumn) string {
+	if len(items) == 0 {
+		return "No items"
+	}
+
+	var b strings.Builder
+	row := func(cells []string) {
+		b.WriteString("|")
+		for _, cell := range cells {
+			b.WriteString(" ")
+			b.WriteString(markdownCellReplacer.Replace(cell))
+			b.WriteString(" |")
+		}
+		b.WriteString("\n")
+	}
+
+	header := make([]string, 0, len(columns))
+	separator := make([]string, 0, len(columns))
+	for _, column := range columns {
+		header = append(header, column.name)
+		separator = append(separator, "---")
+	}
+	row(header)
+	row(separator)
+
+	for _, item := range items {
+		cells := make([]string, 0, len(columns))
+		for _, column := range columns {
+			cells = append(cells, cellText(column.value(item)))
+		}
+		row(cells)
+	}
+
+	return b.String()
+}
+
+func renderCSV(items []map[string]any, columns []listColumn) (string, error) {
+	var b bytes.Buffer
+	w := csv.NewWriter(&b)
+
+	header := make([]string, 0, len(columns))
+	for _, column := range columns {
+		header = append(header, column.name)
+	}
+	if err := w.Write(header); err != nil {
+		return "", err
+	}
+
+	for _, item := range items {
+		record := make([]string, 0, len(columns))
+		for _, column := range columns {
+			record = append(record, cellText(column.value(item)))
+		}
+		if err := w.Write(record); err != nil {
+			return "", err
+		}
+	}
+
+	w.Flush()
+	return b.String(), w.Error()
+}
+
+// renderNDJSON encodes the items one per line, only with the given fields
+// unless fields is empty.
+func renderNDJSON(items []map[string]any, fields []string) (string, error) {
+	var b bytes.Buffer
+	encoder := json.NewEncoder(&b)
+	encoder.SetEscapeHTML(false)
+	for _, item := range items {
+		var line any = item
+		if len(fields) > 0 {
+			line = projectFields(item, fields)
+		}
+		if err := encoder.Encode(line); err != nil {
+			return "", err
+		}
+	}
+	return b.String(), nil
+}
@@ -0,0 +1,102 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	"github.com/stretchr/testify/require"
+)
+
+const formatObjects = `{
+	"items": [
+		{"type": "url", "url": "https://example.com/a|b", "scanAction": "block", "riskLevel": "high", "description": "line 1\nline 2", "inExceptionList": false},
+		{"type": "ip", "ip": "198.51.100.1", "scanAction": "log", "riskLevel": "low", "inExceptionList": true}
+	],
+	"nextLink": "https://example.com/next"
+}`
+
+func formatTool(name, page string) mcpserver.ServerTool {
+	return WithListFormat(cannedTool(name, page, mcp.WithOutputSchema[listResponse[map[string]any]]()))
+}
+
+func TestWithListFormat(t *testing.T) {
+	tool := formatTool("threatintel_suspicious_objects_list", formatObjects)
+	require.Equal(t,
+		[]string{formatJSON, formatMarkdown, formatCSV, formatNDJSON},
+		tool.Tool.InputSchema.Properties[formatArgument].(map[string]any)["enum"],
+	)
+
+	t.Run("should render a markdown table with the default columns", func(t *testing.T) {
+		result := callTool(context.Background(), t, tool, map[string]any{"format": "markdown"})
+		require.False(t, result.IsError, "%v", result.Content)
+		require.Equal(t, ""+
+			"| type | value | scanAction | riskLevel | description | inExceptionList | expiredDateTime |\n"+
+			"| --- | --- | --- | --- | --- | --- | --- |\n"+
+			`| url | https://example.com/a\|b | block | high | line 1<br>line 2 | false |  |`+"\n"+
+			"| ip | 198.51.100.1 | log | low |  | true |  |\n",
+			result.Content[0].(mcp.TextContent).Text,
+		)
+		require.Equal(t, "Showing 2 items. The next page is available at https://example.com/next", result.Content[1].(mcp.TextContent).Text)
+		structured := map[string]any{}
+		require.NoError(t, json.Unmarshal([]byte(formatObjects), &structured))
+		require.Equal(t, structured, result.StructuredContent)
+	})
+
+	t.Run("should render csv with the requested fields", func(t *testing.T) {
+		result := callTool(context.Background(), t, tool, map[string]any{"format": "csv", "fields": []any{"type", "description"}})
+		require.False(t, result.IsError, "%v", result.Content)
+		require.Equal(t, "type,description\nurl,\"line 1\nline 2\"\nip,\n", result.Content[0].(mcp.TextContent).Text)
+	})
+
+	t.Run("should render one item per line as ndjson", func(t *testing.T) {
+		result := callTool(context.Background(), t, tool, map[string]any{"format": "ndjson"})
+		require.False(t, result.IsError, "%v", result.Content)
+		lines := strings.Split(strings.TrimSuffix(result.Content[0].(mcp.TextContent).Text, "\n"), "\n")
+		require.Len(t, lines, 2)
+		for _, line := range lines {
+			item := map[string]any{}
+			require.NoError(t, json.Unmarshal([]byte(line), &item))
+		}
+
+		result = callTool(context.Background(), t, tool, map[string]any{"format": "ndjson", "fields": []any{"type"}})
+		require.False(t, result.IsError, "%v", result.Content)
+		require.Equal(t, "{\"type\":\"url\"}\n{\"type\":\"ip\"}\n", result.Content[0].(mcp.TextContent).Text)
+	})
+
+	t.Run("should return json unchanged", func(t *testing.T) {
+		result := callTool(context.Background(), t, tool, map[string]any{"format": "json"})
+		require.False(t, result.IsError, "%v", result.Content)
+		require.Equal(t, formatObjects, result.Content[0].(mcp.TextContent).Text)
+		require.NotNil(t, result.StructuredContent)
+	})
+
+	t.Run("should show every field without default columns", func(t *testing.T) {
+		result := callTool(context.Background(), t, formatTool("custom_list", `{"items": [{"name": "a", "id": "1", "tags": ["x", "y"], "owner": {"name": "b"}}]}`), map[string]any{"format": "csv"})
+		require.False(t, result.IsError, "%v", result.Content)
+		require.Equal(t, "id,name,owner,tags\n1,a,\"{\"\"name\"\":\"\"b\"\"}\",\"x, y\"\n", result.Content[0].(mcp.TextContent).Text)
+		require.Len(t, result.Content, 1)
+	})
+
+	t.Run("should not add the format to tools that are not lists", func(t *testing.T) {
+		other := WithListFormat(mcpserver.ServerTool{Tool: mcp.NewTool("iam_accounts_get", mcp.WithOutputSchema[iamAccount]())})
+		require.NotContains(t, other.Tool.InputSchema.Properties, formatArgument)
+	})
+}
+
+func TestLookupField(t *testing.T) {
+	item := map[string]any{}
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"id": "WB-1",
+		"impactScope": {"entities": [{"entityValue": "pc-1"}, {"entityValue": {"name": "adam"}}, {"entityType": "host"}]}
+	}`), &item))
+
+	require.Equal(t, "WB-1", lookupField(item, "id"))
+	require.Nil(t, lookupField(item, "missing.field"))
+	require.Equal(t, []any{"pc-1", map[string]any{"name": "adam"}}, lookupField(item, "impactScope.entities.entityValue"))
+	require.Equal(t, `["pc-1",{"name":"adam"}]`, cellText(lookupField(item, "impactScope.entities.entityValue")))
+	require.Equal(t, "12.5", cellText(12.5))
+}
@@ -11,27 +11,19 @@ import (
 	mcpserver "github.com/mark3labs/mcp-go/server"
 )
 
-var ToolsetsReadOnlyIAM = []func(*v1client.V1ApiClient) mcpserver.ServerTool{
-	toolIamApiKeysList,
-	toolIamAccountsList,
-}
-
-var ToolsetsWriteIAM = []func(*v1client.V1ApiClient) mcpserver.ServerTool{
-	toolIamApiKeysDelete,
-	toolIamAccountInvite,
-	toolIamAccountUpdate,
-	toolIamAccountDelete,
-}
-
 func toolIamApiKeysList(client *v1client.V1ApiClient) mcpserver.ServerTool {
 	return mcpserver.ServerTool{
 		Tool: mcp.NewTool(
 			"iam_api_keys_list",
 			mcp.WithDescription("List Vision One API Keys"),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
-				ReadOnlyHint: toPtr(true),
+				Title:           "List API Keys",
+				ReadOnlyHint:    toPtr(true),
+				DestructiveHint: toPtr(false),
+				IdempotentHint:  toPtr(true),
+				OpenWorldHint:   toPtr(false),
 			}),
-			mcp.WithString("filter", mcp.Description(tooldescriptions.FilterApiKeys)),
+			mcp.WithString("filter", mcp.Description(tooldescriptions.FilterApiKeys.Description())),
 			mcp.WithString("orderBy",
 				mcp.Enum(
 					withOrdering(
@@ -51,6 +43,7 @@ func toolIamApiKeysList(client *v1client.V1ApiClient) mcpserver.ServerTool {
 			),
 			mcp.WithString("skipToken",
 				mcp.Description("The token use to paginate. Used to retrieve the next page of information.")),
+			mcp.WithOutputSchema[listResponse[apiKey]](),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			top, err := optionalStrInt("top", request.GetArguments())
@@ -91,7 +84,11 @@ func toolIamApiKeysDelete(client *v1client.V1ApiClient) mcpserver.ServerTool {
 			"iam_api_keys_delete",
 			mcp.WithDescription("Delete Vision One API Keys"),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
-				ReadOnlyHint: toPtr(false),
+				Title:           "Delete API Keys",
+				ReadOnlyHint:    toPtr(false),
+				DestructiveHint: toPtr(true),
+				IdempotentHint:  toPtr(true),
+				OpenWorldHint:   toPtr(false),
 			}),
 			mcp.WithArray("apiKeyIds",
 				mcp.Description("Array of API Key Ids to delete"),
@@ -102,6 +99,7 @@ func toolIamApiKeysDelete(client *v1client.V1ApiClient) mcpserver.ServerTool {
 					},
 				),
 			),
+			mcp.WithOutputSchema[multiStatusResult](),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			keysToDelete := []string{}
@@ -115,7 +113,7 @@ func toolIamApiKeysDelete(client *v1client.V1ApiClient) mcpserver.ServerTool {
 				}
 			}
 			resp, err := client.IAMDeleteAPIKeys(keysToDelete)
-			return handleStatusResponse(resp, err, http.StatusMultiStatus, "failed to delete api keys")
+			return handleMultiStatusResponse(resp, err, multiStatusInputs(keysToDelete), "failed to delete api keys")
 		},
 	}
 }
@@ -126,7 +124,11 @@ func toolIamAccountInvite(client *v1client.V1ApiClient) mcpserver.ServerTool {
 			"iam_account_invite",
 			mcp.WithDescription("Sends an invitation to the specified email address to be added as an account"),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
-				ReadOnlyHint: toPtr(false),
+				Title:           "Invite Account",
+				ReadOnlyHint:    toPtr(false),
+				DestructiveHint: toPtr(false),
+				IdempotentHint:  toPtr(false),
+				OpenWorldHint:   toPtr(false),
 			}),
 			mcp.WithString("email",
 				mcp.Required(),
@@ -185,14 +187,19 @@ func toolIamAccountsList(client *v1client.V1ApiClient) mcpserver.ServerTool {
 			"iam_accounts_list",
 			mcp.WithDescription("Displays users, groups, and invitations in the account"),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
-				ReadOnlyHint: toPtr(true),
+				Title:           "List Accounts",
+				ReadOnlyHint:    toPtr(true),
+				DestructiveHint: toPtr(false),
+				IdempotentHint:  toPtr(true),
+				OpenWorldHint:   toPtr(false),
 			}),
-			mcp.WithString("filter", mcp.Description(tooldescriptions.FilterUserAccounts)),
+			mcp.WithString("filter", mcp.Description(tooldescriptions.FilterUserAccounts.Description())),
 			mcp.WithString(
 				"top",
 				mcp.Description(tooldescriptions.DefaultTop),
 				mcp.Enum("50", "100", "200"),
 			),
+			mcp.WithOutputSchema[listResponse[iamAccount]](),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			top, err := optionalStrInt("top", request.GetArguments())
@@ -221,7 +228,11 @@ func toolIamAccountUpdate(client *v1client.V1ApiClient) mcpserver.ServerTool {
 			"iam_account_update",
 			mcp.WithDescription("Updates the specified account"),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
-				ReadOnlyHint: toPtr(false),
+				Title:           "Update Account",
+				ReadOnlyHint:    toPtr(false),
+				DestructiveHint: toPtr(true),
+				IdempotentHint:  toPtr(true),
+				OpenWorldHint:   toPtr(false),
 			}),
 			mcp.WithString("accountId",
 				mcp.Required(),
@@ -277,7 +288,11 @@ func toolIamAccountDelete(client *v1client.V1ApiClient) mcpserver.ServerTool {
 			"iam_account_delete",
 			mcp.WithDescription("Deletes the specified account"),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
-				ReadOnlyHint: toPtr(false),
+				Title:           "Delete Account",
+				ReadOnlyHint:    toPtr(false),
+				DestructiveHint: toPtr(true),
+				IdempotentHint:  toPtr(true),
+				OpenWorldHint:   toPtr(false),
 			}),
 			mcp.WithString("accountId",
 				mcp.Required(),
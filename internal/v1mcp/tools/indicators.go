@@ -0,0 +1,521 @@
+package tools
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/mail"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	"github.com/trendmicro/vision-one-mcp-server/internal/v1client"
+)
+
+// indicatorTypes are the object types supported by the suspicious object and exception lists.
+var indicatorTypes = []string{"url", "domain", "ip", "senderMailAddress", "fileSha1", "fileSha256"}
+
+var (
+	sha1Regexp   = regexp.MustCompile(`^[a-fA-F0-9]{40}$`)
+	sha256Regexp = regexp.MustCompile(`^[a-fA-F0-9]{64}$`)
+	domainRegexp = regexp.MustCompile(`^(\*\.)?([a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?\.)+[a-zA-Z][a-zA-Z0-9-]{0,62}$`)
+
+	// stixComparisonRegexp matches the comparison expressions of a STIX 2.1 pattern,
+	// e.g. [ipv4-addr:value = '198.51.100.1'] or [file:hashes.'SHA-256' = '...'].
+	stixComparisonRegexp = regexp.MustCompile(`([a-z0-9-]+):([A-Za-z0-9_.'-]+)\s*=\s*'((?:[^'\\]|\\.)*)'`)
+)
+
+// indicator is a single suspicious object or exception to be imported.
+type indicator struct {
+	Type             string `json:"type"`
+	Value            string `json:"value"`
+	Description      string `json:"description,omitempty"`
+	ScanAction       string `json:"scanAction,omitempty"`
+	RiskLevel        string `json:"riskLevel,omitempty"`
+	DaysToExpiration int    `json:"daysToExpiration,omitempty"`
+}
+
+// key identifies an indicator regardless of letter case where the case is not significant.
+func (i indicator) key() string {
+	if i.Type == "url" {
+		return i.Type + ":" + i.Value
+	}
+	return i.Type + ":" + strings.ToLower(i.Value)
+}
+
+func (i indicator) suspiciousObject() v1client.SuspiciousObject {
+	obj := v1client.SuspiciousObject{
+		Description:      i.Description,
+		ScanAction:       i.ScanAction,
+		RiskLevel:        i.RiskLevel,
+		DaysToExpiration: i.DaysToExpiration,
+	}
+
+	switch i.Type {
+	case "url":
+		obj.URL = i.Value
+	case "domain":
+		obj.Domain = i.Value
+	case "ip":
+		obj.IP = i.Value
+	case "senderMailAddress":
+		obj.SenderMailAddress = i.Value
+	case "fileSha1":
+		obj.FileSha1 = i.Value
+	case "fileSha256":
+		obj.FileSha256 = i.Value
+	}
+
+	return obj
+}
+
+func (i indicator) exception() v1client.SuspiciousObjectException {
+	obj := v1client.SuspiciousObjectException{
+		Description: i.Description,
+	}
+
+	switch i.Type {
+	case "url":
+		obj.URL = i.Value
+	case "domain":
+		obj.Domain = i.Value
+	case "ip":
+		obj.IP = i.Value
+	case "senderMailAddress":
+		obj.SenderMailAddress = i.Value
+	case "fileSha1":
+		obj.FileSha1 = i.Value
+	case "fileSha256":
+		obj.FileSha256 = i.Value
+	}
+
+	return obj
+}
+
+// refang reverses common defanging conventions used when sharing indicators,
+// e.g. hxxp://example[.]com.
+func refang(value string) string {
+	r := strings.NewReplacer(
+		"[.]", ".",
+		"(.)", ".",
+		"{.}", ".",
+		"[dot]", ".",
+		"[:]", ":",
+		"[@]", "@",
+		"[at]", "@",
+		"hxxp", "http",
+		"hXXp", "http",
+	)
+	return r.Replace(strings.TrimSpace(value))
+}
+
+// detectIndicatorType returns the suspicious object type of value.
+func detectIndicatorType(value string) (string, bool) {
+	switch {
+	case value == "":
+		return "", false
+	case net.ParseIP(value) != nil:
+		return "ip", true
+	case sha1Regexp.MatchString(value):
+		return "fileSha1", true
+	case sha256Regexp.MatchString(value):
+		return "fileSha256", true
+	case strings.Contains(value, "://"):
+		if u, err := url.Parse(value); err == nil && u.Host != "" {
+			return "url", true
+		}
+		return "", false
+	case strings.Contains(value, "@"):
+		if addr, err := mail.ParseAddress(value); err == nil && addr.Address == value {
+			return "senderMailAddress", true
+		}
+		return "", false
+	case domainRegexp.MatchString(value):
+		return "domain", true
+	case strings.Contains(value, "/"):
+		// URLs without a scheme, e.g. example.com/path
+		host, _, _ := strings.Cut(value, "/")
+		if domainRegexp.MatchString(host) || net.ParseIP(host) != nil {
+			return "url", true
+		}
+	}
+	return "", false
+}
+
+// invalidValueError is the error of a value that can't be imported. Its
+// message includes the value, reason describes the problem without it.
+type invalidValueError struct {
+	reason string
+	value  string
+}
+
+func (e *invalidValueError) Error() string {
+	return fmt.Sprintf("%s: %q", e.reason, e.value)
+}
+
+// positionError is an error at a position of an input, such as a line of
+// a file.
+type positionError struct {
+	position string
+	err      error
+}
+
+func (e *positionError) Error() string {
+	return fmt.Sprintf("%s: %s", e.position, e.err)
+}
+
+func (e *positionError) Unwrap() error {
+	return e.err
+}
+
+// withoutValue returns err without the value it is about, so that the
+// content of local files is not returned to the model. Only the position
+// and the reason are kept.
+func withoutValue(err error) error {
+	invalid := &invalidValueError{}
+	if !errors.As(err, &invalid) {
+		return err
+	}
+	if position := (&positionError{}); errors.As(err, &position) {
+		return fmt.Errorf("%s: %s", position.position, invalid.reason)
+	}
+	return errors.New(invalid.reason)
+}
+
+// newIndicator creates an indicator from a raw value. The type is detected
+// when indicatorType is empty.
+func newIndicator(indicatorType, value string) (indicator, error) {
+	value = refang(value)
+
+	if indicatorType == "" {
+		t, ok := detectIndicatorType(value)
+		if !ok {
+			return indicator{}, &invalidValueError{reason: "could not detect the indicator type", value: value}
+		}
+		indicatorType = t
+	} else if !isIndicatorType(indicatorType) {
+		return indicator{}, &invalidValueError{reason: fmt.Sprintf("unsupported indicator type %q", indicatorType), value: value}
+	}
+
+	if indicatorType == "fileSha1" || indicatorType == "fileSha256" {
+		value = strings.ToLower(value)
+	}
+
+	return indicator{Type: indicatorType, Value: value}, nil
+}
+
+func isIndicatorType(t string) bool {
+	for _, it := range indicatorTypes {
+		if it == t {
+			return true
+		}
+	}
+	return false
+}
+
+// parseIndicatorList parses a plain list of indicators separated by new lines.
+// Empty lines and lines starting with # are ignored.
+func parseIndicatorList(text string) []string {
+	values := []string{}
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		values = append(values, line)
+	}
+	return values
+}
+
+// parseIndicatorCSV parses indicators from CSV. When the first row is a header
+// containing a "value" column the type, description, scanAction, riskLevel and
+// daysToExpiration columns are read as well. Otherwise every cell is treated as
+// a value with an auto-detected type.
+func parseIndicatorCSV(r io.Reader) ([]indicator, []error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.Comment = '#'
+	reader.TrimLeadingSpace = true
+
+	// lines are the line numbers of the records.
+	records, lines := [][]string{}, []int{}
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, []error{fmt.Errorf("could not parse csv: %w", err)}
+		}
+		line, _ := reader.FieldPos(0)
+		records = append(records, record)
+		lines = append(lines, line)
+	}
+
+	if len(records) == 0 {
+		return nil, nil
+	}
+
+	columns := map[string]int{}
+	for i, name := range records[0] {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+
+	indicators := []indicator{}
+	errs := []error{}
+
+	valueColumn, hasHeader := columns["value"]
+	if !hasHeader {
+		for n, record := range records {
+			for _, cell := range record {
+				if strings.TrimSpace(cell) == "" {
+					continue
+				}
+				ind, err := newIndicator("", cell)
+				if err != nil {
+					errs = append(errs, &positionError{fmt.Sprintf("line %d", lines[n]), err})
+					continue
+				}
+				indicators = append(indicators, ind)
+			}
+		}
+		return indicators, errs
+	}
+
+	cell := func(record []string, name string) string {
+		i, ok := columns[strings.ToLower(name)]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	for n, record := range records[1:] {
+		if valueColumn >= len(record) || strings.TrimSpace(record[valueColumn]) == "" {
+			continue
+		}
+		position := fmt.Sprintf("line %d", lines[n+1])
+
+		ind, err := newIndicator(cell(record, "type"), record[valueColumn])
+		if err != nil {
+			errs = append(errs, &positionError{position, err})
+			continue
+		}
+
+		ind.Description = cell(record, "description")
+		ind.ScanAction = cell(record, "scanAction")
+		ind.RiskLevel = cell(record, "riskLevel")
+		if days := cell(record, "daysToExpiration"); days != "" {
+			ind.DaysToExpiration, err = strconv.Atoi(days)
+			if err != nil {
+				errs = append(errs, &positionError{position, &invalidValueError{reason: "daysToExpiration is not a number", value: days}})
+				continue
+			}
+		}
+
+		indicators = append(indicators, ind)
+	}
+
+	return indicators, errs
+}
+
+// parseSTIXBundle extracts indicators from the patterns of STIX 2.1 indicator
+// objects and from cyber observable objects in a bundle.
+func parseSTIXBundle(data []byte) ([]indicator, []error) {
+	bundle := struct {
+		Type    string           `json:"type"`
+		Objects []map[string]any `json:"objects"`
+	}{}
+
+	if err := json.Unmarshal(data, &bundle); err != nil {
+		return nil, []error{fmt.Errorf("could not parse stix bundle: %w", err)}
+	}
+
+	if bundle.Type != "bundle" {
+		return nil, []error{errors.New("stix bundle must have type 'bundle'")}
+	}
+
+	indicators := []indicator{}
+	errs := []error{}
+
+	add := func(i int, indicatorType, value, description string) {
+		ind, err := newIndicator(indicatorType, value)
+		if err != nil {
+			errs = append(errs, &positionError{fmt.Sprintf("objects[%d]", i), err})
+			return
+		}
+		ind.Description = description
+		indicators = append(indicators, ind)
+	}
+
+	for i, obj := range bundle.Objects {
+		objType, _ := obj["type"].(string)
+
+		switch objType {
+		case "indicator":
+			pattern, _ := obj["pattern"].(string)
+			name, _ := obj["name"].(string)
+			for _, m := range stixComparisonRegexp.FindAllStringSubmatch(pattern, -1) {
+				indicatorType, ok := stixIndicatorType(m[1], m[2])
+				if !ok {
+					continue
+				}
+				add(i, indicatorType, strings.ReplaceAll(m[3], `\'`, "'"), name)
+			}
+		case "ipv4-addr", "ipv6-addr", "domain-name", "url", "email-addr":
+			value, _ := obj["value"].(string)
+			indicatorType, _ := stixIndicatorType(objType, "value")
+			add(i, indicatorType, value, "")
+		case "file":
+			hashes, _ := obj["hashes"].(map[string]any)
+			for algorithm, hash := range hashes {
+				h, _ := hash.(string)
+				if indicatorType, ok := stixIndicatorType("file", "hashes."+algorithm); ok {
+					add(i, indicatorType, h, "")
+				}
+			}
+		}
+	}
+
+	return indicators, errs
+}
+
+// stixIndicatorType maps a STIX object path to a suspicious object type.
+func stixIndicatorType(objectType, property string) (string, bool) {
+	property = strings.ReplaceAll(property, "'", "")
+
+	switch objectType {
+	case "ipv4-addr", "ipv6-addr":
+		return "ip", property == "value"
+	case "domain-name":
+		return "domain", property == "value"
+	case "url":
+		return "url", property == "value"
+	case "email-addr":
+		return "senderMailAddress", property == "value"
+	case "email-message":
+		return "senderMailAddress", property == "from_ref.value" || property == "sender_ref.value"
+	case "file":
+		switch strings.ToUpper(strings.ReplaceAll(property, "-", "")) {
+		case "HASHES.SHA1":
+			return "fileSha1", true
+		case "HASHES.SHA256":
+			return "fileSha256", true
+		}
+	}
+	return "", false
+}
+
+// importDirKey is the context key of the import directory.
+type importDirKey struct{}
+
+// WithImportDir lets the bulk import tools read the files of their filePath
+// argument from dir. Without it filePath is rejected.
+func WithImportDir(tool mcpserver.ServerTool, dir string) mcpserver.ServerTool {
+	if _, ok := tool.Tool.InputSchema.Properties["filePath"]; !ok || dir == "" {
+		return tool
+	}
+
+	handler := tool.Handler
+	tool.Handler = func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handler(context.WithValue(ctx, importDirKey{}, dir), request)
+	}
+	return tool
+}
+
+// importDir returns the import directory of ctx, empty when there is none.
+func importDir(ctx context.Context) string {
+	dir, _ := ctx.Value(importDirKey{}).(string)
+	return dir
+}
+
+// readIndicatorFile reads indicators from a CSV file, STIX 2.1 bundle or
+// plain list in dir. The format is chosen by the file extension and falls
+// back to sniffing the content. Opening the file through os.Root rejects
+// names that resolve outside dir, for example through symbolic links.
+// Invalid values are reported by their position in the file, the content
+// of the file is never returned.
+func readIndicatorFile(dir, name string) ([]indicator, []error) {
+	if !filepath.IsLocal(name) {
+		return nil, []error{fmt.Errorf("invalid filePath %q: the file must be inside the import directory", name)}
+	}
+
+	root, err := os.OpenRoot(dir)
+	if err != nil {
+		return nil, []error{fmt.Errorf("could not open the import directory: %w", err)}
+	}
+	defer func() {
+		_ = root.Close()
+	}()
+
+	data, err := root.ReadFile(name)
+	if err != nil {
+		return nil, []error{fmt.Errorf("could not read %s: %w", name, err)}
+	}
+
+	indicators, errs := parseIndicatorFile(name, data)
+	for i, err := range errs {
+		errs[i] = withoutValue(err)
+	}
+	return indicators, errs
+}
+
+// parseIndicatorFile parses the content of the file name.
+func parseIndicatorFile(name string, data []byte) ([]indicator, []error) {
+	trimmed := bytes.TrimSpace(data)
+
+	switch strings.ToLower(filepath.Ext(name)) {
+	case ".csv":
+		return parseIndicatorCSV(bytes.NewReader(data))
+	case ".json", ".stix":
+		return parseSTIXBundle(data)
+	}
+
+	if bytes.HasPrefix(trimmed, []byte("{")) {
+		return parseSTIXBundle(data)
+	}
+
+	if bytes.Contains(trimmed, []byte(",")) {
+		return parseIndicatorCSV(bytes.NewReader(data))
+	}
+
+	indicators := []indicator{}
+	errs := []error{}
+	for n, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		ind, err := newIndicator("", line)
+		if err != nil {
+			errs = append(errs, &positionError{fmt.Sprintf("line %d", n+1), err})
+			continue
+		}
+		indicators = append(indicators, ind)
+	}
+	return indicators, errs
+}
+
+// dedupeIndicators removes repeated indicators, keeping the first occurrence.
+func dedupeIndicators(indicators []indicator) (unique []indicator, duplicates []indicator) {
+	seen := map[string]bool{}
+	for _, ind := range indicators {
+		if seen[ind.key()] {
+			duplicates = append(duplicates, ind)
+			continue
+		}
+		seen[ind.key()] = true
+		unique = append(unique, ind)
+	}
+	return unique, duplicates
+}
@@ -0,0 +1,185 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+	"github.com/trendmicro/vision-one-mcp-server/internal/v1client"
+)
+
+func TestDetectIndicatorType(t *testing.T) {
+	tests := map[string]string{
+		"198.51.100.1":                     "ip",
+		"2001:db8::1":                      "ip",
+		"example.com":                      "domain",
+		"*.example.com":                    "domain",
+		"https://example.com/malware.exe":  "url",
+		"example.com/path":                 "url",
+		"attacker@example.com":             "senderMailAddress",
+		strings.Repeat("a", 40):            "fileSha1",
+		strings.Repeat("A", 64):            "fileSha256",
+		"not an indicator":                 "",
+		"https://":                         "",
+		"Attacker <attacker@example.com>":  "",
+		strings.Repeat("a", 39):            "",
+		"localhost":                        "",
+		"hxxps://example[.]com/path":       "",
+		refang("hxxps://example[.]com/p"):  "url",
+		refang("example[.]com"):            "domain",
+		refang(" attacker[@]example.com "): "senderMailAddress",
+	}
+
+	for value, expected := range tests {
+		actual, ok := detectIndicatorType(value)
+		require.Equal(t, expected != "", ok, "value %q", value)
+		require.Equal(t, expected, actual, "value %q", value)
+	}
+}
+
+func TestParseIndicatorCSV(t *testing.T) {
+	t.Run("should read columns when there is a header", func(t *testing.T) {
+		csv := "type,value,riskLevel,daysToExpiration\n" +
+			"ip,198.51.100.1,high,30\n" +
+			",example.com,,\n" +
+			"ip,not-an-ip,,\n"
+
+		indicators, errs := parseIndicatorCSV(strings.NewReader(csv))
+		require.Len(t, errs, 0)
+		require.Equal(t, []indicator{
+			{Type: "ip", Value: "198.51.100.1", RiskLevel: "high", DaysToExpiration: 30},
+			{Type: "domain", Value: "example.com"},
+			{Type: "ip", Value: "not-an-ip"},
+		}, indicators)
+	})
+
+	t.Run("should detect every cell without a header", func(t *testing.T) {
+		indicators, errs := parseIndicatorCSV(strings.NewReader("198.51.100.1,example.com\n???\n"))
+		require.Len(t, errs, 1)
+		require.Len(t, indicators, 2)
+	})
+}
+
+func TestParseSTIXBundle(t *testing.T) {
+	bundle := `{
+		"type": "bundle",
+		"id": "bundle--1",
+		"objects": [
+			{
+				"type": "indicator",
+				"name": "Malicious infrastructure",
+				"pattern": "[ipv4-addr:value = '198.51.100.1'] OR [domain-name:value = 'example.com']",
+				"pattern_type": "stix"
+			},
+			{
+				"type": "indicator",
+				"pattern": "[file:hashes.'SHA-256' = '` + strings.Repeat("b", 64) + `']",
+				"pattern_type": "stix"
+			},
+			{"type": "url", "value": "https://example.com/payload"},
+			{"type": "malware", "name": "ignored"}
+		]
+	}`
+
+	indicators, errs := parseSTIXBundle([]byte(bundle))
+	require.Len(t, errs, 0)
+	require.Equal(t, []indicator{
+		{Type: "ip", Value: "198.51.100.1", Description: "Malicious infrastructure"},
+		{Type: "domain", Value: "example.com", Description: "Malicious infrastructure"},
+		{Type: "fileSha256", Value: strings.Repeat("b", 64)},
+		{Type: "url", Value: "https://example.com/payload"},
+	}, indicators)
+
+	_, errs = parseSTIXBundle([]byte(`{"type": "indicator"}`))
+	require.Len(t, errs, 1)
+}
+
+func TestReadIndicatorFile(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "list.txt"), []byte("# indicators\n198.51.100.1\nsecret-value\n"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "list.csv"), []byte("type,value\nip,198.51.100.1\nhost,secret-value\n"), 0o600))
+
+	t.Run("should report invalid values by line", func(t *testing.T) {
+		indicators, errs := readIndicatorFile(dir, "list.txt")
+		require.Equal(t, []indicator{{Type: "ip", Value: "198.51.100.1"}}, indicators)
+		require.Len(t, errs, 1)
+		require.EqualError(t, errs[0], "line 3: could not detect the indicator type")
+
+		_, errs = readIndicatorFile(dir, "list.csv")
+		require.Len(t, errs, 1)
+		require.EqualError(t, errs[0], `line 3: unsupported indicator type "host"`)
+	})
+
+	t.Run("should only read files in the directory", func(t *testing.T) {
+		for _, name := range []string{"../list.txt", "/etc/passwd", filepath.Join(dir, "list.txt")} {
+			_, errs := readIndicatorFile(dir, name)
+			require.Len(t, errs, 1)
+			require.ErrorContains(t, errs[0], "the file must be inside the import directory")
+		}
+
+		require.NoError(t, os.Symlink("/etc/passwd", filepath.Join(dir, "passwd")))
+		_, errs := readIndicatorFile(dir, "passwd")
+		require.Len(t, errs, 1)
+		require.ErrorContains(t, errs[0], "could not read passwd")
+	})
+
+	t.Run("should require an import directory", func(t *testing.T) {
+		_, _, err := collectIndicators(context.Background(), map[string]any{"filePath": "list.txt"})
+		require.EqualError(t, err, "filePath requires the server to be started with an import directory (-import-dir)")
+
+		tool := WithImportDir(toolThreatIntelExceptionsBulkAdd(nil), dir)
+		request := mcp.CallToolRequest{}
+		request.Params.Arguments = map[string]any{"filePath": "../list.txt"}
+		result, err := tool.Handler(context.Background(), request)
+		require.NoError(t, err)
+		require.Contains(t, result.Content[0].(mcp.TextContent).Text, "the file must be inside the import directory")
+	})
+}
+
+func TestBulkImport(t *testing.T) {
+	list := func(filter string, qp v1client.ThreatIntelQueryParameters) (*http.Response, error) {
+		require.Equal(t, "ip eq '198.51.100.1' or domain eq 'example.com' or domain eq 'example.org'", filter)
+		return multiStatusHTTPResponse(http.StatusOK, `{"items": [{"type": "domain", "domain": "Example.org"}]}`), nil
+	}
+
+	submitted := [][]indicator{}
+	submit := func(batch []indicator) (*http.Response, error) {
+		submitted = append(submitted, batch)
+		return multiStatusHTTPResponse(http.StatusMultiStatus, `[
+			{"status": 201},
+			{"status": 400, "body": {"error": {"code": "BadRequest", "message": "Invalid domain"}}}
+		]`), nil
+	}
+
+	result, err := bulkImport(
+		context.Background(),
+		map[string]any{
+			"values": []any{"198.51.100.1", "example.com", "example.org", "198.51.100.1", "???"},
+		},
+		list,
+		submit,
+		func(ind *indicator) { ind.RiskLevel = "low" },
+	)
+	require.NoError(t, err)
+	require.True(t, result.IsError)
+	require.Len(t, submitted, 1)
+	require.Equal(t, []indicator{
+		{Type: "ip", Value: "198.51.100.1", RiskLevel: "low"},
+		{Type: "domain", Value: "example.com", RiskLevel: "low"},
+	}, submitted[0])
+
+	summary := bulkImportResult{}
+	require.NoError(t, json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &summary))
+	require.Equal(t, 2, summary.Submitted)
+	require.Equal(t, 1, summary.Succeeded)
+	require.Equal(t, 1, summary.Failed)
+	require.Equal(t, 2, summary.Skipped)
+	require.Equal(t, 1, summary.Invalid)
+	require.Equal(t, "BadRequest", summary.Items[len(summary.Items)-1].Error.Code)
+}
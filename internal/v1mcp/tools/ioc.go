@@ -0,0 +1,482 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	"github.com/trendmicro/vision-one-mcp-server/internal/v1client"
+	"golang.org/x/net/publicsuffix"
+)
+
+// iocEnrichMaxIndicators limits the number of indicators enriched by a single call.
+const iocEnrichMaxIndicators = 50
+
+// iocEnrichConcurrency limits the number of concurrent Vision One requests made by a single call.
+const iocEnrichConcurrency = 8
+
+// iocEnrichFeedTop is the number of the latest feed objects searched. Older
+// objects of the feed are not searched, a miss doesn't mean the feed has no
+// record of an indicator.
+const iocEnrichFeedTop = 10000
+
+const (
+	sourceSuspiciousObjects      = "suspiciousObjects"
+	sourceExceptions             = "exceptions"
+	sourceThreatIntelFeed        = "threatIntelFeed"
+	sourceAttackSurfacePublicIPs = "attackSurfacePublicIPs"
+	sourceAttackSurfaceFQDNs     = "attackSurfaceGlobalFQDNs"
+)
+
+// iocSourceResult is what a single source knows about an indicator.
+type iocSourceResult struct {
+	Matches []map[string]any `json:"matches"`
+	Error   string           `json:"error,omitempty"`
+	// Note explains what was searched when the source only searches part
+	// of its data.
+	Note string `json:"note,omitempty"`
+}
+
+// iocVerdict is the merged enrichment document of an indicator.
+type iocVerdict struct {
+	Indicator string `json:"indicator"`
+	Type      string `json:"type,omitempty"`
+	// Verdict is excepted, malicious, suspicious or unknown.
+	Verdict string `json:"verdict"`
+	// OwnedAsset is true when the indicator is part of your own attack surface.
+	OwnedAsset bool                       `json:"ownedAsset"`
+	Summary    string                     `json:"summary"`
+	Sources    map[string]iocSourceResult `json:"sources,omitempty"`
+	Error      string                     `json:"error,omitempty"`
+}
+
+type iocEnrichResult struct {
+	Indicators []iocVerdict `json:"indicators"`
+}
+
+func toolIOCEnrich(client *v1client.V1ApiClient) mcpserver.ServerTool {
+	return mcpserver.ServerTool{
+		Tool: mcp.NewTool(
+			"ioc_enrich",
+			mcp.WithDescription(fmt.Sprintf(
+				"Enriches IP addresses, domains, URLs, file SHA-1/SHA-256 hashes and email addresses in a single call. "+
+					"Looks each indicator up in the Suspicious Object List, the Exception List, the latest %d objects of the Trend Threat Intelligence Feed and the attack surface public IPs and domains, "+
+					"and returns one merged verdict per indicator with the matches of each source. Accepts up to %d indicators", iocEnrichFeedTop, iocEnrichMaxIndicators)),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:           "Enrich Indicators of Compromise",
+				ReadOnlyHint:    toPtr(true),
+				DestructiveHint: toPtr(false),
+				IdempotentHint:  toPtr(true),
+				OpenWorldHint:   toPtr(true),
+			}),
+			mcp.WithArray("indicators",
+				mcp.Required(),
+				mcp.Description("The indicators to enrich. Types are detected automatically and defanged values are accepted"),
+				mcp.Items(map[string]any{"type": "string"}),
+			),
+			mcp.WithBoolean("includeFeed",
+				mcp.Description(fmt.Sprintf("Search the latest %d objects of the Trend Threat Intelligence Feed. They are downloaded once per call which can be slow. Default true", iocEnrichFeedTop)),
+			),
+			mcp.WithOutputSchema[iocEnrichResult](),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			values := []string{}
+			if err := optionalJSONValue("indicators", request.GetArguments(), &values); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			if len(values) == 0 {
+				return mcp.NewToolResultError("missing required parameter: indicators"), nil
+			}
+
+			if len(values) > iocEnrichMaxIndicators {
+				return mcp.NewToolResultError(fmt.Sprintf("at most %d indicators can be enriched at once", iocEnrichMaxIndicators)), nil
+			}
+
+			includeFeed, err := optionalPointerValue[bool]("includeFeed", request.GetArguments())
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			verdicts, err := enrichIndicators(ctx, client, values, includeFeed == nil || *includeFeed)
+			if err != nil {
+				return nil, err
+			}
+			result := iocEnrichResult{Indicators: verdicts}
+
+			b, err := json.Marshal(result)
+			if err != nil {
+				return nil, err
+			}
+
+			return mcp.NewToolResultStructured(result, string(b)), nil
+		},
+	}
+}
+
+// enrichIndicators looks values up in every source. No lookups are started
+// once ctx is done, and ctx.Err() is returned without waiting for the
+// lookups that are running.
+func enrichIndicators(ctx context.Context, client *v1client.V1ApiClient, values []string, includeFeed bool) ([]iocVerdict, error) {
+	verdicts := make([]iocVerdict, len(values))
+	indicators := make([]*indicator, len(values))
+
+	for i, value := range values {
+		verdicts[i] = iocVerdict{Indicator: value, Sources: map[string]iocSourceResult{}}
+
+		ind, err := newIndicator("", value)
+		if err != nil {
+			verdicts[i].Verdict = "unknown"
+			verdicts[i].Error = err.Error()
+			verdicts[i].Sources = nil
+			continue
+		}
+
+		verdicts[i].Indicator = ind.Value
+		verdicts[i].Type = ind.Type
+		indicators[i] = &ind
+	}
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, iocEnrichConcurrency)
+	// acquire waits for a free request slot and returns false once ctx is
+	// done. release frees the slot when acquire returned true.
+	acquire := func() bool {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			return false
+		}
+		if ctx.Err() != nil {
+			<-sem
+			return false
+		}
+		return true
+	}
+	release := func() { <-sem }
+
+	lookup := func(i int, source string, fn func() ([]map[string]any, error)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if !acquire() {
+				return
+			}
+			defer release()
+
+			result := iocSourceResult{Matches: []map[string]any{}}
+			matches, err := fn()
+			if err != nil {
+				result.Error = err.Error()
+			} else if matches != nil {
+				result.Matches = matches
+			}
+
+			mu.Lock()
+			verdicts[i].Sources[source] = result
+			mu.Unlock()
+		}()
+	}
+
+	for i, ind := range indicators {
+		if ind == nil {
+			continue
+		}
+
+		filter := fmt.Sprintf("%s eq '%s'", ind.Type, escapeFilterValue(ind.Value))
+		qp := v1client.ThreatIntelQueryParameters{Top: 50}
+
+		lookup(i, sourceSuspiciousObjects, func() ([]map[string]any, error) {
+			return listItems(client.ThreatIntelListSuspiciousObjects(filter, qp))
+		})
+
+		lookup(i, sourceExceptions, func() ([]map[string]any, error) {
+			return listItems(client.ThreatIntelListExceptions(filter, qp))
+		})
+
+		switch ind.Type {
+		case "ip":
+			lookup(i, sourceAttackSurfacePublicIPs, func() ([]map[string]any, error) {
+				return listItems(client.CREMListAttackSurfacePublicIPs(
+					fmt.Sprintf("ipAddress eq '%s'", escapeFilterValue(ind.Value)),
+					v1client.QueryParameters{Top: 10},
+				))
+			})
+		case "domain", "url":
+			host := indicatorHost(*ind)
+			if host == "" {
+				continue
+			}
+			lookup(i, sourceAttackSurfaceFQDNs, func() ([]map[string]any, error) {
+				items, err := listItems(client.CREMListAttackSurfaceGlobalFQDNs(
+					fmt.Sprintf("rootDomain eq '%s'", escapeFilterValue(rootDomain(host))),
+					v1client.QueryParameters{Top: 1000},
+				))
+				if err != nil {
+					return nil, err
+				}
+				return itemsMentioning(items, host), nil
+			})
+		}
+	}
+
+	if includeFeed {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if !acquire() {
+				return
+			}
+			defer release()
+
+			feed, err := feedIndicators(client)
+
+			mu.Lock()
+			defer mu.Unlock()
+			for i, ind := range indicators {
+				if ind == nil {
+					continue
+				}
+				result := iocSourceResult{Matches: []map[string]any{}}
+				if err != nil {
+					result.Error = err.Error()
+				} else if matches, ok := feed[ind.key()]; ok {
+					result.Matches = matches
+				} else {
+					result.Note = fmt.Sprintf("not found in the latest %d feed objects", iocEnrichFeedTop)
+				}
+				verdicts[i].Sources[sourceThreatIntelFeed] = result
+			}
+		}()
+	}
+
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	for i := range verdicts {
+		if indicators[i] != nil {
+			mergeVerdict(&verdicts[i])
+		}
+	}
+
+	return verdicts, nil
+}
+
+// mergeVerdict derives the verdict and summary of an indicator from its sources.
+// An exception always takes precedence as it is an explicit decision made in the tenant.
+func mergeVerdict(v *iocVerdict) {
+	found := func(source string) []map[string]any {
+		return v.Sources[source].Matches
+	}
+
+	v.OwnedAsset = len(found(sourceAttackSurfacePublicIPs)) > 0 || len(found(sourceAttackSurfaceFQDNs)) > 0
+
+	summary := []string{}
+	switch {
+	case len(found(sourceExceptions)) > 0:
+		v.Verdict = "excepted"
+		summary = append(summary, "in the Exception List")
+	case len(found(sourceSuspiciousObjects)) > 0:
+		v.Verdict = "suspicious"
+		for _, so := range found(sourceSuspiciousObjects) {
+			if so["scanAction"] == "block" || so["riskLevel"] == "high" {
+				v.Verdict = "malicious"
+			}
+		}
+		summary = append(summary, "in the Suspicious Object List")
+	case len(found(sourceThreatIntelFeed)) > 0:
+		v.Verdict = "malicious"
+	default:
+		v.Verdict = "unknown"
+	}
+
+	if n := len(found(sourceThreatIntelFeed)); n > 0 {
+		summary = append(summary, fmt.Sprintf("matched by %d Trend Threat Intelligence Feed indicators", n))
+	} else if feed, ok := v.Sources[sourceThreatIntelFeed]; ok && feed.Error == "" {
+		summary = append(summary, fmt.Sprintf("not found in the latest %d Trend Threat Intelligence Feed objects", iocEnrichFeedTop))
+	}
+
+	if v.OwnedAsset {
+		summary = append(summary, "part of your attack surface")
+	}
+
+	for _, source := range slices.Sorted(maps.Keys(v.Sources)) {
+		if v.Sources[source].Error != "" {
+			summary = append(summary, fmt.Sprintf("%s lookup failed", source))
+		}
+	}
+
+	if len(summary) == 0 {
+		v.Summary = "no matches"
+		return
+	}
+	v.Summary = strings.Join(summary, ", ")
+}
+
+// feedIndicators downloads the Trend Threat Intelligence Feed and indexes its
+// indicator objects by the indicators referenced in their patterns.
+func feedIndicators(client *v1client.V1ApiClient) (map[string][]map[string]any, error) {
+	resp, err := client.ThreatIntelListFeedIndicators(v1client.ThreatIntelFeedParameters{
+		Top:                   iocEnrichFeedTop,
+		IndicatorObjectFormat: "stixBundle",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to list feed indicators: %s", string(body))
+	}
+
+	bundle := struct {
+		Objects []map[string]any `json:"objects"`
+		Bundle  *struct {
+			Objects []map[string]any `json:"objects"`
+		} `json:"bundle"`
+	}{}
+	if err := json.Unmarshal(body, &bundle); err != nil {
+		return nil, fmt.Errorf("failed to parse feed indicators: %w", err)
+	}
+
+	objects := bundle.Objects
+	if bundle.Bundle != nil {
+		objects = append(objects, bundle.Bundle.Objects...)
+	}
+
+	index := map[string][]map[string]any{}
+	for _, obj := range objects {
+		if obj["type"] != "indicator" {
+			continue
+		}
+		pattern, _ := obj["pattern"].(string)
+		for _, m := range stixComparisonRegexp.FindAllStringSubmatch(pattern, -1) {
+			indicatorType, ok := stixIndicatorType(m[1], m[2])
+			if !ok {
+				continue
+			}
+			key := indicator{Type: indicatorType, Value: m[3]}.key()
+			index[key] = append(index[key], obj)
+		}
+	}
+
+	return index, nil
+}
+
+// listItems reads the items of a list response.
+func listItems(resp *http.Response, err error) ([]map[string]any, error) {
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %d: %s", resp.StatusCode, string(body))
+	}
+
+	page := struct {
+		Items []map[string]any `json:"items"`
+	}{}
+	if err := json.Unmarshal(body, &page); err != nil {
+		return nil, err
+	}
+
+	return page.Items, nil
+}
+
+// itemsMentioning returns the items with a string field, or a string in an
+// array field, equal to value.
+func itemsMentioning(items []map[string]any, value string) []map[string]any {
+	matches := []map[string]any{}
+	for _, item := range items {
+		for _, v := range item {
+			if mentions(v, value) {
+				matches = append(matches, item)
+				break
+			}
+		}
+	}
+	return matches
+}
+
+func mentions(v any, value string) bool {
+	switch t := v.(type) {
+	case string:
+		return strings.EqualFold(t, value)
+	case []any:
+		for _, e := range t {
+			if mentions(e, value) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// indicatorHost returns the host name of a domain or URL indicator.
+func indicatorHost(ind indicator) string {
+	if ind.Type == "domain" {
+		return strings.TrimPrefix(ind.Value, "*.")
+	}
+
+	value := ind.Value
+	if !strings.Contains(value, "://") {
+		value = "http://" + value
+	}
+
+	u, err := url.Parse(value)
+	if err != nil {
+		return ""
+	}
+	return u.Hostname()
+}
+
+// rootDomain returns the registrable domain of host, such as example.co.uk
+// for www.example.co.uk. Hosts without one, such as public suffixes, are
+// returned unchanged.
+func rootDomain(host string) string {
+	domain, err := publicsuffix.EffectiveTLDPlusOne(host)
+	if err != nil {
+		return host
+	}
+	return domain
+}
+
+// escapeFilterValue escapes single quotes in a quoted filter value.
+func escapeFilterValue(value string) string {
+	return strings.ReplaceAll(value, "'", "''")
+}
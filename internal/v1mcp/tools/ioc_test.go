@@ -0,0 +1,130 @@
+package tools
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/trendmicro/vision-one-mcp-server/internal/v1client"
+)
+
+func TestMergeVerdict(t *testing.T) {
+	t.Run("exceptions should take precedence", func(t *testing.T) {
+		v := iocVerdict{Sources: map[string]iocSourceResult{
+			sourceExceptions:        {Matches: []map[string]any{{"ip": "198.51.100.1"}}},
+			sourceSuspiciousObjects: {Matches: []map[string]any{{"ip": "198.51.100.1", "scanAction": "block"}}},
+		}}
+		mergeVerdict(&v)
+		require.Equal(t, "excepted", v.Verdict)
+		require.Equal(t, "in the Exception List", v.Summary)
+	})
+
+	t.Run("blocked suspicious objects should be malicious", func(t *testing.T) {
+		v := iocVerdict{Sources: map[string]iocSourceResult{
+			sourceSuspiciousObjects:      {Matches: []map[string]any{{"ip": "198.51.100.1", "scanAction": "block"}}},
+			sourceAttackSurfacePublicIPs: {Matches: []map[string]any{{"ipAddress": "198.51.100.1"}}},
+			sourceThreatIntelFeed:        {Error: "unexpected status 500"},
+		}}
+		mergeVerdict(&v)
+		require.Equal(t, "malicious", v.Verdict)
+		require.True(t, v.OwnedAsset)
+		require.Equal(t, "in the Suspicious Object List, part of your attack surface, threatIntelFeed lookup failed", v.Summary)
+	})
+
+	t.Run("feed matches should be malicious", func(t *testing.T) {
+		v := iocVerdict{Sources: map[string]iocSourceResult{
+			sourceSuspiciousObjects: {Matches: []map[string]any{}},
+			sourceThreatIntelFeed:   {Matches: []map[string]any{{"type": "indicator"}}},
+		}}
+		mergeVerdict(&v)
+		require.Equal(t, "malicious", v.Verdict)
+		require.Equal(t, "matched by 1 Trend Threat Intelligence Feed indicators", v.Summary)
+	})
+
+	t.Run("feed misses should say what was searched", func(t *testing.T) {
+		v := iocVerdict{Sources: map[string]iocSourceResult{
+			sourceSuspiciousObjects: {Matches: []map[string]any{}},
+			sourceThreatIntelFeed:   {Matches: []map[string]any{}, Note: "not found in the latest 10000 feed objects"},
+		}}
+		mergeVerdict(&v)
+		require.Equal(t, "unknown", v.Verdict)
+		require.Equal(t, "not found in the latest 10000 Trend Threat Intelligence Feed objects", v.Summary)
+	})
+
+	t.Run("no matches should be unknown", func(t *testing.T) {
+		v := iocVerdict{Sources: map[string]iocSourceResult{}}
+		mergeVerdict(&v)
+		require.Equal(t, "unknown", v.Verdict)
+		require.Equal(t, "no matches", v.Summary)
+	})
+}
+
+func TestItemsMentioning(t *testing.T) {
+	items := []map[string]any{
+		{"id": "1", "fqdn": "www.example.com"},
+		{"id": "2", "fqdns": []any{"mail.example.com", "WWW.EXAMPLE.COM"}},
+		{"id": "3", "fqdn": "api.example.com"},
+	}
+
+	matches := itemsMentioning(items, "www.example.com")
+	require.Len(t, matches, 2)
+	require.Equal(t, "1", matches[0]["id"])
+	require.Equal(t, "2", matches[1]["id"])
+}
+
+func TestIndicatorHost(t *testing.T) {
+	require.Equal(t, "example.com", indicatorHost(indicator{Type: "domain", Value: "*.example.com"}))
+	require.Equal(t, "www.example.com", indicatorHost(indicator{Type: "url", Value: "https://www.example.com:8443/path"}))
+	require.Equal(t, "www.example.com", indicatorHost(indicator{Type: "url", Value: "www.example.com/path"}))
+
+	require.Equal(t, "example.com", rootDomain("www.mail.example.com"))
+	require.Equal(t, "example.com", rootDomain("example.com"))
+	require.Equal(t, "example.co.uk", rootDomain("www.example.co.uk"))
+	require.Equal(t, "co.uk", rootDomain("co.uk"))
+}
+
+func TestListItems(t *testing.T) {
+	items, err := listItems(multiStatusHTTPResponse(http.StatusOK, `{"items": [{"ip": "198.51.100.1"}]}`), nil)
+	require.NoError(t, err)
+	require.Equal(t, []map[string]any{{"ip": "198.51.100.1"}}, items)
+
+	_, err = listItems(multiStatusHTTPResponse(http.StatusForbidden, `{"error": {}}`), nil)
+	require.Error(t, err)
+}
+
+func TestEnrichIndicatorsCancel(t *testing.T) {
+	var requests atomic.Int32
+	unblock := make(chan struct{})
+	defer close(unblock)
+	client, err := v1client.NewV1ApiClient(v1client.ClientOptions{
+		Region: "us",
+		Transport: roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+			requests.Add(1)
+			<-unblock
+			w := httptest.NewRecorder()
+			_, _ = w.WriteString(`{"items":[]}`)
+			return w.Result(), nil
+		}),
+	})
+	require.NoError(t, err)
+
+	t.Run("should not start lookups once cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, err := enrichIndicators(ctx, client, []string{"198.51.100.1"}, true)
+		require.ErrorIs(t, err, context.Canceled)
+		require.Zero(t, requests.Load())
+	})
+
+	t.Run("should return without waiting for running lookups", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		_, err := enrichIndicators(ctx, client, []string{"198.51.100.1", "example.com"}, true)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		require.LessOrEqual(t, requests.Load(), int32(iocEnrichConcurrency))
+	})
+}
@@ -0,0 +1,149 @@
+package tools
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// multiStatusResponse is a single sub-response of a 207 Multi-Status response.
+// Vision One returns one sub-response for each item in the request body, in
+// the same order.
+type multiStatusResponse struct {
+	Status  int                 `json:"status"`
+	Headers []multiStatusHeader `json:"headers,omitempty"`
+	Body    json.RawMessage     `json:"body,omitempty"`
+}
+
+type multiStatusHeader struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// multiStatusError is the error returned in the body of a failed sub-response.
+type multiStatusError struct {
+	Code    string `json:"code,omitempty"`
+	Message string `json:"message,omitempty"`
+	Number  int    `json:"number,omitempty"`
+}
+
+func (r multiStatusResponse) succeeded() bool {
+	return r.Status >= http.StatusOK && r.Status < http.StatusMultipleChoices
+}
+
+// error returns the error of a failed sub-response.
+// It returns nil for successful sub-responses.
+func (r multiStatusResponse) error() *multiStatusError {
+	if r.succeeded() {
+		return nil
+	}
+
+	body := struct {
+		Error *multiStatusError `json:"error"`
+	}{}
+	if err := json.Unmarshal(r.Body, &body); err != nil || body.Error == nil {
+		return &multiStatusError{Message: http.StatusText(r.Status)}
+	}
+
+	return body.Error
+}
+
+func parseMultiStatus(body []byte) ([]multiStatusResponse, error) {
+	responses := []multiStatusResponse{}
+	if err := json.Unmarshal(body, &responses); err != nil {
+		return nil, fmt.Errorf("could not parse multi-status response: %w", err)
+	}
+	return responses, nil
+}
+
+// multiStatusItemResult is the outcome of a single item of a request that
+// returned a 207 Multi-Status response.
+type multiStatusItemResult struct {
+	Input   any                 `json:"input"`
+	Status  int                 `json:"status,omitempty"`
+	Headers []multiStatusHeader `json:"headers,omitempty"`
+	Body    json.RawMessage     `json:"body,omitempty"`
+	Error   *multiStatusError   `json:"error,omitempty"`
+}
+
+type multiStatusResult struct {
+	Succeeded int                     `json:"succeeded"`
+	Failed    int                     `json:"failed"`
+	Items     []multiStatusItemResult `json:"items"`
+}
+
+// handleMultiStatusResponse maps each sub-response of a 207 Multi-Status
+// response to the request item at the same position. The tool result is
+// marked as an error when any item failed, so partial failures are not
+// mistaken for success.
+func handleMultiStatusResponse(r *http.Response, err error, inputs []any, msg string) (*mcp.CallToolResult, error) {
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		_ = r.Body.Close()
+	}()
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.StatusCode != http.StatusMultiStatus {
+		return mcp.NewToolResultError(fmt.Sprintf("%s: %s", msg, string(body))), nil
+	}
+
+	responses, err := parseMultiStatus(body)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("%s: %s", msg, err)), nil
+	}
+
+	result := multiStatusResult{Items: make([]multiStatusItemResult, 0, len(inputs))}
+	for i, input := range inputs {
+		item := multiStatusItemResult{Input: input}
+
+		if i >= len(responses) {
+			item.Error = &multiStatusError{Message: "no response for this item"}
+			result.Failed++
+			result.Items = append(result.Items, item)
+			continue
+		}
+
+		item.Status = responses[i].Status
+		item.Headers = responses[i].Headers
+		if responses[i].succeeded() {
+			item.Body = responses[i].Body
+			result.Succeeded++
+		} else {
+			item.Error = responses[i].error()
+			result.Failed++
+		}
+		result.Items = append(result.Items, item)
+	}
+
+	b, err := json.Marshal(result)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Failed > 0 {
+		toolResult := mcp.NewToolResultStructured(result, fmt.Sprintf("%s: %d of %d items failed\n%s", msg, result.Failed, len(inputs), string(b)))
+		toolResult.IsError = true
+		return toolResult, nil
+	}
+
+	return mcp.NewToolResultStructured(result, string(b)), nil
+}
+
+// multiStatusInputs converts request items to the inputs of handleMultiStatusResponse.
+func multiStatusInputs[T any](items []T) []any {
+	inputs := make([]any, 0, len(items))
+	for _, item := range items {
+		inputs = append(inputs, item)
+	}
+	return inputs
+}
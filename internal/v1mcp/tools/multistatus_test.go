@@ -0,0 +1,65 @@
+package tools
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+)
+
+func multiStatusHTTPResponse(status int, body string) *http.Response {
+	return &http.Response{StatusCode: status, Body: io.NopCloser(strings.NewReader(body))}
+}
+
+func TestHandleMultiStatusResponse(t *testing.T) {
+	t.Run("should map each sub-response to its input", func(t *testing.T) {
+		resp := multiStatusHTTPResponse(http.StatusMultiStatus, `[
+			{"status": 204},
+			{"status": 202, "headers": [{"name": "Operation-Location", "value": "https://api/tasks/1"}]}
+		]`)
+
+		result, err := handleMultiStatusResponse(resp, nil, []any{"a", "b"}, "failed")
+		require.NoError(t, err)
+		require.False(t, result.IsError)
+
+		parsed := multiStatusResult{}
+		require.NoError(t, json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &parsed))
+		require.Equal(t, 2, parsed.Succeeded)
+		require.Equal(t, "a", parsed.Items[0].Input)
+		require.Equal(t, "Operation-Location", parsed.Items[1].Headers[0].Name)
+	})
+
+	t.Run("should mark partial failures as errors", func(t *testing.T) {
+		resp := multiStatusHTTPResponse(http.StatusMultiStatus, `[
+			{"status": 201},
+			{"status": 400, "body": {"error": {"code": "BadRequest", "message": "Invalid IP"}}},
+			{"status": 500}
+		]`)
+
+		result, err := handleMultiStatusResponse(resp, nil, []any{"a", "b", "c", "d"}, "failed to add")
+		require.NoError(t, err)
+		require.True(t, result.IsError)
+
+		text := result.Content[0].(mcp.TextContent).Text
+		require.True(t, strings.HasPrefix(text, "failed to add: 3 of 4 items failed\n"))
+
+		parsed := multiStatusResult{}
+		require.NoError(t, json.Unmarshal([]byte(strings.SplitN(text, "\n", 2)[1]), &parsed))
+		require.Equal(t, 1, parsed.Succeeded)
+		require.Equal(t, 3, parsed.Failed)
+		require.Equal(t, "BadRequest", parsed.Items[1].Error.Code)
+		require.Equal(t, "Internal Server Error", parsed.Items[2].Error.Message)
+		require.Equal(t, "no response for this item", parsed.Items[3].Error.Message)
+	})
+
+	t.Run("should return an error for other status codes", func(t *testing.T) {
+		resp := multiStatusHTTPResponse(http.StatusBadRequest, `{"error": {}}`)
+		result, err := handleMultiStatusResponse(resp, nil, []any{"a"}, "failed")
+		require.NoError(t, err)
+		require.True(t, result.IsError)
+	})
+}
@@ -0,0 +1,233 @@
+package tools
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"maps"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// toolParameterOptions are the options that declare the parameters of a
+// tool.
+var toolParameterOptions = []string{"WithString", "WithNumber", "WithBoolean", "WithArray", "WithObject"}
+
+// TestToolsReadTheirParameters checks that every parameter a tool declares
+// is read by its handler. The parameters of a tool are the names passed to
+// toolParameterOptions in the function that creates or wraps it and in the
+// functions it uses, as string literals or string constants. A parameter is
+// read when its name is passed to an argument helper such as requiredValue
+// or optionalTimeValue, or used as the key of the arguments of the request.
+func TestToolsReadTheirParameters(t *testing.T) {
+	names, err := filepath.Glob("*.go")
+	require.NoError(t, err)
+
+	// declarations are the functions, variables and constants of the
+	// package by name, constants the values of its string constants.
+	declarations := map[string]ast.Node{}
+	constants := map[string]string{}
+	fset := token.NewFileSet()
+	for _, name := range names {
+		if strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+		file, err := parser.ParseFile(fset, name, nil, 0)
+		require.NoError(t, err)
+
+		for _, decl := range file.Decls {
+			switch d := decl.(type) {
+			case *ast.FuncDecl:
+				if d.Recv == nil {
+					declarations[d.Name.Name] = d
+				}
+			case *ast.GenDecl:
+				for _, spec := range d.Specs {
+					v, ok := spec.(*ast.ValueSpec)
+					if !ok {
+						continue
+					}
+					for i, name := range v.Names {
+						declarations[name.Name] = v
+						if d.Tok == token.CONST && i < len(v.Values) {
+							if value, ok := stringLiteral(v.Values[i]); ok {
+								constants[name.Name] = value
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+
+	checked := 0
+	for _, name := range slices.Sorted(maps.Keys(declarations)) {
+		fn, ok := declarations[name].(*ast.FuncDecl)
+		if !ok || !returnsTool(fn) {
+			continue
+		}
+		checked++
+
+		declared := map[string]bool{}
+		read := map[string]bool{}
+		for _, node := range reachableDeclarations(fn, declarations) {
+			ast.Inspect(node, func(n ast.Node) bool {
+				if call, ok := n.(*ast.CallExpr); ok && isParameterOption(call) && len(call.Args) > 0 {
+					if parameter, ok := stringValue(call.Args[0], constants); ok {
+						declared[parameter] = true
+					}
+					// The options of a parameter describe it, they don't read it.
+					return false
+				}
+				if parameter, ok := readParameter(n, constants); ok {
+					read[parameter] = true
+				}
+				return true
+			})
+		}
+
+		for _, parameter := range slices.Sorted(maps.Keys(declared)) {
+			require.True(t, read[parameter], "%s declares the parameter %q but never reads it", name, parameter)
+		}
+	}
+	require.NotZero(t, checked)
+}
+
+// returnsTool returns whether fn returns a mcpserver.ServerTool: whether it
+// creates a tool or wraps one, such as WithTimeRange.
+func returnsTool(fn *ast.FuncDecl) bool {
+	if fn.Type.Results == nil {
+		return false
+	}
+	for _, result := range fn.Type.Results.List {
+		selector, ok := result.Type.(*ast.SelectorExpr)
+		if !ok {
+			continue
+		}
+		pkg, ok := selector.X.(*ast.Ident)
+		if ok && pkg.Name == "mcpserver" && selector.Sel.Name == "ServerTool" {
+			return true
+		}
+	}
+	return false
+}
+
+// reachableDeclarations returns fn and the declarations of the package it
+// refers to, directly or through other declarations.
+func reachableDeclarations(fn *ast.FuncDecl, declarations map[string]ast.Node) []ast.Node {
+	seen := map[ast.Node]bool{fn: true}
+	reachable := []ast.Node{fn}
+	for i := 0; i < len(reachable); i++ {
+		ast.Inspect(reachable[i], func(n ast.Node) bool {
+			ident, ok := n.(*ast.Ident)
+			if !ok {
+				return true
+			}
+			if decl, ok := declarations[ident.Name]; ok && !seen[decl] {
+				seen[decl] = true
+				reachable = append(reachable, decl)
+			}
+			return true
+		})
+	}
+	return reachable
+}
+
+// argumentHelperPrefixes are the prefixes of the functions of the package
+// that read an argument named by their first parameter.
+var argumentHelperPrefixes = []string{"required", "optional"}
+
+// argumentMaps are the names of the variables holding the arguments of a
+// request.
+var argumentMaps = []string{"args", "arguments"}
+
+// readParameter returns the name of the parameter n reads: the first
+// argument of a call to an argument helper, or the key of an index into the
+// arguments of the request.
+func readParameter(n ast.Node, constants map[string]string) (string, bool) {
+	switch n := n.(type) {
+	case *ast.CallExpr:
+		if len(n.Args) > 0 && isArgumentHelper(n.Fun) {
+			return stringValue(n.Args[0], constants)
+		}
+	case *ast.IndexExpr:
+		if isArgumentMap(n.X) {
+			return stringValue(n.Index, constants)
+		}
+	}
+	return "", false
+}
+
+// isArgumentHelper returns whether fun names an argument helper, including
+// instantiations of the generic ones such as optionalValue[string].
+func isArgumentHelper(fun ast.Expr) bool {
+	switch f := fun.(type) {
+	case *ast.IndexExpr:
+		fun = f.X
+	case *ast.IndexListExpr:
+		fun = f.X
+	}
+	ident, ok := fun.(*ast.Ident)
+	if !ok {
+		return false
+	}
+	for _, prefix := range argumentHelperPrefixes {
+		if strings.HasPrefix(ident.Name, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// isArgumentMap returns whether x is request.GetArguments() or a variable
+// holding the arguments of a request.
+func isArgumentMap(x ast.Expr) bool {
+	if call, ok := x.(*ast.CallExpr); ok {
+		selector, ok := call.Fun.(*ast.SelectorExpr)
+		return ok && selector.Sel.Name == "GetArguments"
+	}
+	ident, ok := x.(*ast.Ident)
+	return ok && slices.Contains(argumentMaps, ident.Name)
+}
+
+func isParameterOption(call *ast.CallExpr) bool {
+	for _, option := range toolParameterOptions {
+		if isMCPCall(call, option) {
+			return true
+		}
+	}
+	return false
+}
+
+func isMCPCall(call *ast.CallExpr, name string) bool {
+	selector, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return false
+	}
+	pkg, ok := selector.X.(*ast.Ident)
+	return ok && pkg.Name == "mcp" && selector.Sel.Name == name
+}
+
+// stringValue returns the value of a string literal or of an identifier
+// naming a string constant.
+func stringValue(n ast.Node, constants map[string]string) (string, bool) {
+	if ident, ok := n.(*ast.Ident); ok {
+		value, ok := constants[ident.Name]
+		return value, ok
+	}
+	return stringLiteral(n)
+}
+
+func stringLiteral(n ast.Node) (string, bool) {
+	lit, ok := n.(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return "", false
+	}
+	s, err := strconv.Unquote(lit.Value)
+	return s, err == nil
+}
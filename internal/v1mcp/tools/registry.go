@@ -0,0 +1,568 @@
+package tools
+
+import (
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	"github.com/trendmicro/vision-one-mcp-server/internal/v1client"
+)
+
+// Mode is whether a tool only reads from Vision One or changes it.
+type Mode string
+
+const (
+	ModeRead  Mode = "read"
+	ModeWrite Mode = "write"
+)
+
+// Toolsets group the tools of a Vision One app.
+const (
+	ToolsetAISecurity          = "aisecurity"
+	ToolsetCAM                 = "cam"
+	ToolsetCloudPosture        = "cloud_posture"
+	ToolsetCloudRiskManagement = "cloud_risk_management"
+	ToolsetContainer           = "container"
+	ToolsetCREM                = "crem"
+	ToolsetEmail               = "email"
+	ToolsetEndpoint            = "endpoint"
+	ToolsetIAM                 = "iam"
+	ToolsetIOC                 = "ioc"
+	ToolsetThreatIntel         = "threatintel"
+	ToolsetWorkbench           = "workbench"
+)
+
+// Registration describes a tool of the server.
+type Registration struct {
+	// New creates the tool. It is called with a nil client to read the
+	// definition of the tool.
+	New func(*v1client.V1ApiClient) mcpserver.ServerTool
+	// Toolset is the group of the tool, one of the Toolset constants.
+	Toolset string
+	// Permissions are the permissions the role of the API key needs for
+	// the tool, as shown in the User Roles settings of Vision One.
+	Permissions []string
+	// APIPaths are the Vision One API endpoints the tool calls.
+	APIPaths []string
+	// Beta is set when the endpoints are beta APIs, which may change
+	// without notice.
+	Beta bool
+}
+
+// Mode returns ModeRead when the tool is annotated as read only, and
+// ModeWrite otherwise.
+func (r Registration) Mode() Mode {
+	if hint := r.New(nil).Tool.Annotations.ReadOnlyHint; hint != nil && *hint {
+		return ModeRead
+	}
+	return ModeWrite
+}
+
+// Registry lists every tool the server can register, by toolset. The
+// export_to_file tool is not listed, it is created from the other tools.
+var Registry = []Registration{
+	{
+		New:         toolAISecurityApplyGuardrails,
+		Toolset:     ToolsetAISecurity,
+		Permissions: []string{"AI Guard: Call detection API"},
+		APIPaths:    []string{"POST /v3.0/aiSecurity/applyGuardrails"},
+	},
+
+	{
+		New:         toolCAMAwsAccountsList,
+		Toolset:     ToolsetCAM,
+		Permissions: []string{"Cloud Accounts: View"},
+		APIPaths:    []string{"GET /v3.0/cam/awsAccounts"},
+	},
+	{
+		New:         toolCAMAwsAccountGet,
+		Toolset:     ToolsetCAM,
+		Permissions: []string{"Cloud Accounts: View"},
+		APIPaths:    []string{"GET /v3.0/cam/awsAccounts/{accountId}"},
+	},
+	{
+		New:         toolCAMGcpAccountsList,
+		Toolset:     ToolsetCAM,
+		Permissions: []string{"Cloud Accounts: View"},
+		APIPaths:    []string{"GET /v3.0/cam/gcpProjects"},
+	},
+	{
+		New:         toolCAMGcpAccountGet,
+		Toolset:     ToolsetCAM,
+		Permissions: []string{"Cloud Accounts: View"},
+		APIPaths:    []string{"GET /v3.0/cam/gcpProjects/{projectId}"},
+	},
+	{
+		New:         toolCAMAlibabaAccountsList,
+		Toolset:     ToolsetCAM,
+		Permissions: []string{"Cloud Accounts: View"},
+		APIPaths:    []string{"GET /v3.0/cam/alibabaAccounts"},
+	},
+	{
+		New:         toolCAMAlibabaAccountGet,
+		Toolset:     ToolsetCAM,
+		Permissions: []string{"Cloud Accounts: View"},
+		APIPaths:    []string{"GET /v3.0/cam/alibabaAccounts/{accountId}"},
+	},
+
+	{
+		New:         toolCloudPostureAccountsList,
+		Toolset:     ToolsetCloudPosture,
+		Permissions: []string{"Cloud Posture: View"},
+		APIPaths:    []string{"GET /beta/cloudPosture/accounts"},
+		Beta:        true,
+	},
+	{
+		New:         toolCloudPostureAccountChecksList,
+		Toolset:     ToolsetCloudPosture,
+		Permissions: []string{"Cloud Posture: View"},
+		APIPaths:    []string{"GET /beta/cloudPosture/checks"},
+		Beta:        true,
+	},
+	{
+		New:         toolCloudPostureTemplateScannerRun,
+		Toolset:     ToolsetCloudPosture,
+		Permissions: []string{"Cloud Posture: View"},
+		APIPaths:    []string{"POST /beta/cloudPosture/scanTemplate"},
+		Beta:        true,
+	},
+	{
+		New:         toolCloudPostureAccountScanSettingsGet,
+		Toolset:     ToolsetCloudPosture,
+		Permissions: []string{"Cloud Posture: View"},
+		APIPaths:    []string{"GET /beta/cloudPosture/accounts/{accountId}/scanSetting"},
+		Beta:        true,
+	},
+	{
+		New:         toolCloudPostureAccountScan,
+		Toolset:     ToolsetCloudPosture,
+		Permissions: []string{"Cloud Posture: Manage"},
+		APIPaths:    []string{"POST /beta/cloudPosture/accounts/{accountId}/scan"},
+		Beta:        true,
+	},
+	{
+		New:         toolCloudPostureAccountScanSettingsUpdate,
+		Toolset:     ToolsetCloudPosture,
+		Permissions: []string{"Cloud Posture: Manage"},
+		APIPaths:    []string{"PATCH /beta/cloudPosture/accounts/{accountId}/scanSetting"},
+		Beta:        true,
+	},
+	{
+		New:         toolCloudPostureCustomRulesList,
+		Toolset:     ToolsetCloudPosture,
+		Permissions: []string{"Cloud Posture: View"},
+		APIPaths:    []string{"GET /beta/cloudPosture/customRules"},
+		Beta:        true,
+	},
+	{
+		New:         toolCloudPostureCustomRuleGet,
+		Toolset:     ToolsetCloudPosture,
+		Permissions: []string{"Cloud Posture: View"},
+		APIPaths:    []string{"GET /beta/cloudPosture/customRules/{ruleId}"},
+		Beta:        true,
+	},
+	{
+		New:         toolCloudPostureCustomRuleTest,
+		Toolset:     ToolsetCloudPosture,
+		Permissions: []string{"Master Administrator role"},
+		APIPaths:    []string{"POST /beta/cloudPosture/customRules/test"},
+		Beta:        true,
+	},
+	{
+		New:         toolCloudPostureCustomRuleCreate,
+		Toolset:     ToolsetCloudPosture,
+		Permissions: []string{"Master Administrator role"},
+		APIPaths:    []string{"POST /beta/cloudPosture/customRules"},
+		Beta:        true,
+	},
+	{
+		New:         toolCloudPostureCustomRuleUpdate,
+		Toolset:     ToolsetCloudPosture,
+		Permissions: []string{"Master Administrator role"},
+		APIPaths:    []string{"PATCH /beta/cloudPosture/customRules/{ruleId}"},
+		Beta:        true,
+	},
+	{
+		New:         toolCloudPostureCustomRuleDelete,
+		Toolset:     ToolsetCloudPosture,
+		Permissions: []string{"Master Administrator role"},
+		APIPaths:    []string{"DELETE /beta/cloudPosture/customRules/{ruleId}"},
+		Beta:        true,
+	},
+
+	{
+		New:         toolCloudRiskManagementAccountsList,
+		Toolset:     ToolsetCloudRiskManagement,
+		Permissions: []string{"Cloud Risk Management: View"},
+		APIPaths:    []string{"GET /v3.0/cloudRiskManagement/accounts"},
+	},
+	{
+		New:         toolCloudRiskManagementAccountScanRulesGet,
+		Toolset:     ToolsetCloudRiskManagement,
+		Permissions: []string{"Cloud Risk Management: View"},
+		APIPaths:    []string{"GET /v3.0/cloudRiskManagement/accounts/{accountId}/scanRules"},
+	},
+	{
+		New:         toolCloudRiskManagementServicesList,
+		Toolset:     ToolsetCloudRiskManagement,
+		Permissions: []string{"Cloud Risk Management: View"},
+		APIPaths:    []string{"GET /v3.0/cloudRiskManagement/services"},
+	},
+
+	{
+		New:         toolContainerSecurityImageVulnerabilitiesList,
+		Toolset:     ToolsetContainer,
+		Permissions: []string{"Container Inventory: View"},
+		APIPaths:    []string{"GET /v3.0/containerSecurity/vulnerabilities"},
+	},
+	{
+		New:         toolContainerSecurityK8ClustersList,
+		Toolset:     ToolsetContainer,
+		Permissions: []string{"Container Inventory: View"},
+		APIPaths:    []string{"GET /v3.0/containerSecurity/kubernetesClusters"},
+	},
+	{
+		New:         toolContainerSecurityK8ClusterGet,
+		Toolset:     ToolsetContainer,
+		Permissions: []string{"Container Inventory: View"},
+		APIPaths:    []string{"GET /v3.0/containerSecurity/kubernetesClusters/{clusterId}"},
+	},
+	{
+		New:         toolContainerSecurityECSClustersList,
+		Toolset:     ToolsetContainer,
+		Permissions: []string{"Container Inventory: View"},
+		APIPaths:    []string{"GET /v3.0/containerSecurity/amazonEcsClusters"},
+	},
+	{
+		New:         toolContainerSecurityK8ImagesList,
+		Toolset:     ToolsetContainer,
+		Permissions: []string{"Container Inventory: View"},
+		APIPaths:    []string{"GET /v3.0/containerSecurity/kubernetesImages"},
+	},
+
+	{
+		New:         toolCREMAttackSurfaceDevicesList,
+		Toolset:     ToolsetCREM,
+		Permissions: []string{"Attack Surface Discovery: View"},
+		APIPaths:    []string{"GET /v3.0/asrm/attackSurfaceDevices"},
+	},
+	{
+		New:         toolCREMAttackSurfaceDomainAccountsList,
+		Toolset:     ToolsetCREM,
+		Permissions: []string{"Attack Surface Discovery: View"},
+		APIPaths:    []string{"GET /v3.0/asrm/attackSurfaceDomainAccounts"},
+	},
+	{
+		New:         toolCREMAttackSurfaceServiceAccountsList,
+		Toolset:     ToolsetCREM,
+		Permissions: []string{"Attack Surface Discovery: View"},
+		APIPaths:    []string{"GET /v3.0/asrm/attackSurfaceServiceAccounts"},
+	},
+	{
+		New:         toolCREMAttackSurfaceGlobalFQDNsList,
+		Toolset:     ToolsetCREM,
+		Permissions: []string{"Attack Surface Discovery: View"},
+		APIPaths:    []string{"GET /v3.0/asrm/attackSurfaceGlobalFqdns"},
+	},
+	{
+		New:         toolCREMAttackSurfacePublicIPsList,
+		Toolset:     ToolsetCREM,
+		Permissions: []string{"Attack Surface Discovery: View"},
+		APIPaths:    []string{"GET /v3.0/asrm/attackSurfacePublicIpAddresses"},
+	},
+	{
+		New:         toolCREMAttackSurfaceCloudAssetsList,
+		Toolset:     ToolsetCREM,
+		Permissions: []string{"Attack Surface Discovery: View"},
+		APIPaths:    []string{"GET /v3.0/asrm/attackSurfaceCloudAssets"},
+	},
+	{
+		New:         toolCREMAttackSurfaceHighRiskUsersList,
+		Toolset:     ToolsetCREM,
+		Permissions: []string{"Attack Surface Discovery: View"},
+		APIPaths:    []string{"GET /v3.0/asrm/highRiskUsers"},
+	},
+	{
+		New:         toolCREMAttackSurfaceCloudAssetProfileGet,
+		Toolset:     ToolsetCREM,
+		Permissions: []string{"Attack Surface Discovery: View"},
+		APIPaths:    []string{"GET /v3.0/asrm/attackSurfaceCloudAssets/{id}"},
+	},
+	{
+		New:         toolCREMAttackSurfaceCloudAssetRiskIndicatorsList,
+		Toolset:     ToolsetCREM,
+		Permissions: []string{"Attack Surface Discovery: View"},
+		APIPaths:    []string{"GET /v3.0/asrm/attackSurfaceCloudAssets/{id}/riskIndicatorEvents"},
+	},
+	{
+		New:         toolCREMAttackSurfaceLocalAppsList,
+		Toolset:     ToolsetCREM,
+		Permissions: []string{"Attack Surface Discovery: View"},
+		APIPaths:    []string{"GET /v3.0/asrm/attackSurfaceLocalApps"},
+	},
+	{
+		New:         toolCREMAttackSurfaceLocalAppProfileGet,
+		Toolset:     ToolsetCREM,
+		Permissions: []string{"Attack Surface Discovery: View"},
+		APIPaths:    []string{"GET /v3.0/asrm/attackSurfaceLocalApps/{id}"},
+	},
+	{
+		New:         toolCREMAttackSurfaceLocalAppRiskIndicatorsList,
+		Toolset:     ToolsetCREM,
+		Permissions: []string{"Attack Surface Discovery: View"},
+		APIPaths:    []string{"GET /v3.0/asrm/attackSurfaceLocalApps/{id}/riskIndicatorEvents"},
+	},
+	{
+		New:         toolCREMAttackSurfaceLocalAppDevicesList,
+		Toolset:     ToolsetCREM,
+		Permissions: []string{"Attack Surface Discovery: View"},
+		APIPaths:    []string{"GET /v3.0/asrm/attackSurfaceLocalApps/{id}/devices"},
+	},
+	{
+		New:         toolCREMAttackSurfaceLocalAppExecutableFilesList,
+		Toolset:     ToolsetCREM,
+		Permissions: []string{"Attack Surface Discovery: View"},
+		APIPaths:    []string{"GET /v3.0/asrm/attackSurfaceLocalApps/{id}/executableFiles"},
+	},
+	{
+		New:         toolCREMAttackSurfaceCustomTagsList,
+		Toolset:     ToolsetCREM,
+		Permissions: []string{"Attack Surface Discovery: View"},
+		APIPaths:    []string{"GET /v3.0/asrm/attackSurfaceCustomTags"},
+	},
+
+	{
+		New:         toolEmailSecurityAccountsList,
+		Toolset:     ToolsetEmail,
+		Permissions: []string{"Email Asset Inventory: View"},
+		APIPaths:    []string{"GET /v3.0/emailAssetInventory/emailAccounts"},
+	},
+	{
+		New:         toolEmailSecurityDomainsList,
+		Toolset:     ToolsetEmail,
+		Permissions: []string{"Email Asset Inventory: View"},
+		APIPaths:    []string{"GET /v3.0/emailAssetInventory/emailDomains"},
+	},
+	{
+		New:         toolEmailSecurityServersList,
+		Toolset:     ToolsetEmail,
+		Permissions: []string{"Email Asset Inventory: View"},
+		APIPaths:    []string{"GET /v3.0/emailAssetInventory/emailServers"},
+	},
+
+	{
+		New:         toolEndpointSecurityEndpointsList,
+		Toolset:     ToolsetEndpoint,
+		Permissions: []string{"Endpoint Inventory: View"},
+		APIPaths:    []string{"GET /v3.0/endpointSecurity/endpoints"},
+	},
+	{
+		New:         toolEndpointSecurityEndpointGet,
+		Toolset:     ToolsetEndpoint,
+		Permissions: []string{"Endpoint Inventory: View"},
+		APIPaths:    []string{"GET /v3.0/endpointSecurity/endpoints/{endpointId}"},
+	},
+	{
+		New:         toolEndpointSecurityTaskList,
+		Toolset:     ToolsetEndpoint,
+		Permissions: []string{"Endpoint Inventory: View"},
+		APIPaths:    []string{"GET /v3.0/endpointSecurity/tasks"},
+	},
+	{
+		New:         toolEndpointSecurityTaskGet,
+		Toolset:     ToolsetEndpoint,
+		Permissions: []string{"Endpoint Inventory: View"},
+		APIPaths:    []string{"GET /v3.0/endpointSecurity/tasks/{taskId}"},
+	},
+	{
+		New:         toolEndpointSecurityVersionControlPoliciesList,
+		Toolset:     ToolsetEndpoint,
+		Permissions: []string{"Endpoint Version Control: View"},
+		APIPaths:    []string{"GET /v3.0/endpointSecurity/versionControlPolicies"},
+	},
+	{
+		New:         toolEndpointSecurityAgentUpdatePoliciesList,
+		Toolset:     ToolsetEndpoint,
+		Permissions: []string{"Endpoint Version Control: View"},
+		APIPaths:    []string{"GET /v3.0/endpointSecurity/versionControlPolicies/agentUpdatePolicies"},
+	},
+
+	{
+		New:         toolIamApiKeysList,
+		Toolset:     ToolsetIAM,
+		Permissions: []string{"API Keys: View"},
+		APIPaths:    []string{"GET /v3.0/iam/apiKeys"},
+	},
+	{
+		New:         toolIamAccountsList,
+		Toolset:     ToolsetIAM,
+		Permissions: []string{"User Accounts: View"},
+		APIPaths:    []string{"GET /v3.0/iam/accounts"},
+	},
+	{
+		New:         toolIamApiKeysDelete,
+		Toolset:     ToolsetIAM,
+		Permissions: []string{"API Keys: Manage"},
+		APIPaths:    []string{"POST /v3.0/iam/apiKeys/delete"},
+	},
+	{
+		New:         toolIamAccountInvite,
+		Toolset:     ToolsetIAM,
+		Permissions: []string{"User Accounts: Manage"},
+		APIPaths:    []string{"POST /v3.0/iam/accounts"},
+	},
+	{
+		New:         toolIamAccountUpdate,
+		Toolset:     ToolsetIAM,
+		Permissions: []string{"User Accounts: Manage"},
+		APIPaths:    []string{"PATCH /v3.0/iam/accounts/{accountId}"},
+	},
+	{
+		New:         toolIamAccountDelete,
+		Toolset:     ToolsetIAM,
+		Permissions: []string{"User Accounts: Manage"},
+		APIPaths:    []string{"DELETE /v3.0/iam/accounts/{accountId}"},
+	},
+
+	{
+		New:     toolIOCEnrich,
+		Toolset: ToolsetIOC,
+		Permissions: []string{
+			"Suspicious Object Management: View, filter, and search",
+			"Threat Intelligence Feeds: View",
+			"Attack Surface Discovery: View",
+		},
+		APIPaths: []string{
+			"GET /v3.0/threatintel/suspiciousObjects",
+			"GET /v3.0/threatintel/suspiciousObjectExceptions",
+			"GET /v3.0/threatintel/feedIndicators",
+			"GET /v3.0/asrm/attackSurfacePublicIpAddresses",
+			"GET /v3.0/asrm/attackSurfaceGlobalFqdns",
+		},
+	},
+
+	{
+		New:         toolThreatIntelSuspiciousObjectsList,
+		Toolset:     ToolsetThreatIntel,
+		Permissions: []string{"Suspicious Object Management: View, filter, and search"},
+		APIPaths:    []string{"GET /v3.0/threatintel/suspiciousObjects"},
+	},
+	{
+		New:         toolThreatIntelExceptionsList,
+		Toolset:     ToolsetThreatIntel,
+		Permissions: []string{"Suspicious Object Management: View, filter, and search"},
+		APIPaths:    []string{"GET /v3.0/threatintel/suspiciousObjectExceptions"},
+	},
+	{
+		New:         toolThreatIntelIntelligenceReportsList,
+		Toolset:     ToolsetThreatIntel,
+		Permissions: []string{"Intelligence Reports: View, filter, and search"},
+		APIPaths:    []string{"GET /v3.0/threatintel/intelligenceReports"},
+	},
+	{
+		New:         toolThreatIntelIntelligenceReportGet,
+		Toolset:     ToolsetThreatIntel,
+		Permissions: []string{"Intelligence Reports: View, filter, and search"},
+		APIPaths:    []string{"GET /v3.0/threatintel/intelligenceReports/{reportId}"},
+	},
+	{
+		New:         toolThreatIntelTasksList,
+		Toolset:     ToolsetThreatIntel,
+		Permissions: []string{"Intelligence Reports: View, filter, and search"},
+		APIPaths:    []string{"GET /v3.0/threatintel/tasks"},
+	},
+	{
+		New:         toolThreatIntelTaskResultsGet,
+		Toolset:     ToolsetThreatIntel,
+		Permissions: []string{"Intelligence Reports: View, filter, and search"},
+		APIPaths:    []string{"GET /v3.0/threatintel/tasks/{taskId}"},
+	},
+	{
+		New:         toolThreatIntelFeedIndicatorsList,
+		Toolset:     ToolsetThreatIntel,
+		Permissions: []string{"Threat Intelligence Feeds: View"},
+		APIPaths:    []string{"GET /v3.0/threatintel/feedIndicators"},
+	},
+	{
+		New:         toolThreatIntelFeedsList,
+		Toolset:     ToolsetThreatIntel,
+		Permissions: []string{"Threat Intelligence Feeds: View"},
+		APIPaths:    []string{"GET /v3.0/threatintel/feeds"},
+	},
+	{
+		New:         toolThreatIntelFeedFilterDefinitionGet,
+		Toolset:     ToolsetThreatIntel,
+		Permissions: []string{"Threat Intelligence Feeds: View"},
+		APIPaths:    []string{"GET /v3.0/threatintel/feeds/filterDefinition"},
+	},
+	{
+		New:         toolThreatIntelSuspiciousObjectsAdd,
+		Toolset:     ToolsetThreatIntel,
+		Permissions: []string{"Suspicious Object Management: Manage lists and configure settings"},
+		APIPaths:    []string{"POST /v3.0/threatintel/suspiciousObjects"},
+	},
+	{
+		New:         toolThreatIntelSuspiciousObjectsBulkAdd,
+		Toolset:     ToolsetThreatIntel,
+		Permissions: []string{"Suspicious Object Management: Manage lists and configure settings"},
+		APIPaths: []string{
+			"GET /v3.0/threatintel/suspiciousObjects",
+			"POST /v3.0/threatintel/suspiciousObjects",
+		},
+	},
+	{
+		New:         toolThreatIntelSuspiciousObjectsDelete,
+		Toolset:     ToolsetThreatIntel,
+		Permissions: []string{"Suspicious Object Management: Manage lists and configure settings"},
+		APIPaths:    []string{"POST /v3.0/threatintel/suspiciousObjects/delete"},
+	},
+	{
+		New:         toolThreatIntelExceptionsAdd,
+		Toolset:     ToolsetThreatIntel,
+		Permissions: []string{"Suspicious Object Management: Manage lists and configure settings"},
+		APIPaths:    []string{"POST /v3.0/threatintel/suspiciousObjectExceptions"},
+	},
+	{
+		New:         toolThreatIntelExceptionsBulkAdd,
+		Toolset:     ToolsetThreatIntel,
+		Permissions: []string{"Suspicious Object Management: Manage lists and configure settings"},
+		APIPaths: []string{
+			"GET /v3.0/threatintel/suspiciousObjectExceptions",
+			"POST /v3.0/threatintel/suspiciousObjectExceptions",
+		},
+	},
+	{
+		New:         toolThreatIntelExceptionsDelete,
+		Toolset:     ToolsetThreatIntel,
+		Permissions: []string{"Suspicious Object Management: Manage lists and configure settings"},
+		APIPaths:    []string{"POST /v3.0/threatintel/suspiciousObjectExceptions/delete"},
+	},
+	{
+		New:         toolThreatIntelIntelligenceReportsDelete,
+		Toolset:     ToolsetThreatIntel,
+		Permissions: []string{"Intelligence Reports: Delete"},
+		APIPaths:    []string{"POST /v3.0/threatintel/intelligenceReports/delete"},
+	},
+	{
+		New:         toolThreatIntelSweepTrigger,
+		Toolset:     ToolsetThreatIntel,
+		Permissions: []string{"Intelligence Reports: Run sweep"},
+		APIPaths:    []string{"POST /v3.0/threatintel/intelligenceReports/sweep"},
+	},
+
+	{
+		New:         toolWorkbenchAlertsList,
+		Toolset:     ToolsetWorkbench,
+		Permissions: []string{"Workbench: View, filter, and search"},
+		APIPaths:    []string{"GET /v3.0/workbench/alerts"},
+	},
+	{
+		New:         toolWorkbenchAlertDetailGet,
+		Toolset:     ToolsetWorkbench,
+		Permissions: []string{"Workbench: View, filter, and search"},
+		APIPaths:    []string{"GET /v3.0/workbench/alerts/{alertId}"},
+	},
+	{
+		New:         toolObservedAttackTechniquesList,
+		Toolset:     ToolsetWorkbench,
+		Permissions: []string{"Observed Attack Techniques: View, filter, and search"},
+		APIPaths:    []string{"GET /v3.0/oat/detections"},
+	},
+}
@@ -0,0 +1,44 @@
+package tools
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var apiPathRegexp = regexp.MustCompile(`^(GET|POST|PATCH|DELETE) /(v3\.0|beta)/[A-Za-z0-9/{}]+$`)
+
+func TestRegistry(t *testing.T) {
+	toolsets := []string{
+		ToolsetAISecurity,
+		ToolsetCAM,
+		ToolsetCloudPosture,
+		ToolsetCloudRiskManagement,
+		ToolsetContainer,
+		ToolsetCREM,
+		ToolsetEmail,
+		ToolsetEndpoint,
+		ToolsetIAM,
+		ToolsetIOC,
+		ToolsetThreatIntel,
+		ToolsetWorkbench,
+	}
+
+	names := map[string]bool{}
+	for _, r := range Registry {
+		tool := r.New(nil).Tool
+		require.False(t, names[tool.Name], "%s is registered twice", tool.Name)
+		names[tool.Name] = true
+
+		require.Contains(t, toolsets, r.Toolset, tool.Name)
+		require.NotNil(t, tool.Annotations.ReadOnlyHint, "%s has no readOnlyHint", tool.Name)
+		require.NotEmpty(t, r.Permissions, tool.Name)
+		require.NotEmpty(t, r.APIPaths, tool.Name)
+		for _, path := range r.APIPaths {
+			require.Regexp(t, apiPathRegexp, path, tool.Name)
+			require.Equal(t, r.Beta, strings.Contains(path, " /beta/"), "%s: %s", tool.Name, path)
+		}
+	}
+}
@@ -0,0 +1,400 @@
+package tools
+
+// Output schemas of the tool results.
+//
+// The schemas declare the fields that are the most useful to clients and
+// downstream agents. Vision One returns many more fields, additional
+// properties are allowed and fields are only required when Vision One always
+// returns them. Descriptions must not contain commas, they separate the
+// options of the jsonschema struct tag.
+
+// listResponse is a page of a Vision One list API.
+type listResponse[T any] struct {
+	TotalCount int    `json:"totalCount,omitempty" jsonschema:"description=The number of items matching the query"`
+	Count      int    `json:"count,omitempty" jsonschema:"description=The number of items in this page"`
+	Items      []T    `json:"items" jsonschema:"description=The items of this page"`
+	NextLink   string `json:"nextLink,omitempty" jsonschema:"description=The link to the next page of results"`
+}
+
+// objectResponse is a response whose structure is not declared in detail.
+type objectResponse map[string]any
+
+type workbenchAlert struct {
+	ID                  string           `json:"id" jsonschema:"description=The ID of the alert"`
+	SchemaVersion       string           `json:"schemaVersion,omitempty"`
+	Status              string           `json:"status,omitempty" jsonschema:"description=The status of the case or investigation"`
+	InvestigationStatus string           `json:"investigationStatus,omitempty"`
+	InvestigationResult string           `json:"investigationResult,omitempty"`
+	WorkbenchLink       string           `json:"workbenchLink,omitempty"`
+	AlertProvider       string           `json:"alertProvider,omitempty"`
+	ModelID             string           `json:"modelId,omitempty"`
+	Model               string           `json:"model,omitempty" jsonschema:"description=The detection model that triggered the alert"`
+	ModelType           string           `json:"modelType,omitempty"`
+	Score               int              `json:"score,omitempty"`
+	Severity            string           `json:"severity,omitempty" jsonschema:"enum=critical,enum=high,enum=medium,enum=low"`
+	CreatedDateTime     string           `json:"createdDateTime,omitempty"`
+	UpdatedDateTime     string           `json:"updatedDateTime,omitempty"`
+	IncidentID          string           `json:"incidentId,omitempty"`
+	OwnerIDs            []string         `json:"ownerIds,omitempty"`
+	Description         string           `json:"description,omitempty"`
+	ImpactScope         map[string]any   `json:"impactScope,omitempty" jsonschema:"description=The entities affected by the alert"`
+	MatchedRules        []map[string]any `json:"matchedRules,omitempty"`
+	Indicators          []map[string]any `json:"indicators,omitempty" jsonschema:"description=The objects found using root cause analysis or sweeping"`
+}
+
+type observedAttackTechnique struct {
+	UUID             string         `json:"uuid,omitempty"`
+	Source           string         `json:"source,omitempty"`
+	DetectedDateTime string         `json:"detectedDateTime,omitempty"`
+	IngestedDateTime string         `json:"ingestedDateTime,omitempty"`
+	Filters          []any          `json:"filters,omitempty"`
+	Endpoint         map[string]any `json:"endpoint,omitempty"`
+	Entity           map[string]any `json:"entity,omitempty"`
+	Detail           map[string]any `json:"detail,omitempty"`
+}
+
+type endpoint struct {
+	AgentGUID    string         `json:"agentGuid" jsonschema:"description=The GUID of the agent installed on the endpoint"`
+	EndpointName string         `json:"endpointName,omitempty"`
+	Type         string         `json:"type,omitempty"`
+	OSName       string         `json:"osName,omitempty"`
+	OSVersion    string         `json:"osVersion,omitempty"`
+	OSPlatform   string         `json:"osPlatform,omitempty"`
+	LastUsedIP   string         `json:"lastUsedIp,omitempty"`
+	IPAddresses  []string       `json:"ipAddresses,omitempty"`
+	EPPAgent     map[string]any `json:"eppAgent,omitempty" jsonschema:"description=The endpoint protection agent"`
+	EDRSensor    map[string]any `json:"edrSensor,omitempty" jsonschema:"description=The endpoint sensor"`
+}
+
+type endpointTask struct {
+	ID                 string `json:"id" jsonschema:"description=The ID of the task"`
+	Status             string `json:"status,omitempty"`
+	Action             string `json:"action,omitempty"`
+	Account            string `json:"account,omitempty"`
+	AgentGUID          string `json:"agentGuid,omitempty"`
+	EndpointName       string `json:"endpointName,omitempty"`
+	CreatedDateTime    string `json:"createdDateTime,omitempty"`
+	LastActionDateTime string `json:"lastActionDateTime,omitempty"`
+}
+
+type agentUpdatePolicy struct {
+	ID   string `json:"id,omitempty"`
+	Name string `json:"name,omitempty"`
+}
+
+type versionControlPolicy struct {
+	ID         string `json:"id,omitempty"`
+	Name       string `json:"name,omitempty"`
+	PolicyType string `json:"policyType,omitempty"`
+}
+
+type suspiciousObject struct {
+	Type                 string `json:"type" jsonschema:"description=The type of the object,enum=url,enum=domain,enum=ip,enum=fileSha1,enum=fileSha256,enum=senderMailAddress"`
+	URL                  string `json:"url,omitempty"`
+	Domain               string `json:"domain,omitempty"`
+	IP                   string `json:"ip,omitempty"`
+	FileSHA1             string `json:"fileSha1,omitempty"`
+	FileSHA256           string `json:"fileSha256,omitempty"`
+	SenderMailAddress    string `json:"senderMailAddress,omitempty"`
+	Description          string `json:"description,omitempty"`
+	ScanAction           string `json:"scanAction,omitempty" jsonschema:"enum=block,enum=log"`
+	RiskLevel            string `json:"riskLevel,omitempty" jsonschema:"enum=high,enum=medium,enum=low"`
+	InExceptionList      bool   `json:"inExceptionList,omitempty"`
+	LastModifiedDateTime string `json:"lastModifiedDateTime,omitempty"`
+	ExpiredDateTime      string `json:"expiredDateTime,omitempty"`
+}
+
+type suspiciousObjectException struct {
+	Type                 string `json:"type" jsonschema:"description=The type of the object,enum=url,enum=domain,enum=ip,enum=fileSha1,enum=fileSha256,enum=senderMailAddress"`
+	URL                  string `json:"url,omitempty"`
+	Domain               string `json:"domain,omitempty"`
+	IP                   string `json:"ip,omitempty"`
+	FileSHA1             string `json:"fileSha1,omitempty"`
+	FileSHA256           string `json:"fileSha256,omitempty"`
+	SenderMailAddress    string `json:"senderMailAddress,omitempty"`
+	Description          string `json:"description,omitempty"`
+	LastModifiedDateTime string `json:"lastModifiedDateTime,omitempty"`
+}
+
+type intelligenceReport struct {
+	ID                   string `json:"id" jsonschema:"description=The ID of the custom intelligence report"`
+	Name                 string `json:"name,omitempty"`
+	CreatedDateTime      string `json:"createdDateTime,omitempty"`
+	UpdatedDateTime      string `json:"updatedDateTime,omitempty"`
+	LastSweepedDateTime  string `json:"lastSweepedDateTime,omitempty"`
+	SweepType            string `json:"sweepType,omitempty"`
+	SweepStatus          string `json:"sweepStatus,omitempty"`
+	TotalObjectCount     int    `json:"totalObjectCount,omitempty"`
+	StixSupportedCount   int    `json:"stixSupportedCount,omitempty"`
+	StixUnsupportedCount int    `json:"stixUnsupportedCount,omitempty"`
+}
+
+// stixBundle is a STIX 2.1 bundle.
+type stixBundle struct {
+	Type    string           `json:"type" jsonschema:"enum=bundle"`
+	ID      string           `json:"id"`
+	Objects []map[string]any `json:"objects,omitempty" jsonschema:"description=The STIX objects of the bundle"`
+}
+
+type threatIntelTask struct {
+	ID                 string `json:"id" jsonschema:"description=The ID of the task"`
+	Status             string `json:"status,omitempty"`
+	Action             string `json:"action,omitempty"`
+	Account            string `json:"account,omitempty"`
+	CreatedDateTime    string `json:"createdDateTime,omitempty"`
+	LastActionDateTime string `json:"lastActionDateTime,omitempty"`
+}
+
+// feedFilterDefinition are the values of the contextual filter of the
+// Trend Threat Intelligence Feed.
+type feedFilterDefinition struct {
+	Location []string `json:"location,omitempty" jsonschema:"description=The supported values of location"`
+	Industry []string `json:"industry,omitempty" jsonschema:"description=The supported values of industry"`
+}
+
+// feedResponse is a page of the Trend Threat Intelligence Feed.
+type feedResponse struct {
+	TotalCount int            `json:"totalCount,omitempty" jsonschema:"description=The number of objects matching the query"`
+	Count      int            `json:"count,omitempty" jsonschema:"description=The number of objects in this page"`
+	Bundle     map[string]any `json:"bundle,omitempty" jsonschema:"description=The STIX 2.1 bundle of this page"`
+	NextLink   string         `json:"nextLink,omitempty" jsonschema:"description=The link to the next page of results"`
+}
+
+type apiKey struct {
+	ID                   string `json:"id" jsonschema:"description=The ID of the API key"`
+	Name                 string `json:"name,omitempty"`
+	Role                 string `json:"role,omitempty"`
+	Status               string `json:"status,omitempty" jsonschema:"enum=enabled,enum=disabled"`
+	Description          string `json:"description,omitempty"`
+	ExpiredDateTime      string `json:"expiredDateTime,omitempty"`
+	LastUsedDateTime     string `json:"lastUsedDateTime,omitempty"`
+	CreatedDateTime      string `json:"createdDateTime,omitempty"`
+	LastModifiedDateTime string `json:"lastModifiedDateTime,omitempty"`
+}
+
+type iamAccount struct {
+	ID                   string `json:"id,omitempty"`
+	Email                string `json:"email,omitempty"`
+	Type                 string `json:"type,omitempty"`
+	Role                 string `json:"role,omitempty"`
+	Status               string `json:"status,omitempty"`
+	Description          string `json:"description,omitempty"`
+	AuthType             string `json:"authType,omitempty"`
+	MFAEnabled           bool   `json:"mfaEnabled,omitempty"`
+	LastLoggedDateTime   string `json:"lastLoggedDateTime,omitempty"`
+	LastModifiedDateTime string `json:"lastModifiedDateTime,omitempty"`
+}
+
+type camAccount struct {
+	ID                 string           `json:"id" jsonschema:"description=The ID of the cloud account"`
+	Name               string           `json:"name,omitempty"`
+	Description        string           `json:"description,omitempty"`
+	State              string           `json:"state,omitempty"`
+	CreatedDateTime    string           `json:"createdDateTime,omitempty"`
+	UpdatedDateTime    string           `json:"updatedDateTime,omitempty"`
+	LastSyncedDateTime string           `json:"lastSyncedDateTime,omitempty"`
+	Features           []map[string]any `json:"features,omitempty"`
+	OrganizationID     string           `json:"organizationId,omitempty"`
+}
+
+type cloudPostureScanSettings struct {
+	Enabled  bool `json:"enabled,omitempty" jsonschema:"description=Whether the account is scanned"`
+	Interval int  `json:"interval,omitempty" jsonschema:"description=The number of hours between scans"`
+}
+
+type cloudRiskManagementAccount struct {
+	ID       string `json:"id" jsonschema:"description=The Cloud Risk Management ID of the account"`
+	Name     string `json:"name,omitempty"`
+	Provider string `json:"provider,omitempty" jsonschema:"enum=aws,enum=azure,enum=gcp,enum=alibabaCloud,enum=oci"`
+}
+
+type cloudRiskManagementScanRule struct {
+	ID        string `json:"id" jsonschema:"description=The ID of the rule"`
+	Enabled   bool   `json:"enabled,omitempty"`
+	RiskLevel string `json:"riskLevel,omitempty"`
+}
+
+type cloudRiskManagementService struct {
+	ID       string           `json:"id" jsonschema:"description=The ID of the cloud service"`
+	Name     string           `json:"name,omitempty"`
+	Provider string           `json:"provider,omitempty" jsonschema:"enum=aws,enum=azure,enum=gcp,enum=alibabaCloud,enum=oci"`
+	Rules    []map[string]any `json:"rules,omitempty" jsonschema:"description=The rules of the service"`
+}
+
+type cloudPostureAccount struct {
+	ID                  string `json:"id" jsonschema:"description=The Cloud Posture ID of the account"`
+	Name                string `json:"name,omitempty"`
+	Environment         string `json:"environment,omitempty"`
+	Provider            string `json:"provider,omitempty"`
+	AWSAccountID        string `json:"awsAccountId,omitempty"`
+	AzureSubscriptionID string `json:"azureSubscriptionId,omitempty"`
+	GCPProjectID        string `json:"gcpProjectId,omitempty"`
+	LastCheckedDateTime string `json:"lastCheckedDateTime,omitempty"`
+	CreatedDateTime     string `json:"createdDateTime,omitempty"`
+}
+
+type cloudPostureCheck struct {
+	ID              string   `json:"id" jsonschema:"description=The ID of the check"`
+	AccountID       string   `json:"accountId,omitempty"`
+	RuleID          string   `json:"ruleId,omitempty"`
+	RuleTitle       string   `json:"ruleTitle,omitempty"`
+	Service         string   `json:"service,omitempty"`
+	Region          string   `json:"region,omitempty"`
+	Resource        string   `json:"resource,omitempty"`
+	ResourceName    string   `json:"resourceName,omitempty"`
+	Description     string   `json:"description,omitempty"`
+	Status          string   `json:"status,omitempty" jsonschema:"enum=SUCCESS,enum=FAILURE"`
+	RiskLevel       string   `json:"riskLevel,omitempty" jsonschema:"enum=LOW,enum=MEDIUM,enum=HIGH,enum=VERY_HIGH,enum=EXTREME"`
+	Categories      []string `json:"categories,omitempty"`
+	Compliances     []string `json:"compliances,omitempty"`
+	Suppressed      bool     `json:"suppressed,omitempty"`
+	CreatedDateTime string   `json:"createdDateTime,omitempty"`
+	UpdatedDateTime string   `json:"updatedDateTime,omitempty"`
+}
+
+type cloudPostureCustomRule struct {
+	ID          string           `json:"id" jsonschema:"description=The ID of the custom rule"`
+	Name        string           `json:"name,omitempty"`
+	Description string           `json:"description,omitempty"`
+	Service     string           `json:"service,omitempty"`
+	Provider    string           `json:"provider,omitempty"`
+	RiskLevel   string           `json:"riskLevel,omitempty"`
+	Enabled     bool             `json:"enabled,omitempty"`
+	Categories  []string         `json:"categories,omitempty"`
+	Attributes  []map[string]any `json:"attributes,omitempty"`
+	Rules       []map[string]any `json:"rules,omitempty"`
+}
+
+type kubernetesCluster struct {
+	ID                    string         `json:"id" jsonschema:"description=The ID of the cluster"`
+	Name                  string         `json:"name,omitempty"`
+	Description           string         `json:"description,omitempty"`
+	Orchestrator          string         `json:"orchestrator,omitempty"`
+	PolicyID              string         `json:"policyId,omitempty"`
+	ProtectionStatus      string         `json:"protectionStatus,omitempty"`
+	CreatedDateTime       string         `json:"createdDateTime,omitempty"`
+	UpdatedDateTime       string         `json:"updatedDateTime,omitempty"`
+	LastEvaluatedDateTime string         `json:"lastEvaluatedDateTime,omitempty"`
+	RuntimeSecurity       map[string]any `json:"runtimeSecurity,omitempty"`
+	VulnerabilityScanning map[string]any `json:"vulnerabilityScanning,omitempty"`
+	MalwareScanning       map[string]any `json:"malwareScanning,omitempty"`
+	Nodes                 []any          `json:"nodes,omitempty"`
+}
+
+type containerImage struct {
+	ID              string   `json:"id,omitempty"`
+	Registry        string   `json:"registry,omitempty"`
+	Repository      string   `json:"repository,omitempty"`
+	Tags            []string `json:"tags,omitempty"`
+	Digest          string   `json:"digest,omitempty"`
+	ClusterID       string   `json:"clusterId,omitempty"`
+	ClusterName     string   `json:"clusterName,omitempty"`
+	CreatedDateTime string   `json:"createdDateTime,omitempty"`
+}
+
+type containerImageVulnerability struct {
+	ID                    string           `json:"id,omitempty"`
+	Name                  string           `json:"name,omitempty" jsonschema:"description=The CVE ID of the vulnerability"`
+	RiskLevel             string           `json:"riskLevel,omitempty"`
+	Description           string           `json:"description,omitempty"`
+	ClusterID             string           `json:"clusterId,omitempty"`
+	ClusterType           string           `json:"clusterType,omitempty"`
+	ImageID               string           `json:"imageId,omitempty"`
+	Packages              []map[string]any `json:"packages,omitempty"`
+	FirstDetectedDateTime string           `json:"firstDetectedDateTime,omitempty"`
+	LastDetectedDateTime  string           `json:"lastDetectedDateTime,omitempty"`
+}
+
+type attackSurfaceDevice struct {
+	ID                   string   `json:"id" jsonschema:"description=The ID of the device on the Trend Vision One platform"`
+	DeviceName           string   `json:"deviceName,omitempty"`
+	IP                   []string `json:"ip,omitempty"`
+	OSPlatform           string   `json:"osPlatform,omitempty"`
+	OSName               string   `json:"osName,omitempty"`
+	LatestRiskScore      int      `json:"latestRiskScore,omitempty"`
+	Criticality          string   `json:"criticality,omitempty"`
+	FirstSeenDateTime    string   `json:"firstSeenDateTime,omitempty"`
+	LastDetectedDateTime string   `json:"lastDetectedDateTime,omitempty"`
+	AssetCustomTags      []any    `json:"assetCustomTags,omitempty"`
+}
+
+type attackSurfaceAccount struct {
+	ID                   string `json:"id" jsonschema:"description=The ID of the asset on the Trend Vision One platform"`
+	Name                 string `json:"name,omitempty"`
+	Type                 string `json:"type,omitempty"`
+	LatestRiskScore      int    `json:"latestRiskScore,omitempty"`
+	Criticality          string `json:"criticality,omitempty"`
+	LastDetectedDateTime string `json:"lastDetectedDateTime,omitempty"`
+	AssetCustomTags      []any  `json:"assetCustomTags,omitempty"`
+}
+
+type attackSurfaceFQDN struct {
+	ID                   string `json:"id" jsonschema:"description=The ID of the domain on the Trend Vision One platform"`
+	FQDN                 string `json:"fqdn,omitempty"`
+	RootDomain           string `json:"rootDomain,omitempty"`
+	Provider             string `json:"provider,omitempty"`
+	LatestRiskScore      int    `json:"latestRiskScore,omitempty"`
+	Criticality          string `json:"criticality,omitempty"`
+	DiscoveredBy         []any  `json:"discoveredBy,omitempty"`
+	LastDetectedDateTime string `json:"lastDetectedDateTime,omitempty"`
+}
+
+type attackSurfacePublicIP struct {
+	ID                   string `json:"id" jsonschema:"description=The ID of the IP address on the Trend Vision One platform"`
+	IPAddress            string `json:"ipAddress,omitempty"`
+	Provider             string `json:"provider,omitempty"`
+	LatestRiskScore      int    `json:"latestRiskScore,omitempty"`
+	Criticality          string `json:"criticality,omitempty"`
+	DiscoveredBy         []any  `json:"discoveredBy,omitempty"`
+	LastDetectedDateTime string `json:"lastDetectedDateTime,omitempty"`
+}
+
+type attackSurfaceCloudAsset struct {
+	ID                   string `json:"id" jsonschema:"description=The ID of the cloud asset on the Trend Vision One platform"`
+	AssetName            string `json:"assetName,omitempty"`
+	AssetType            string `json:"assetType,omitempty"`
+	Provider             string `json:"provider,omitempty"`
+	Region               string `json:"region,omitempty"`
+	CloudAccountID       string `json:"cloudAccountId,omitempty"`
+	LatestRiskScore      int    `json:"latestRiskScore,omitempty"`
+	Criticality          string `json:"criticality,omitempty"`
+	LastDetectedDateTime string `json:"lastDetectedDateTime,omitempty"`
+	AssetCustomTags      []any  `json:"assetCustomTags,omitempty"`
+}
+
+type highRiskUser struct {
+	ID                string `json:"id" jsonschema:"description=The ID of the user on the Trend Vision One platform"`
+	UserName          string `json:"userName,omitempty"`
+	UserPrincipalName string `json:"userPrincipalName,omitempty"`
+	RiskScore         int    `json:"riskScore,omitempty"`
+}
+
+type attackSurfaceRiskIndicator struct {
+	ID                    string `json:"id,omitempty"`
+	RiskFactor            string `json:"riskFactor,omitempty"`
+	RiskLevel             string `json:"riskLevel,omitempty"`
+	RiskCategory          string `json:"riskCategory,omitempty"`
+	EventName             string `json:"eventName,omitempty"`
+	Description           string `json:"description,omitempty"`
+	FirstDetectedDateTime string `json:"firstDetectedDateTime,omitempty"`
+	LastDetectedDateTime  string `json:"lastDetectedDateTime,omitempty"`
+	ComplianceRules       []any  `json:"complianceRules,omitempty"`
+}
+
+type attackSurfaceLocalApp struct {
+	ID                   string `json:"id" jsonschema:"description=The ID of the application on the Trend Vision One platform"`
+	Name                 string `json:"name,omitempty"`
+	Publisher            string `json:"publisher,omitempty"`
+	Version              string `json:"version,omitempty"`
+	Category             string `json:"category,omitempty"`
+	LatestRiskScore      int    `json:"latestRiskScore,omitempty"`
+	DeviceCount          int    `json:"deviceCount,omitempty"`
+	FirstSeenDateTime    string `json:"firstSeenDateTime,omitempty"`
+	LastDetectedDateTime string `json:"lastDetectedDateTime,omitempty"`
+}
+
+type customTag struct {
+	ID    string `json:"id" jsonschema:"description=The ID of the custom tag"`
+	Key   string `json:"key,omitempty"`
+	Value string `json:"value,omitempty"`
+}
@@ -0,0 +1,160 @@
+package tools
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+	"github.com/trendmicro/vision-one-mcp-server/internal/v1client"
+)
+
+func allTools(t *testing.T) map[string]mcp.Tool {
+	t.Helper()
+
+	client, err := v1client.NewV1ApiClient(v1client.ClientOptions{Region: "us"})
+	require.NoError(t, err)
+
+	tools := map[string]mcp.Tool{}
+	for _, r := range Registry {
+		tool := r.New(client).Tool
+		tools[tool.Name] = tool
+	}
+	return tools
+}
+
+// untypedOutputTools declare an objectResponse, their results have no
+// documented structure.
+var untypedOutputTools = []string{
+	"cloud_posture_custom_rule_test",
+	"cloud_posture_template_scanner_run",
+}
+
+func TestReadOnlyToolsDeclareOutputSchema(t *testing.T) {
+	for name, tool := range allTools(t) {
+		if tool.Annotations.ReadOnlyHint == nil || !*tool.Annotations.ReadOnlyHint {
+			continue
+		}
+		require.Equal(t, "object", tool.OutputSchema.Type, "tool %s has no output schema", name)
+		require.Equal(t, slices.Contains(untypedOutputTools, name), len(tool.OutputSchema.Properties) == 0, "tool %s has an untyped output schema", name)
+	}
+}
+
+// TestOutputSchemaFixtures validates the results in testdata/outputs, see its
+// README for where they come from.
+func TestOutputSchemaFixtures(t *testing.T) {
+	tools := allTools(t)
+
+	fixtures, err := filepath.Glob(filepath.Join("testdata", "outputs", "*.json"))
+	require.NoError(t, err)
+	require.NotEmpty(t, fixtures)
+
+	for _, fixture := range fixtures {
+		name := strings.TrimSuffix(filepath.Base(fixture), ".json")
+		t.Run(name, func(t *testing.T) {
+			tool, ok := tools[name]
+			require.True(t, ok, "no tool named %s", name)
+			require.NotEmpty(t, tool.OutputSchema.Type, "tool %s has no output schema", name)
+
+			b, err := json.Marshal(tool.OutputSchema)
+			require.NoError(t, err)
+			schema := map[string]any{}
+			require.NoError(t, json.Unmarshal(b, &schema))
+
+			b, err = os.ReadFile(fixture)
+			require.NoError(t, err)
+			var value any
+			require.NoError(t, json.Unmarshal(b, &value))
+
+			require.NoError(t, validateSchema(schema, value, "$"))
+		})
+	}
+}
+
+func TestHandleStatusResponseStructuredContent(t *testing.T) {
+	response := func(body string) *http.Response {
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(body))}
+	}
+
+	result, err := handleStatusResponse(response(`{"items":[]}`), nil, http.StatusOK, "failed")
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{"items": []any{}}, result.StructuredContent)
+	require.Equal(t, `{"items":[]}`, result.Content[0].(mcp.TextContent).Text)
+
+	result, err = handleStatusResponse(response(`not json`), nil, http.StatusOK, "failed")
+	require.NoError(t, err)
+	require.Nil(t, result.StructuredContent)
+	require.Equal(t, `not json`, result.Content[0].(mcp.TextContent).Text)
+}
+
+// validateSchema checks value against the subset of JSON Schema generated for
+// the output schemas: type, properties, required, items and enum.
+func validateSchema(schema map[string]any, value any, path string) error {
+	if enum, ok := schema["enum"].([]any); ok && !slices.Contains(enum, value) {
+		return fmt.Errorf("%s: %v is not one of %v", path, value, enum)
+	}
+
+	switch schema["type"] {
+	case nil:
+		return nil
+	case "string":
+		if _, ok := value.(string); !ok {
+			return fmt.Errorf("%s: expected a string, got %T", path, value)
+		}
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			return fmt.Errorf("%s: expected a boolean, got %T", path, value)
+		}
+	case "number":
+		if _, ok := value.(float64); !ok {
+			return fmt.Errorf("%s: expected a number, got %T", path, value)
+		}
+	case "integer":
+		if n, ok := value.(float64); !ok || n != float64(int64(n)) {
+			return fmt.Errorf("%s: expected an integer, got %v", path, value)
+		}
+	case "array":
+		items, ok := value.([]any)
+		if !ok {
+			return fmt.Errorf("%s: expected an array, got %T", path, value)
+		}
+		itemSchema, _ := schema["items"].(map[string]any)
+		for i, item := range items {
+			if err := validateSchema(itemSchema, item, fmt.Sprintf("%s[%d]", path, i)); err != nil {
+				return err
+			}
+		}
+	case "object":
+		object, ok := value.(map[string]any)
+		if !ok {
+			return fmt.Errorf("%s: expected an object, got %T", path, value)
+		}
+		required, _ := schema["required"].([]any)
+		for _, name := range required {
+			if _, ok := object[name.(string)]; !ok {
+				return fmt.Errorf("%s: missing required property %s", path, name)
+			}
+		}
+		properties, _ := schema["properties"].(map[string]any)
+		for name, property := range object {
+			propertySchema, ok := properties[name].(map[string]any)
+			if !ok {
+				continue
+			}
+			if err := validateSchema(propertySchema, property, path+"."+name); err != nil {
+				return err
+			}
+		}
+	default:
+		return fmt.Errorf("%s: unsupported schema type %v", path, schema["type"])
+	}
+
+	return nil
+}
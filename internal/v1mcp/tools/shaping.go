@@ -0,0 +1,491 @@
+package tools
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	mcpserver "github.com/mark3labs/mcp-go/server"
+)
+
+// Arguments added to read tools by WithResponseShaping.
+const (
+	shapingFields   = "fields"
+	shapingJSONPath = "jsonPath"
+	shapingMaxBytes = "maxBytes"
+)
+
+// WithResponseShaping adds the fields, jsonPath and maxBytes arguments to a
+// tool. They reduce large Vision One responses to the parts the client needs
+// before the result is returned. A note is added whenever the result was
+// trimmed so the model knows it is not looking at the full response. The
+// structured content is not shaped, it must still match the output schema
+// of the tool.
+func WithResponseShaping(tool mcpserver.ServerTool) mcpserver.ServerTool {
+	for _, option := range []mcp.ToolOption{
+		mcp.WithArray(shapingFields,
+			mcp.Description("Only return these fields. Nested fields are separated by dots such as impactScope.entities. "+
+				"For list results the fields are selected from each item and the paging fields are kept"),
+			mcp.Items(map[string]any{"type": "string"}),
+		),
+		mcp.WithString(shapingJSONPath,
+			mcp.Description("Only return the values matching this JSONPath expression such as $.items[*].id or $..entityValue. "+
+				"Supports child names, [n] indexes, [*] and .* wildcards and .. recursive descent. Applied before fields"),
+		),
+		mcp.WithNumber(shapingMaxBytes,
+			mcp.Description("Maximum size of the result in bytes. Trailing list items are dropped to fit, other results are truncated"),
+		),
+	} {
+		option(&tool.Tool)
+	}
+
+	handler := tool.Handler
+	tool.Handler = func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		shape, err := newResponseShape(request.GetArguments())
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		shape, err = shape.forFormat(request.GetArguments())
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		result, err := handler(ctx, request)
+		if err != nil || result == nil || result.IsError || shape.empty() {
+			return result, err
+		}
+
+		return shape.apply(result)
+	}
+
+	return tool
+}
+
+type responseShape struct {
+	fields   []string
+	path     []pathStep
+	maxBytes int
+}
+
+func newResponseShape(args map[string]any) (responseShape, error) {
+	shape := responseShape{}
+
+	if err := optionalJSONValue(shapingFields, args, &shape.fields); err != nil {
+		return shape, err
+	}
+
+	expression, err := optionalValue[string](shapingJSONPath, args)
+	if err != nil {
+		return shape, err
+	}
+	if expression != "" {
+		shape.path, err = parseJSONPath(expression)
+		if err != nil {
+			return shape, err
+		}
+	}
+
+	shape.maxBytes, err = optionalIntValue(shapingMaxBytes, args)
+	if err != nil {
+		return shape, err
+	}
+	if shape.maxBytes < 0 {
+		return shape, fmt.Errorf("%s must not be negative", shapingMaxBytes)
+	}
+
+	return shape, nil
+}
+
+// forFormat returns the shape of results rendered by WithListFormat. The
+// fields of rendered results are its columns and only maxBytes applies.
+func (s responseShape) forFormat(args map[string]any) (responseShape, error) {
+	format, err := optionalValue[string](formatArgument, args)
+	if err != nil || format == "" || format == formatJSON {
+		return s, err
+	}
+	if s.path != nil {
+		return s, fmt.Errorf("%s requires %s %s", shapingJSONPath, formatArgument, formatJSON)
+	}
+	return responseShape{maxBytes: s.maxBytes}, nil
+}
+
+func (s responseShape) empty() bool {
+	return len(s.fields) == 0 && s.path == nil && s.maxBytes == 0
+}
+
+// apply shapes the text content of a tool result. The structured content
+// is kept unchanged.
+func (s responseShape) apply(result *mcp.CallToolResult) (*mcp.CallToolResult, error) {
+	for i, content := range result.Content {
+		text, ok := mcp.AsTextContent(content)
+		if !ok {
+			continue
+		}
+
+		shaped, note, err := s.shapeText(text.Text)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if shaped == text.Text {
+			continue
+		}
+
+		result.Content[i] = mcp.NewTextContent(shaped)
+		result.Content = append(result.Content, mcp.NewTextContent(note))
+		break
+	}
+
+	return result, nil
+}
+
+// shapeText returns the shaped text and a note describing what was trimmed.
+func (s responseShape) shapeText(text string) (string, string, error) {
+	var value any
+	if err := json.Unmarshal([]byte(text), &value); err != nil {
+		if s.maxBytes == 0 || len(text) <= s.maxBytes {
+			return text, "", nil
+		}
+		return truncateText(text, s.maxBytes), trimmedNote(len(text), s.maxBytes, "the text was truncated"), nil
+	}
+
+	reasons := []string{}
+	if s.path != nil {
+		value = selectJSONPath(value, s.path)
+		reasons = append(reasons, "jsonPath")
+	}
+	if len(s.fields) > 0 {
+		value = projectResponse(value, s.fields)
+		reasons = append(reasons, "fields")
+	}
+
+	// A response that already fits is returned as it is.
+	if len(reasons) == 0 && len(text) <= s.maxBytes {
+		return text, "", nil
+	}
+
+	b, err := marshalShaped(value)
+	if err != nil {
+		return "", "", err
+	}
+
+	if s.maxBytes > 0 && len(b) > s.maxBytes {
+		var dropped int
+		b, dropped, err = fitItems(value, s.maxBytes)
+		if err != nil {
+			return "", "", err
+		}
+		switch {
+		case len(b) > s.maxBytes:
+			b = []byte(truncateText(string(b), s.maxBytes))
+			reasons = append(reasons, "maxBytes truncated the JSON which is no longer valid")
+		case dropped > 0:
+			reasons = append(reasons, fmt.Sprintf("maxBytes dropped the last %d items", dropped))
+		}
+	}
+
+	if len(reasons) == 0 {
+		reasons = append(reasons, "maxBytes removed the whitespace")
+	}
+
+	return string(b), trimmedNote(len(text), len(b), strings.Join(reasons, " and ")), nil
+}
+
+// marshalShaped encodes a shaped value. Characters such as & are not escaped,
+// they are returned as Vision One sent them.
+func marshalShaped(value any) ([]byte, error) {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(value); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
+
+func trimmedNote(original, returned int, reason string) string {
+	return fmt.Sprintf(
+		"Note: the response was trimmed from %d to %d bytes by %s. Call the tool again without these arguments for the full response",
+		original, returned, reason,
+	)
+}
+
+// truncateText cuts text to at most maxBytes without splitting a UTF-8 character.
+func truncateText(text string, maxBytes int) string {
+	if len(text) <= maxBytes {
+		return text
+	}
+	end := maxBytes
+	for end > 0 && !isRuneStart(text[end]) {
+		end--
+	}
+	return text[:end]
+}
+
+func isRuneStart(b byte) bool {
+	return b&0xC0 != 0x80
+}
+
+// listItemsKey is the key of the items of a Vision One list response.
+const listItemsKey = "items"
+
+// projectResponse keeps only the given fields. Fields are selected from
+// each element of an array and from each item of a list response, the
+// other top level fields of a list response such as nextLink are kept.
+func projectResponse(value any, fields []string) any {
+	switch v := value.(type) {
+	case []any:
+		return projectFields(v, fields)
+	case map[string]any:
+		if items, ok := v[listItemsKey].([]any); ok {
+			projected := make(map[string]any, len(v))
+			for key, field := range v {
+				projected[key] = field
+			}
+			projected[listItemsKey] = projectFields(items, fields)
+			return projected
+		}
+	}
+	return projectFields(value, fields)
+}
+
+func projectFields(value any, fields []string) any {
+	switch v := value.(type) {
+	case []any:
+		projected := make([]any, 0, len(v))
+		for _, element := range v {
+			projected = append(projected, projectFields(element, fields))
+		}
+		return projected
+	case map[string]any:
+		nested := map[string][]string{}
+		for _, field := range fields {
+			name, rest, _ := strings.Cut(field, ".")
+			if _, ok := v[name]; !ok {
+				continue
+			}
+			if rest == "" {
+				nested[name] = nil
+			} else if sub, ok := nested[name]; !ok || sub != nil {
+				nested[name] = append(sub, rest)
+			}
+		}
+
+		projected := make(map[string]any, len(nested))
+		for name, sub := range nested {
+			if sub == nil {
+				projected[name] = v[name]
+			} else {
+				projected[name] = projectFields(v[name], sub)
+			}
+		}
+		return projected
+	default:
+		return value
+	}
+}
+
+// fitItems drops trailing elements of an array, or of the items of a list
+// response, until the encoded value fits in maxBytes. It returns the encoded
+// value and the number of dropped elements.
+func fitItems(value any, maxBytes int) ([]byte, int, error) {
+	items, set := []any(nil), func([]any) any { return nil }
+	switch v := value.(type) {
+	case []any:
+		items, set = v, func(items []any) any { return items }
+	case map[string]any:
+		if list, ok := v[listItemsKey].([]any); ok {
+			items, set = list, func(items []any) any {
+				trimmed := make(map[string]any, len(v))
+				for key, field := range v {
+					trimmed[key] = field
+				}
+				trimmed[listItemsKey] = items
+				return trimmed
+			}
+		}
+	}
+
+	if items == nil {
+		b, err := marshalShaped(value)
+		return b, 0, err
+	}
+
+	// Binary search for the largest number of items that fits.
+	low, high := 0, len(items)
+	for low < high {
+		mid := (low + high + 1) / 2
+		b, err := marshalShaped(set(items[:mid]))
+		if err != nil {
+			return nil, 0, err
+		}
+		if len(b) <= maxBytes {
+			low = mid
+		} else {
+			high = mid - 1
+		}
+	}
+
+	b, err := marshalShaped(set(items[:low]))
+	return b, len(items) - low, err
+}
+
+// pathStep is a single step of a JSONPath expression.
+type pathStep struct {
+	name      string
+	index     int
+	isIndex   bool
+	wildcard  bool
+	recursive bool
+}
+
+// parseJSONPath parses the supported subset of JSONPath: $, .name,
+// ['name'], [n], [*], .* and ..name.
+func parseJSONPath(expression string) ([]pathStep, error) {
+	invalid := func(reason string) error {
+		return fmt.Errorf("invalid jsonPath %q: %s", expression, reason)
+	}
+
+	rest := strings.TrimSpace(expression)
+	rest = strings.TrimPrefix(rest, "$")
+
+	steps := []pathStep{}
+	for rest != "" {
+		step := pathStep{}
+		switch {
+		case strings.HasPrefix(rest, ".."):
+			step.recursive = true
+			rest = rest[2:]
+		case strings.HasPrefix(rest, "."):
+			rest = rest[1:]
+		case strings.HasPrefix(rest, "["):
+		default:
+			return nil, invalid(fmt.Sprintf("unexpected %q", rest))
+		}
+
+		switch {
+		case strings.HasPrefix(rest, "["):
+			end := strings.Index(rest, "]")
+			if end < 0 {
+				return nil, invalid("missing ]")
+			}
+			selector := strings.TrimSpace(rest[1:end])
+			rest = rest[end+1:]
+
+			switch {
+			case selector == "*":
+				step.wildcard = true
+			case len(selector) >= 2 && (selector[0] == '\'' || selector[0] == '"') && selector[len(selector)-1] == selector[0]:
+				step.name = selector[1 : len(selector)-1]
+			default:
+				index, err := strconv.Atoi(selector)
+				if err != nil {
+					return nil, invalid(fmt.Sprintf("unsupported selector [%s]", selector))
+				}
+				step.index, step.isIndex = index, true
+			}
+		case strings.HasPrefix(rest, "*"):
+			step.wildcard = true
+			rest = rest[1:]
+		default:
+			end := strings.IndexAny(rest, ".[")
+			if end < 0 {
+				end = len(rest)
+			}
+			step.name = rest[:end]
+			rest = rest[end:]
+			if step.name == "" {
+				return nil, invalid("missing field name")
+			}
+		}
+
+		steps = append(steps, step)
+	}
+
+	return steps, nil
+}
+
+// selectJSONPath returns the values matching the path. A path without
+// wildcards or recursive descent returns a single value, or nil when nothing
+// matches. Other paths return the array of all matches.
+func selectJSONPath(value any, steps []pathStep) any {
+	matches := []any{value}
+	definite := true
+
+	for _, step := range steps {
+		if step.wildcard || step.recursive {
+			definite = false
+		}
+
+		next := []any{}
+		for _, match := range matches {
+			candidates := []any{match}
+			if step.recursive {
+				candidates = descendants(match)
+			}
+			for _, candidate := range candidates {
+				next = append(next, selectStep(candidate, step)...)
+			}
+		}
+		matches = next
+	}
+
+	if definite {
+		if len(matches) == 0 {
+			return nil
+		}
+		return matches[0]
+	}
+	return matches
+}
+
+func selectStep(value any, step pathStep) []any {
+	switch v := value.(type) {
+	case map[string]any:
+		if step.wildcard {
+			matches := make([]any, 0, len(v))
+			for _, key := range slices.Sorted(maps.Keys(v)) {
+				matches = append(matches, v[key])
+			}
+			return matches
+		}
+		if field, ok := v[step.name]; ok && !step.isIndex {
+			return []any{field}
+		}
+	case []any:
+		if step.wildcard {
+			return v
+		}
+		if step.isIndex {
+			index := step.index
+			if index < 0 {
+				index += len(v)
+			}
+			if index >= 0 && index < len(v) {
+				return []any{v[index]}
+			}
+		}
+	}
+	return nil
+}
+
+// descendants returns value and all values nested in it in document order.
+func descendants(value any) []any {
+	all := []any{value}
+	switch v := value.(type) {
+	case map[string]any:
+		for _, key := range slices.Sorted(maps.Keys(v)) {
+			all = append(all, descendants(v[key])...)
+		}
+	case []any:
+		for _, element := range v {
+			all = append(all, descendants(element)...)
+		}
+	}
+	return all
+}
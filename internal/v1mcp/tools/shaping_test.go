@@ -0,0 +1,160 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+)
+
+const shapingAlerts = `{
+	"totalCount": 3,
+	"count": 3,
+	"items": [
+		{"id": "WB-1", "severity": "high", "impactScope": {"desktopCount": 1, "entities": [{"entityType": "host", "entityValue": "pc-1"}]}},
+		{"id": "WB-2", "severity": "low", "impactScope": {"desktopCount": 0, "entities": [{"entityType": "account", "entityValue": "adam"}]}},
+		{"id": "WB-3", "severity": "medium", "impactScope": {"desktopCount": 2, "entities": []}}
+	],
+	"nextLink": "https://api.xdr.trendmicro.com/v3.0/workbench/alerts?skipToken=1"
+}`
+
+func TestWithResponseShaping(t *testing.T) {
+	tool := WithResponseShaping(cannedTool("test_list", shapingAlerts))
+	require.Contains(t, tool.Tool.InputSchema.Properties, shapingFields)
+	require.Contains(t, tool.Tool.InputSchema.Properties, shapingJSONPath)
+	require.Contains(t, tool.Tool.InputSchema.Properties, shapingMaxBytes)
+
+	t.Run("should return the result unchanged without shaping arguments", func(t *testing.T) {
+		result := callTool(context.Background(), t, tool, map[string]any{})
+		require.Len(t, result.Content, 1)
+		require.Equal(t, shapingAlerts, result.Content[0].(mcp.TextContent).Text)
+		require.NotNil(t, result.StructuredContent)
+	})
+
+	t.Run("should project fields of list items", func(t *testing.T) {
+		result := callTool(context.Background(), t, tool, map[string]any{"fields": []any{"id", "impactScope.entities.entityValue"}})
+		require.Len(t, result.Content, 2)
+		require.JSONEq(t, `{
+			"totalCount": 3,
+			"count": 3,
+			"items": [
+				{"id": "WB-1", "impactScope": {"entities": [{"entityValue": "pc-1"}]}},
+				{"id": "WB-2", "impactScope": {"entities": [{"entityValue": "adam"}]}},
+				{"id": "WB-3", "impactScope": {"entities": []}}
+			],
+			"nextLink": "https://api.xdr.trendmicro.com/v3.0/workbench/alerts?skipToken=1"
+		}`, result.Content[0].(mcp.TextContent).Text)
+		require.Contains(t, result.Content[1].(mcp.TextContent).Text, "trimmed")
+		// The structured content still matches the output schema.
+		structured := map[string]any{}
+		require.NoError(t, json.Unmarshal([]byte(shapingAlerts), &structured))
+		require.Equal(t, structured, result.StructuredContent)
+	})
+
+	t.Run("should select values with a JSONPath expression", func(t *testing.T) {
+		result := callTool(context.Background(), t, tool, map[string]any{"jsonPath": "$.items[*].id"})
+		require.JSONEq(t, `["WB-1","WB-2","WB-3"]`, result.Content[0].(mcp.TextContent).Text)
+	})
+
+	t.Run("should drop trailing items to fit maxBytes", func(t *testing.T) {
+		result := callTool(context.Background(), t, tool, map[string]any{"fields": []any{"id"}, "maxBytes": float64(150)})
+		text := result.Content[0].(mcp.TextContent).Text
+		require.LessOrEqual(t, len(text), 150)
+
+		list := listResponse[map[string]any]{}
+		require.NoError(t, json.Unmarshal([]byte(text), &list))
+		require.NotEmpty(t, list.Items)
+		require.Less(t, len(list.Items), 3)
+		require.NotEmpty(t, list.NextLink)
+		require.Contains(t, result.Content[1].(mcp.TextContent).Text, "dropped the last")
+	})
+
+	t.Run("should return results that fit maxBytes unchanged", func(t *testing.T) {
+		text := `{"url":"https://a.example/?a=1&b=2","id":"x"}`
+		result := callTool(context.Background(), t, WithResponseShaping(cannedTool("test_list", text)), map[string]any{"maxBytes": float64(10000)})
+		require.Len(t, result.Content, 1)
+		require.Equal(t, text, result.Content[0].(mcp.TextContent).Text)
+		require.NotNil(t, result.StructuredContent)
+	})
+
+	t.Run("should not escape HTML characters", func(t *testing.T) {
+		result := callTool(context.Background(), t, WithResponseShaping(cannedTool("test_list", `{"url":"https://a.example/?a=1&b=2","id":"x"}`)), map[string]any{"fields": []any{"url"}})
+		require.Equal(t, `{"url":"https://a.example/?a=1&b=2"}`, result.Content[0].(mcp.TextContent).Text)
+	})
+
+	t.Run("should remove whitespace to fit maxBytes", func(t *testing.T) {
+		result := callTool(context.Background(), t, WithResponseShaping(cannedTool("test_list", `{ "id": "x" }`)), map[string]any{"maxBytes": float64(10)})
+		require.Equal(t, `{"id":"x"}`, result.Content[0].(mcp.TextContent).Text)
+		require.Contains(t, result.Content[1].(mcp.TextContent).Text, "by maxBytes removed the whitespace")
+	})
+
+	t.Run("should truncate text results", func(t *testing.T) {
+		result := callTool(context.Background(), t, WithResponseShaping(cannedTool("test_list", strings.Repeat("é", 10))), map[string]any{"maxBytes": float64(5)})
+		require.Equal(t, "éé", result.Content[0].(mcp.TextContent).Text)
+		require.Contains(t, result.Content[1].(mcp.TextContent).Text, "truncated")
+	})
+
+	t.Run("should reject an invalid JSONPath expression", func(t *testing.T) {
+		result := callTool(context.Background(), t, tool, map[string]any{"jsonPath": "$.items[abc]"})
+		require.True(t, result.IsError)
+	})
+}
+
+func TestWithResponseShapingOfFormattedResults(t *testing.T) {
+	tool := WithResponseShaping(formatTool("workbench_alerts_list", shapingAlerts))
+
+	t.Run("should limit a summary to maxBytes", func(t *testing.T) {
+		summary := callTool(context.Background(), t, tool, map[string]any{"format": "summary"})
+		require.Len(t, summary.Content, 1)
+		require.Greater(t, len(summary.Content[0].(mcp.TextContent).Text), 100)
+
+		result := callTool(context.Background(), t, tool, map[string]any{"format": "summary", "maxBytes": float64(100)})
+		require.False(t, result.IsError)
+		require.LessOrEqual(t, len(result.Content[0].(mcp.TextContent).Text), 100)
+		require.Contains(t, result.Content[1].(mcp.TextContent).Text, "trimmed")
+	})
+
+	t.Run("should use fields as the columns", func(t *testing.T) {
+		result := callTool(context.Background(), t, tool, map[string]any{"format": "csv", "fields": []any{"id"}, "maxBytes": float64(1000)})
+		require.Equal(t, "id\nWB-1\nWB-2\nWB-3\n", result.Content[0].(mcp.TextContent).Text)
+	})
+
+	t.Run("should reject jsonPath with other formats", func(t *testing.T) {
+		result := callTool(context.Background(), t, tool, map[string]any{"format": "markdown", "jsonPath": "$.items[*].id"})
+		require.True(t, result.IsError)
+	})
+}
+
+func TestSelectJSONPath(t *testing.T) {
+	var document any
+	require.NoError(t, json.Unmarshal([]byte(shapingAlerts), &document))
+
+	tests := map[string]string{
+		"$":                                   shapingAlerts,
+		"$.count":                             `3`,
+		"$.items[0].id":                       `"WB-1"`,
+		"$.items[-1].severity":                `"medium"`,
+		"$['items'][1]['id']":                 `"WB-2"`,
+		"$.items[5]":                          `null`,
+		"$.items[*].impactScope.desktopCount": `[1, 0, 2]`,
+		"$..entityValue":                      `["pc-1", "adam"]`,
+		"$.items[0].impactScope.*":            `[1, [{"entityType": "host", "entityValue": "pc-1"}]]`,
+	}
+
+	for expression, expected := range tests {
+		path, err := parseJSONPath(expression)
+		require.NoError(t, err, expression)
+
+		b, err := json.Marshal(selectJSONPath(document, path))
+		require.NoError(t, err)
+		require.JSONEq(t, expected, string(b), expression)
+	}
+
+	for _, expression := range []string{"$items", "$.items[", "$.items[?(@.id)]", "$.items."} {
+		_, err := parseJSONPath(expression)
+		require.Error(t, err, expression)
+	}
+}
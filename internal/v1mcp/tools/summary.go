@@ -0,0 +1,240 @@
+package tools
+
+import (
+	"cmp"
+	"encoding/json"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+)
+
+// summaryTopRecords is the number of records included in a summary.
+const summaryTopRecords = 10
+
+// summaryMaxGroups is the number of groups of an aggregate before the
+// remaining groups are counted as other.
+const summaryMaxGroups = 10
+
+// severityOrder orders severities and risk levels from the least severe.
+var severityOrder = []string{"low", "medium", "high", "critical"}
+
+// summaryNow is the time summaries are computed at.
+var summaryNow = time.Now
+
+// listSummarizer aggregates the items of a list page and picks the most
+// relevant records.
+type listSummarizer func(items []map[string]any) listSummary
+
+type listSummary struct {
+	Aggregates map[string]map[string]int `json:"aggregates"`
+	Top        []map[string]any          `json:"top"`
+}
+
+// listSummaryResult is returned instead of the list page when format is summary.
+type listSummaryResult struct {
+	TotalCount      int    `json:"totalCount,omitempty"`
+	NextLink        string `json:"nextLink,omitempty"`
+	SummarizedItems int    `json:"summarizedItems"`
+	listSummary
+}
+
+// listSummarizers are the summarizers of the list tools that support the
+// summary format.
+var listSummarizers = map[string]listSummarizer{
+	"workbench_alerts_list":                         summarizeAlerts,
+	"crem_attack_surface_devices_list":              summarizeDevices,
+	"crem_attack_surface_local_app_devices_list":    summarizeDevices,
+	"container_security_image_vulnerabilities_list": summarizeVulnerabilities,
+	"iam_api_keys_list":                             summarizeAPIKeys,
+}
+
+// summarizeList replaces the items of a list page by the summary of the items.
+func summarizeList(page listResponse[map[string]any], summarize listSummarizer) (string, error) {
+	b, err := json.Marshal(listSummaryResult{
+		TotalCount:      page.TotalCount,
+		NextLink:        page.NextLink,
+		SummarizedItems: len(page.Items),
+		listSummary:     summarize(page.Items),
+	})
+	return string(b), err
+}
+
+func summarizeAlerts(items []map[string]any) listSummary {
+	top := slices.SortedStableFunc(slices.Values(items), func(a, b map[string]any) int {
+		return cmp.Or(
+			cmp.Compare(rank(stringField(b, "severity"), severityOrder...), rank(stringField(a, "severity"), severityOrder...)),
+			cmp.Compare(numberField(b, "score"), numberField(a, "score")),
+		)
+	})
+
+	return listSummary{
+		Aggregates: map[string]map[string]int{
+			"bySeverity": countBy(items, "severity"),
+			"byModel":    countBy(items, "model"),
+			"byStatus":   countBy(items, "status"),
+		},
+		Top: topRecords(top, "id", "model", "severity", "score", "status", "incidentId", "createdDateTime"),
+	}
+}
+
+func summarizeDevices(items []map[string]any) listSummary {
+	top := slices.SortedStableFunc(slices.Values(items), func(a, b map[string]any) int {
+		return cmp.Compare(numberField(b, "latestRiskScore"), numberField(a, "latestRiskScore"))
+	})
+
+	return listSummary{
+		Aggregates: map[string]map[string]int{
+			"byRiskScore":   countByFunc(items, riskScoreBand),
+			"byCriticality": countBy(items, "criticality"),
+			"byOsPlatform":  countBy(items, "osPlatform"),
+		},
+		Top: topRecords(top, "id", "deviceName", "ip", "osName", "latestRiskScore", "criticality", "lastDetectedDateTime"),
+	}
+}
+
+// riskScoreBand returns the risk level of a Cyber Risk Exposure Management risk score.
+func riskScoreBand(item map[string]any) string {
+	score, ok := item["latestRiskScore"].(float64)
+	switch {
+	case !ok:
+		return "unknown"
+	case score >= 70:
+		return "high (70-100)"
+	case score >= 31:
+		return "medium (31-69)"
+	default:
+		return "low (0-30)"
+	}
+}
+
+func summarizeVulnerabilities(items []map[string]any) listSummary {
+	top := slices.SortedStableFunc(slices.Values(items), func(a, b map[string]any) int {
+		return cmp.Compare(rank(stringField(b, "riskLevel"), severityOrder...), rank(stringField(a, "riskLevel"), severityOrder...))
+	})
+
+	return listSummary{
+		Aggregates: map[string]map[string]int{
+			"byRiskLevel": countBy(items, "riskLevel"),
+			"byCve":       countBy(items, "name"),
+		},
+		Top: topRecords(top, "id", "name", "riskLevel", "clusterId", "imageId", "firstDetectedDateTime", "lastDetectedDateTime"),
+	}
+}
+
+func summarizeAPIKeys(items []map[string]any) listSummary {
+	now := summaryNow()
+
+	// Keys that expire first are the most relevant, keys without an
+	// expiration date come last.
+	top := slices.SortedStableFunc(slices.Values(items), func(a, b map[string]any) int {
+		ea, eb := stringField(a, "expiredDateTime"), stringField(b, "expiredDateTime")
+		switch {
+		case ea == eb:
+			return 0
+		case ea == "":
+			return 1
+		case eb == "":
+			return -1
+		}
+		return cmp.Compare(ea, eb)
+	})
+
+	return listSummary{
+		Aggregates: map[string]map[string]int{
+			"byStatus": countBy(items, "status"),
+			"byExpiry": countByFunc(items, func(item map[string]any) string {
+				return apiKeyExpiry(item, now)
+			}),
+		},
+		Top: topRecords(top, "id", "name", "role", "status", "expiredDateTime", "lastUsedDateTime"),
+	}
+}
+
+func apiKeyExpiry(item map[string]any, now time.Time) string {
+	value := stringField(item, "expiredDateTime")
+	if value == "" {
+		return "never"
+	}
+
+	expires, err := time.Parse(time.RFC3339, value)
+	switch {
+	case err != nil:
+		return "unknown"
+	case expires.Before(now):
+		return "expired"
+	case expires.Before(now.AddDate(0, 0, 30)):
+		return "within 30 days"
+	default:
+		return "after 30 days"
+	}
+}
+
+func stringField(item map[string]any, name string) string {
+	value, _ := item[name].(string)
+	return value
+}
+
+func numberField(item map[string]any, name string) float64 {
+	value, _ := item[name].(float64)
+	return value
+}
+
+// rank returns the position of value in the ascending order, or -1 when
+// the value is unknown.
+func rank(value string, order ...string) int {
+	return slices.IndexFunc(order, func(o string) bool {
+		return strings.EqualFold(o, value)
+	})
+}
+
+func countBy(items []map[string]any, field string) map[string]int {
+	return countByFunc(items, func(item map[string]any) string {
+		if value := stringField(item, field); value != "" {
+			return value
+		}
+		return "unknown"
+	})
+}
+
+// countByFunc counts the items of each group. Only the summaryMaxGroups
+// largest groups are kept, the items of the other groups are counted as other.
+func countByFunc(items []map[string]any, group func(map[string]any) string) map[string]int {
+	counts := map[string]int{}
+	for _, item := range items {
+		counts[group(item)]++
+	}
+
+	if len(counts) <= summaryMaxGroups {
+		return counts
+	}
+
+	groups := slices.SortedFunc(maps.Keys(counts), func(a, b string) int {
+		return cmp.Or(cmp.Compare(counts[b], counts[a]), cmp.Compare(a, b))
+	})
+
+	kept := make(map[string]int, summaryMaxGroups+1)
+	for i, g := range groups {
+		if i < summaryMaxGroups {
+			kept[g] = counts[g]
+		} else {
+			kept["other"] += counts[g]
+		}
+	}
+	return kept
+}
+
+// topRecords returns the given fields of the first summaryTopRecords items.
+func topRecords(items []map[string]any, fields ...string) []map[string]any {
+	top := make([]map[string]any, 0, min(len(items), summaryTopRecords))
+	for _, item := range items[:min(len(items), summaryTopRecords)] {
+		record := make(map[string]any, len(fields))
+		for _, field := range fields {
+			if value, ok := item[field]; ok {
+				record[field] = value
+			}
+		}
+		top = append(top, record)
+	}
+	return top
+}
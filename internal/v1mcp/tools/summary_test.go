@@ -0,0 +1,126 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	"github.com/stretchr/testify/require"
+)
+
+func summaryItems(t *testing.T, text string) []map[string]any {
+	t.Helper()
+	items := []map[string]any{}
+	require.NoError(t, json.Unmarshal([]byte(text), &items))
+	return items
+}
+
+func TestSummarizeAlerts(t *testing.T) {
+	summary := summarizeAlerts(summaryItems(t, `[
+		{"id": "WB-1", "severity": "low", "score": 10, "model": "Suspicious Login", "status": "Open", "impactScope": {}},
+		{"id": "WB-2", "severity": "critical", "score": 90, "model": "Ransomware Behavior", "status": "Open"},
+		{"id": "WB-3", "severity": "high", "score": 70, "model": "Suspicious Login", "status": "Closed"},
+		{"id": "WB-4", "severity": "critical", "score": 95, "model": "Ransomware Behavior", "status": "In Progress"}
+	]`))
+
+	require.Equal(t, map[string]int{"critical": 2, "high": 1, "low": 1}, summary.Aggregates["bySeverity"])
+	require.Equal(t, map[string]int{"Suspicious Login": 2, "Ransomware Behavior": 2}, summary.Aggregates["byModel"])
+
+	ids := []any{}
+	for _, record := range summary.Top {
+		ids = append(ids, record["id"])
+		require.NotContains(t, record, "impactScope")
+	}
+	require.Equal(t, []any{"WB-4", "WB-2", "WB-3", "WB-1"}, ids)
+}
+
+func TestSummarizeDevices(t *testing.T) {
+	summary := summarizeDevices(summaryItems(t, `[
+		{"id": "1", "deviceName": "a", "latestRiskScore": 85, "criticality": "high", "osPlatform": "Windows"},
+		{"id": "2", "deviceName": "b", "latestRiskScore": 31, "criticality": "low", "osPlatform": "Linux"},
+		{"id": "3", "deviceName": "c", "latestRiskScore": 12, "osPlatform": "Windows"},
+		{"id": "4", "deviceName": "d"}
+	]`))
+
+	require.Equal(t, map[string]int{"high (70-100)": 1, "medium (31-69)": 1, "low (0-30)": 1, "unknown": 1}, summary.Aggregates["byRiskScore"])
+	require.Equal(t, map[string]int{"high": 1, "low": 1, "unknown": 2}, summary.Aggregates["byCriticality"])
+	require.Equal(t, "1", summary.Top[0]["id"])
+}
+
+func TestSummarizeVulnerabilities(t *testing.T) {
+	summary := summarizeVulnerabilities(summaryItems(t, `[
+		{"id": "1", "name": "CVE-2023-0001", "riskLevel": "low"},
+		{"id": "2", "name": "CVE-2023-0002", "riskLevel": "high"},
+		{"id": "3", "name": "CVE-2023-0002", "riskLevel": "high"},
+		{"id": "4", "name": "CVE-2023-0003", "riskLevel": "medium"}
+	]`))
+
+	require.Equal(t, map[string]int{"CVE-2023-0001": 1, "CVE-2023-0002": 2, "CVE-2023-0003": 1}, summary.Aggregates["byCve"])
+	require.Equal(t, map[string]int{"low": 1, "medium": 1, "high": 2}, summary.Aggregates["byRiskLevel"])
+	require.Equal(t, "high", summary.Top[0]["riskLevel"])
+	require.Equal(t, "low", summary.Top[3]["riskLevel"])
+}
+
+func TestSummarizeAPIKeys(t *testing.T) {
+	summaryNow = func() time.Time { return time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC) }
+	defer func() { summaryNow = time.Now }()
+
+	summary := summarizeAPIKeys(summaryItems(t, `[
+		{"id": "never", "status": "enabled"},
+		{"id": "later", "status": "enabled", "expiredDateTime": "2025-01-01T00:00:00Z"},
+		{"id": "soon", "status": "enabled", "expiredDateTime": "2024-06-10T00:00:00Z"},
+		{"id": "expired", "status": "disabled", "expiredDateTime": "2024-01-01T00:00:00Z"}
+	]`))
+
+	require.Equal(t, map[string]int{"never": 1, "after 30 days": 1, "within 30 days": 1, "expired": 1}, summary.Aggregates["byExpiry"])
+	require.Equal(t, map[string]int{"enabled": 3, "disabled": 1}, summary.Aggregates["byStatus"])
+
+	ids := []any{}
+	for _, record := range summary.Top {
+		ids = append(ids, record["id"])
+	}
+	require.Equal(t, []any{"expired", "soon", "later", "never"}, ids)
+}
+
+func TestCountByFunc(t *testing.T) {
+	items := []map[string]any{}
+	for i := range summaryMaxGroups + 5 {
+		items = append(items, map[string]any{"model": string(rune('a' + i))})
+	}
+	items = append(items, map[string]any{"model": "a"})
+
+	counts := countBy(items, "model")
+	require.Len(t, counts, summaryMaxGroups+1)
+	require.Equal(t, 2, counts["a"])
+	require.Equal(t, 5, counts["other"])
+}
+
+func TestWithListFormatSummary(t *testing.T) {
+	page := `{"totalCount": 250, "items": [{"id": "WB-1", "severity": "high"}], "nextLink": "https://example.com/next"}`
+	tool := WithListFormat(mcpserver.ServerTool{
+		Tool: mcp.NewTool("workbench_alerts_list"),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return mcp.NewToolResultStructured(map[string]any{}, page), nil
+		},
+	})
+	require.Contains(t, tool.Tool.InputSchema.Properties, formatArgument)
+
+	result := callTool(context.Background(), t, tool, map[string]any{"format": "summary"})
+	require.Equal(t, map[string]any{}, result.StructuredContent)
+	require.JSONEq(t, `{
+		"totalCount": 250,
+		"nextLink": "https://example.com/next",
+		"summarizedItems": 1,
+		"aggregates": {"bySeverity": {"high": 1}, "byModel": {"unknown": 1}, "byStatus": {"unknown": 1}},
+		"top": [{"id": "WB-1", "severity": "high"}]
+	}`, result.Content[0].(mcp.TextContent).Text)
+
+	result = callTool(context.Background(), t, tool, map[string]any{})
+	require.Equal(t, page, result.Content[0].(mcp.TextContent).Text)
+
+	other := WithListFormat(mcpserver.ServerTool{Tool: mcp.NewTool("iam_accounts_list")})
+	require.NotContains(t, other.Tool.InputSchema.Properties, formatArgument)
+}
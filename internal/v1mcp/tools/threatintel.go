@@ -2,7 +2,12 @@ package tools
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
 	"net/http"
+	"strings"
 
 	"github.com/mark3labs/mcp-go/mcp"
 	mcpserver "github.com/mark3labs/mcp-go/server"
@@ -10,36 +15,19 @@ import (
 	"github.com/trendmicro/vision-one-mcp-server/internal/v1mcp/tooldescriptions"
 )
 
-var ToolsetsReadOnlyThreatIntel = []func(*v1client.V1ApiClient) mcpserver.ServerTool{
-	toolThreatIntelSuspiciousObjectsList,
-	toolThreatIntelExceptionsList,
-	toolThreatIntelIntelligenceReportsList,
-	toolThreatIntelIntelligenceReportGet,
-	toolThreatIntelTasksList,
-	toolThreatIntelTaskResultsGet,
-	toolThreatIntelFeedIndicatorsList,
-	toolThreatIntelFeedsList,
-	toolThreatIntelFeedFilterDefinitionGet,
-}
-
-var ToolsetsWriteThreatIntel = []func(*v1client.V1ApiClient) mcpserver.ServerTool{
-	toolThreatIntelSuspiciousObjectsAdd,
-	toolThreatIntelSuspiciousObjectsDelete,
-	toolThreatIntelExceptionsAdd,
-	toolThreatIntelExceptionsDelete,
-	toolThreatIntelIntelligenceReportsDelete,
-	toolThreatIntelSweepTrigger,
-}
-
 func toolThreatIntelSuspiciousObjectsList(client *v1client.V1ApiClient) mcpserver.ServerTool {
 	return mcpserver.ServerTool{
 		Tool: mcp.NewTool(
 			"threatintel_suspicious_objects_list",
 			mcp.WithDescription("Retrieves information about domains, file SHA-1, file SHA-256, IP addresses, email addresses, or URLs in the Suspicious Object List"),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
-				ReadOnlyHint: toPtr(true),
+				Title:           "List Suspicious Objects",
+				ReadOnlyHint:    toPtr(true),
+				DestructiveHint: toPtr(false),
+				IdempotentHint:  toPtr(true),
+				OpenWorldHint:   toPtr(false),
 			}),
-			mcp.WithString("filter", mcp.Description(tooldescriptions.FilterSuspiciousObjects)),
+			mcp.WithString("filter", mcp.Description(tooldescriptions.FilterSuspiciousObjects.Description())),
 			mcp.WithString("orderBy",
 				mcp.Description("The field by which the results are sorted"),
 				mcp.Enum(withOrdering(asc_desc, "riskLevel", "lastModifiedDateTime", "expiredDateTime")...),
@@ -50,6 +38,7 @@ func toolThreatIntelSuspiciousObjectsList(client *v1client.V1ApiClient) mcpserve
 			),
 			mcp.WithString("startDateTime", mcp.Description("The start of the data retrieval range in ISO 8601 format")),
 			mcp.WithString("endDateTime", mcp.Description("The end of the data retrieval range in ISO 8601 format")),
+			mcp.WithOutputSchema[listResponse[suspiciousObject]](),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			filter, err := optionalValue[string]("filter", request.GetArguments())
@@ -67,12 +56,12 @@ func toolThreatIntelSuspiciousObjectsList(client *v1client.V1ApiClient) mcpserve
 				return mcp.NewToolResultError(err.Error()), nil
 			}
 
-			startDateTime, err := optionalValue[string]("startDateTime", request.GetArguments())
+			startDateTime, err := optionalTimeValue("startDateTime", request.GetArguments())
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
 
-			endDateTime, err := optionalValue[string]("endDateTime", request.GetArguments())
+			endDateTime, err := optionalTimeValue("endDateTime", request.GetArguments())
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
@@ -96,7 +85,11 @@ func toolThreatIntelSuspiciousObjectsAdd(client *v1client.V1ApiClient) mcpserver
 			"threatintel_suspicious_objects_add",
 			mcp.WithDescription("Adds information about domains, file SHA-1, file SHA-256, IP addresses, email addresses, or URLs to the Suspicious Object List"),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
-				ReadOnlyHint: toPtr(false),
+				Title:           "Add Suspicious Object",
+				ReadOnlyHint:    toPtr(false),
+				DestructiveHint: toPtr(false),
+				IdempotentHint:  toPtr(true),
+				OpenWorldHint:   toPtr(false),
 			}),
 			mcp.WithString("type",
 				mcp.Required(),
@@ -121,6 +114,7 @@ func toolThreatIntelSuspiciousObjectsAdd(client *v1client.V1ApiClient) mcpserver
 			mcp.WithNumber("daysToExpiration",
 				mcp.Description("Number of days before the object expires from the list"),
 			),
+			mcp.WithOutputSchema[multiStatusResult](),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			objType, err := requiredValue[string]("type", request.GetArguments())
@@ -175,8 +169,9 @@ func toolThreatIntelSuspiciousObjectsAdd(client *v1client.V1ApiClient) mcpserver
 				obj.FileSha256 = value
 			}
 
-			resp, err := client.ThreatIntelAddSuspiciousObjects([]v1client.SuspiciousObject{obj})
-			return handleStatusResponse(resp, err, http.StatusMultiStatus, "failed to add suspicious object")
+			objects := []v1client.SuspiciousObject{obj}
+			resp, err := client.ThreatIntelAddSuspiciousObjects(objects)
+			return handleMultiStatusResponse(resp, err, multiStatusInputs(objects), "failed to add suspicious object")
 		},
 	}
 }
@@ -187,7 +182,11 @@ func toolThreatIntelSuspiciousObjectsDelete(client *v1client.V1ApiClient) mcpser
 			"threatintel_suspicious_objects_delete",
 			mcp.WithDescription("Deletes information about domains, file SHA-1, file SHA-256, IP addresses, email addresses, or URLs from the Suspicious Object List"),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
-				ReadOnlyHint: toPtr(false),
+				Title:           "Delete Suspicious Object",
+				ReadOnlyHint:    toPtr(false),
+				DestructiveHint: toPtr(true),
+				IdempotentHint:  toPtr(true),
+				OpenWorldHint:   toPtr(false),
 			}),
 			mcp.WithString("type",
 				mcp.Required(),
@@ -198,6 +197,7 @@ func toolThreatIntelSuspiciousObjectsDelete(client *v1client.V1ApiClient) mcpser
 				mcp.Required(),
 				mcp.Description("The value of the suspicious object to delete"),
 			),
+			mcp.WithOutputSchema[multiStatusResult](),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			objType, err := requiredValue[string]("type", request.GetArguments())
@@ -227,8 +227,9 @@ func toolThreatIntelSuspiciousObjectsDelete(client *v1client.V1ApiClient) mcpser
 				obj.FileSha256 = value
 			}
 
-			resp, err := client.ThreatIntelDeleteSuspiciousObjects([]v1client.SuspiciousObjectDelete{obj})
-			return handleStatusResponse(resp, err, http.StatusMultiStatus, "failed to delete suspicious object")
+			objects := []v1client.SuspiciousObjectDelete{obj}
+			resp, err := client.ThreatIntelDeleteSuspiciousObjects(objects)
+			return handleMultiStatusResponse(resp, err, multiStatusInputs(objects), "failed to delete suspicious object")
 		},
 	}
 }
@@ -239,9 +240,13 @@ func toolThreatIntelExceptionsList(client *v1client.V1ApiClient) mcpserver.Serve
 			"threatintel_exceptions_list",
 			mcp.WithDescription("Retrieves information about domains, file SHA-1, file SHA-256, IP addresses, sender addresses, or URLs in the Exception List"),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
-				ReadOnlyHint: toPtr(true),
+				Title:           "List Exceptions",
+				ReadOnlyHint:    toPtr(true),
+				DestructiveHint: toPtr(false),
+				IdempotentHint:  toPtr(true),
+				OpenWorldHint:   toPtr(false),
 			}),
-			mcp.WithString("filter", mcp.Description(tooldescriptions.FilterSuspiciousObjectExceptions)),
+			mcp.WithString("filter", mcp.Description(tooldescriptions.FilterSuspiciousObjectExceptions.Description())),
 			mcp.WithString("orderBy",
 				mcp.Description("The field by which the results are sorted"),
 				mcp.Enum(withOrdering(asc_desc, "lastModifiedDateTime")...),
@@ -252,6 +257,7 @@ func toolThreatIntelExceptionsList(client *v1client.V1ApiClient) mcpserver.Serve
 			),
 			mcp.WithString("startDateTime", mcp.Description("The start of the data retrieval range in ISO 8601 format")),
 			mcp.WithString("endDateTime", mcp.Description("The end of the data retrieval range in ISO 8601 format")),
+			mcp.WithOutputSchema[listResponse[suspiciousObjectException]](),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			filter, err := optionalValue[string]("filter", request.GetArguments())
@@ -269,12 +275,12 @@ func toolThreatIntelExceptionsList(client *v1client.V1ApiClient) mcpserver.Serve
 				return mcp.NewToolResultError(err.Error()), nil
 			}
 
-			startDateTime, err := optionalValue[string]("startDateTime", request.GetArguments())
+			startDateTime, err := optionalTimeValue("startDateTime", request.GetArguments())
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
 
-			endDateTime, err := optionalValue[string]("endDateTime", request.GetArguments())
+			endDateTime, err := optionalTimeValue("endDateTime", request.GetArguments())
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
@@ -298,7 +304,11 @@ func toolThreatIntelExceptionsAdd(client *v1client.V1ApiClient) mcpserver.Server
 			"threatintel_exceptions_add",
 			mcp.WithDescription("Adds domains, file SHA-1, file SHA-256, IP addresses, sender addresses, or URLs to the Exception List"),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
-				ReadOnlyHint: toPtr(false),
+				Title:           "Add Exception",
+				ReadOnlyHint:    toPtr(false),
+				DestructiveHint: toPtr(false),
+				IdempotentHint:  toPtr(true),
+				OpenWorldHint:   toPtr(false),
 			}),
 			mcp.WithString("type",
 				mcp.Required(),
@@ -312,6 +322,7 @@ func toolThreatIntelExceptionsAdd(client *v1client.V1ApiClient) mcpserver.Server
 			mcp.WithString("description",
 				mcp.Description("Brief description of the exception object"),
 			),
+			mcp.WithOutputSchema[multiStatusResult](),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			objType, err := requiredValue[string]("type", request.GetArguments())
@@ -348,8 +359,9 @@ func toolThreatIntelExceptionsAdd(client *v1client.V1ApiClient) mcpserver.Server
 				obj.FileSha256 = value
 			}
 
-			resp, err := client.ThreatIntelAddExceptions([]v1client.SuspiciousObjectException{obj})
-			return handleStatusResponse(resp, err, http.StatusMultiStatus, "failed to add exception object")
+			objects := []v1client.SuspiciousObjectException{obj}
+			resp, err := client.ThreatIntelAddExceptions(objects)
+			return handleMultiStatusResponse(resp, err, multiStatusInputs(objects), "failed to add exception object")
 		},
 	}
 }
@@ -360,7 +372,11 @@ func toolThreatIntelExceptionsDelete(client *v1client.V1ApiClient) mcpserver.Ser
 			"threatintel_exceptions_delete",
 			mcp.WithDescription("Deletes the specified objects from the Exception List"),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
-				ReadOnlyHint: toPtr(false),
+				Title:           "Delete Exception",
+				ReadOnlyHint:    toPtr(false),
+				DestructiveHint: toPtr(true),
+				IdempotentHint:  toPtr(true),
+				OpenWorldHint:   toPtr(false),
 			}),
 			mcp.WithString("type",
 				mcp.Required(),
@@ -371,6 +387,7 @@ func toolThreatIntelExceptionsDelete(client *v1client.V1ApiClient) mcpserver.Ser
 				mcp.Required(),
 				mcp.Description("The value of the exception object to delete"),
 			),
+			mcp.WithOutputSchema[multiStatusResult](),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			objType, err := requiredValue[string]("type", request.GetArguments())
@@ -400,8 +417,9 @@ func toolThreatIntelExceptionsDelete(client *v1client.V1ApiClient) mcpserver.Ser
 				obj.FileSha256 = value
 			}
 
-			resp, err := client.ThreatIntelDeleteExceptions([]v1client.SuspiciousObjectDelete{obj})
-			return handleStatusResponse(resp, err, http.StatusMultiStatus, "failed to delete exception object")
+			objects := []v1client.SuspiciousObjectDelete{obj}
+			resp, err := client.ThreatIntelDeleteExceptions(objects)
+			return handleMultiStatusResponse(resp, err, multiStatusInputs(objects), "failed to delete exception object")
 		},
 	}
 }
@@ -412,9 +430,13 @@ func toolThreatIntelIntelligenceReportsList(client *v1client.V1ApiClient) mcpser
 			"threatintel_intelligence_reports_list",
 			mcp.WithDescription("Retrieves a list of custom intelligence reports created from imported or retrieved data"),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
-				ReadOnlyHint: toPtr(true),
+				Title:           "List Intelligence Reports",
+				ReadOnlyHint:    toPtr(true),
+				DestructiveHint: toPtr(false),
+				IdempotentHint:  toPtr(true),
+				OpenWorldHint:   toPtr(false),
 			}),
-			mcp.WithString("filter", mcp.Description(tooldescriptions.FilterIntelligenceReports)),
+			mcp.WithString("filter", mcp.Description(tooldescriptions.FilterIntelligenceReports.Description())),
 			mcp.WithString("orderBy",
 				mcp.Description("The field by which the results are sorted"),
 				mcp.Enum(withOrdering(asc_desc, "updatedDateTime", "createdDateTime")...),
@@ -425,6 +447,7 @@ func toolThreatIntelIntelligenceReportsList(client *v1client.V1ApiClient) mcpser
 			),
 			mcp.WithString("startDateTime", mcp.Description("The start of the data retrieval range in ISO 8601 format")),
 			mcp.WithString("endDateTime", mcp.Description("The end of the data retrieval range in ISO 8601 format")),
+			mcp.WithOutputSchema[listResponse[intelligenceReport]](),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			filter, err := optionalValue[string]("filter", request.GetArguments())
@@ -442,12 +465,12 @@ func toolThreatIntelIntelligenceReportsList(client *v1client.V1ApiClient) mcpser
 				return mcp.NewToolResultError(err.Error()), nil
 			}
 
-			startDateTime, err := optionalValue[string]("startDateTime", request.GetArguments())
+			startDateTime, err := optionalTimeValue("startDateTime", request.GetArguments())
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
 
-			endDateTime, err := optionalValue[string]("endDateTime", request.GetArguments())
+			endDateTime, err := optionalTimeValue("endDateTime", request.GetArguments())
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
@@ -472,12 +495,17 @@ func toolThreatIntelIntelligenceReportGet(client *v1client.V1ApiClient) mcpserve
 			"threatintel_intelligence_report_get",
 			mcp.WithDescription("Downloads a custom intelligence report as a STIX Bundle"),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
-				ReadOnlyHint: toPtr(true),
+				Title:           "Get Intelligence Report",
+				ReadOnlyHint:    toPtr(true),
+				DestructiveHint: toPtr(false),
+				IdempotentHint:  toPtr(true),
+				OpenWorldHint:   toPtr(false),
 			}),
 			mcp.WithString("reportId",
 				mcp.Required(),
 				mcp.Description("The unique identifier of the intelligence report"),
 			),
+			mcp.WithOutputSchema[stixBundle](),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			reportId, err := requiredValue[string]("reportId", request.GetArguments())
@@ -497,13 +525,18 @@ func toolThreatIntelIntelligenceReportsDelete(client *v1client.V1ApiClient) mcps
 			"threatintel_intelligence_reports_delete",
 			mcp.WithDescription("Deletes the specified custom intelligence reports"),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
-				ReadOnlyHint: toPtr(false),
+				Title:           "Delete Intelligence Reports",
+				ReadOnlyHint:    toPtr(false),
+				DestructiveHint: toPtr(true),
+				IdempotentHint:  toPtr(true),
+				OpenWorldHint:   toPtr(false),
 			}),
 			mcp.WithArray("reportIds",
 				mcp.Required(),
 				mcp.Description("Array of intelligence report IDs to delete"),
 				mcp.Items(map[string]any{"type": "string"}),
 			),
+			mcp.WithOutputSchema[multiStatusResult](),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			reportIds := []string{}
@@ -518,7 +551,7 @@ func toolThreatIntelIntelligenceReportsDelete(client *v1client.V1ApiClient) mcps
 			}
 
 			resp, err := client.ThreatIntelDeleteIntelligenceReports(reportIds)
-			return handleStatusResponse(resp, err, http.StatusMultiStatus, "failed to delete intelligence reports")
+			return handleMultiStatusResponse(resp, err, multiStatusInputs(reportIds), "failed to delete intelligence reports")
 		},
 	}
 }
@@ -529,7 +562,11 @@ func toolThreatIntelSweepTrigger(client *v1client.V1ApiClient) mcpserver.ServerT
 			"threatintel_sweep_trigger",
 			mcp.WithDescription("Searches your environment for threat indicators specified in a custom intelligence report"),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
-				ReadOnlyHint: toPtr(false),
+				Title:           "Trigger Threat Sweep",
+				ReadOnlyHint:    toPtr(false),
+				DestructiveHint: toPtr(false),
+				IdempotentHint:  toPtr(false),
+				OpenWorldHint:   toPtr(false),
 			}),
 			mcp.WithString("reportId",
 				mcp.Required(),
@@ -543,6 +580,7 @@ func toolThreatIntelSweepTrigger(client *v1client.V1ApiClient) mcpserver.ServerT
 			mcp.WithString("description",
 				mcp.Description("Brief description of the sweep task"),
 			),
+			mcp.WithOutputSchema[multiStatusResult](),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			reportId, err := requiredValue[string]("reportId", request.GetArguments())
@@ -566,8 +604,9 @@ func toolThreatIntelSweepTrigger(client *v1client.V1ApiClient) mcpserver.ServerT
 				Description: description,
 			}
 
-			resp, err := client.ThreatIntelTriggerSweep([]v1client.IntelligenceReportSweep{sweep})
-			return handleStatusResponse(resp, err, http.StatusMultiStatus, "failed to trigger sweep")
+			sweeps := []v1client.IntelligenceReportSweep{sweep}
+			resp, err := client.ThreatIntelTriggerSweep(sweeps)
+			return handleMultiStatusResponse(resp, err, multiStatusInputs(sweeps), "failed to trigger sweep")
 		},
 	}
 }
@@ -578,9 +617,13 @@ func toolThreatIntelTasksList(client *v1client.V1ApiClient) mcpserver.ServerTool
 			"threatintel_tasks_list",
 			mcp.WithDescription("Displays information about threat intelligence tasks and asynchronous jobs"),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
-				ReadOnlyHint: toPtr(true),
+				Title:           "List Threat Intelligence Tasks",
+				ReadOnlyHint:    toPtr(true),
+				DestructiveHint: toPtr(false),
+				IdempotentHint:  toPtr(true),
+				OpenWorldHint:   toPtr(false),
 			}),
-			mcp.WithString("filter", mcp.Description(tooldescriptions.FilterThreatIntelTasks)),
+			mcp.WithString("filter", mcp.Description(tooldescriptions.FilterThreatIntelTasks.Description())),
 			mcp.WithString("orderBy",
 				mcp.Description("The field by which the results are sorted"),
 				mcp.Enum(withOrdering(asc_desc, "lastActionDateTime")...),
@@ -591,6 +634,7 @@ func toolThreatIntelTasksList(client *v1client.V1ApiClient) mcpserver.ServerTool
 			),
 			mcp.WithString("startDateTime", mcp.Description("The start of the data retrieval range in ISO 8601 format")),
 			mcp.WithString("endDateTime", mcp.Description("The end of the data retrieval range in ISO 8601 format")),
+			mcp.WithOutputSchema[listResponse[threatIntelTask]](),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			filter, err := optionalValue[string]("filter", request.GetArguments())
@@ -608,12 +652,12 @@ func toolThreatIntelTasksList(client *v1client.V1ApiClient) mcpserver.ServerTool
 				return mcp.NewToolResultError(err.Error()), nil
 			}
 
-			startDateTime, err := optionalValue[string]("startDateTime", request.GetArguments())
+			startDateTime, err := optionalTimeValue("startDateTime", request.GetArguments())
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
 
-			endDateTime, err := optionalValue[string]("endDateTime", request.GetArguments())
+			endDateTime, err := optionalTimeValue("endDateTime", request.GetArguments())
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
@@ -638,12 +682,17 @@ func toolThreatIntelTaskResultsGet(client *v1client.V1ApiClient) mcpserver.Serve
 			"threatintel_task_results_get",
 			mcp.WithDescription("Retrieves the results of a threat intelligence task"),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
-				ReadOnlyHint: toPtr(true),
+				Title:           "Get Threat Intelligence Task Results",
+				ReadOnlyHint:    toPtr(true),
+				DestructiveHint: toPtr(false),
+				IdempotentHint:  toPtr(true),
+				OpenWorldHint:   toPtr(false),
 			}),
 			mcp.WithString("taskId",
 				mcp.Required(),
 				mcp.Description("The unique identifier of the task"),
 			),
+			mcp.WithOutputSchema[threatIntelTask](),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			taskId, err := requiredValue[string]("taskId", request.GetArguments())
@@ -663,7 +712,11 @@ func toolThreatIntelFeedIndicatorsList(client *v1client.V1ApiClient) mcpserver.S
 			"threatintel_feed_indicators_list",
 			mcp.WithDescription("Retrieves a list of IoCs from Trend Threat Intelligence Feed"),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
-				ReadOnlyHint: toPtr(true),
+				Title:           "List Threat Intelligence Feed Indicators",
+				ReadOnlyHint:    toPtr(true),
+				DestructiveHint: toPtr(false),
+				IdempotentHint:  toPtr(true),
+				OpenWorldHint:   toPtr(true),
 			}),
 			mcp.WithString("startDateTime", mcp.Description("The start of the data retrieval range in ISO 8601 format")),
 			mcp.WithString("endDateTime", mcp.Description("The end of the data retrieval range in ISO 8601 format")),
@@ -675,14 +728,15 @@ func toolThreatIntelFeedIndicatorsList(client *v1client.V1ApiClient) mcpserver.S
 				mcp.Description("The desired format for the query response"),
 				mcp.Enum("stixBundle", "taxiiEnvelope"),
 			),
+			mcp.WithOutputSchema[feedResponse](),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-			startDateTime, err := optionalValue[string]("startDateTime", request.GetArguments())
+			startDateTime, err := optionalTimeValue("startDateTime", request.GetArguments())
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
 
-			endDateTime, err := optionalValue[string]("endDateTime", request.GetArguments())
+			endDateTime, err := optionalTimeValue("endDateTime", request.GetArguments())
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
@@ -716,9 +770,13 @@ func toolThreatIntelFeedsList(client *v1client.V1ApiClient) mcpserver.ServerTool
 			"threatintel_feeds_list",
 			mcp.WithDescription("Retrieves a list of intelligence reports from the Trend Threat Intelligence Feed with associated objects and relationships"),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
-				ReadOnlyHint: toPtr(true),
+				Title:           "List Threat Intelligence Feeds",
+				ReadOnlyHint:    toPtr(true),
+				DestructiveHint: toPtr(false),
+				IdempotentHint:  toPtr(true),
+				OpenWorldHint:   toPtr(true),
 			}),
-			mcp.WithString("contextualFilter", mcp.Description(tooldescriptions.FilterThreatIntelFeeds)),
+			mcp.WithString("contextualFilter", mcp.Description(tooldescriptions.FilterThreatIntelFeeds.Description())),
 			mcp.WithString("startDateTime", mcp.Description("The start of the data retrieval range in ISO 8601 format")),
 			mcp.WithString("endDateTime", mcp.Description("The end of the data retrieval range in ISO 8601 format")),
 			mcp.WithString("topReport",
@@ -729,6 +787,7 @@ func toolThreatIntelFeedsList(client *v1client.V1ApiClient) mcpserver.ServerTool
 				mcp.Description("The preferred format for the query response"),
 				mcp.Enum("stixBundle", "taxiiEnvelope"),
 			),
+			mcp.WithOutputSchema[feedResponse](),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			contextualFilter, err := optionalValue[string]("contextualFilter", request.GetArguments())
@@ -736,12 +795,12 @@ func toolThreatIntelFeedsList(client *v1client.V1ApiClient) mcpserver.ServerTool
 				return mcp.NewToolResultError(err.Error()), nil
 			}
 
-			startDateTime, err := optionalValue[string]("startDateTime", request.GetArguments())
+			startDateTime, err := optionalTimeValue("startDateTime", request.GetArguments())
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
 
-			endDateTime, err := optionalValue[string]("endDateTime", request.GetArguments())
+			endDateTime, err := optionalTimeValue("endDateTime", request.GetArguments())
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
@@ -775,8 +834,13 @@ func toolThreatIntelFeedFilterDefinitionGet(client *v1client.V1ApiClient) mcpser
 			"threatintel_feed_filter_definition_get",
 			mcp.WithDescription("Retrieves supported filter keys and values for Trend Threat Intelligence Feed queries"),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
-				ReadOnlyHint: toPtr(true),
+				Title:           "Get Threat Intelligence Feed Filter Definition",
+				ReadOnlyHint:    toPtr(true),
+				DestructiveHint: toPtr(false),
+				IdempotentHint:  toPtr(true),
+				OpenWorldHint:   toPtr(true),
 			}),
+			mcp.WithOutputSchema[feedFilterDefinition](),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			resp, err := client.ThreatIntelGetFeedFilterDefinition()
@@ -784,3 +848,468 @@ func toolThreatIntelFeedFilterDefinitionGet(client *v1client.V1ApiClient) mcpser
 		},
 	}
 }
+
+// threatIntelBatchSize is the maximum number of objects accepted by a single
+// suspicious object or exception list request.
+const threatIntelBatchSize = 1000
+
+// threatIntelFilterMaxLength is the maximum length of a threat intel filter.
+const threatIntelFilterMaxLength = 4000
+
+// bulkImportItem is the outcome of importing a single indicator.
+type bulkImportItem struct {
+	Type   string            `json:"type,omitempty"`
+	Value  string            `json:"value"`
+	Result string            `json:"result"`
+	Status int               `json:"status,omitempty"`
+	Error  *multiStatusError `json:"error,omitempty"`
+	Reason string            `json:"reason,omitempty"`
+}
+
+type bulkImportResult struct {
+	Submitted int              `json:"submitted"`
+	Succeeded int              `json:"succeeded"`
+	Failed    int              `json:"failed"`
+	Skipped   int              `json:"skipped"`
+	Invalid   int              `json:"invalid"`
+	Items     []bulkImportItem `json:"items"`
+}
+
+func (r *bulkImportResult) add(item bulkImportItem) {
+	switch item.Result {
+	case "added":
+		r.Submitted++
+		r.Succeeded++
+	case "failed":
+		r.Submitted++
+		r.Failed++
+	case "skipped":
+		r.Skipped++
+	case "invalid":
+		r.Invalid++
+	}
+	r.Items = append(r.Items, item)
+}
+
+func bulkImportToolOptions(objectName string) []mcp.ToolOption {
+	return []mcp.ToolOption{
+		mcp.WithArray("values",
+			mcp.Description(fmt.Sprintf("Indicators to add to the %s. The type of each value (IP, domain, URL, SHA-1, SHA-256, email address) is detected automatically", objectName)),
+			mcp.Items(map[string]any{"type": "string"}),
+		),
+		mcp.WithArray("objects",
+			mcp.Description("Indicators with an explicit type and optional per-object settings"),
+			mcp.Items(map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"type": map[string]any{
+						"type": "string",
+						"enum": indicatorTypes,
+					},
+					"value":            map[string]any{"type": "string"},
+					"description":      map[string]any{"type": "string"},
+					"scanAction":       map[string]any{"type": "string", "enum": []string{"block", "log"}},
+					"riskLevel":        map[string]any{"type": "string", "enum": []string{"high", "medium", "low"}},
+					"daysToExpiration": map[string]any{"type": "number"},
+				},
+				"required": []string{"value"},
+			}),
+		),
+		mcp.WithString("text",
+			mcp.Description("A list of indicators separated by new lines. Lines starting with # are ignored and defanged values such as hxxp://example[.]com are accepted"),
+		),
+		mcp.WithString("stixBundle",
+			mcp.Description("A STIX 2.1 bundle in JSON format. Indicators are read from indicator patterns and cyber observable objects"),
+		),
+		mcp.WithString("filePath",
+			mcp.Description("Name of a file in the import directory of the server containing indicators as CSV, a STIX 2.1 bundle (.json) or a plain list. Only available when the server was started with -import-dir. A CSV file with a header row may contain the columns type, value, description, scanAction, riskLevel and daysToExpiration"),
+		),
+		mcp.WithString("description",
+			mcp.Description(fmt.Sprintf("Default description applied to objects without their own description (added to the %s)", objectName)),
+		),
+		mcp.WithBoolean("skipExisting",
+			mcp.Description(fmt.Sprintf("Skip indicators that are already in the %s. Default true", objectName)),
+		),
+		mcp.WithOutputSchema[bulkImportResult](),
+	}
+}
+
+// collectIndicators gathers the indicators of a bulk import from every supported source.
+// Values that cannot be parsed are reported as invalid items.
+func collectIndicators(ctx context.Context, args map[string]any) ([]indicator, []bulkImportItem, error) {
+	indicators := []indicator{}
+	invalid := []bulkImportItem{}
+
+	addInvalid := func(errs ...error) {
+		for _, err := range errs {
+			invalid = append(invalid, bulkImportItem{Result: "invalid", Reason: err.Error()})
+		}
+	}
+
+	values := []string{}
+	if err := optionalJSONValue("values", args, &values); err != nil {
+		return nil, nil, err
+	}
+
+	text, err := optionalValue[string]("text", args)
+	if err != nil {
+		return nil, nil, err
+	}
+	values = append(values, parseIndicatorList(text)...)
+
+	for _, v := range values {
+		ind, err := newIndicator("", v)
+		if err != nil {
+			addInvalid(err)
+			continue
+		}
+		indicators = append(indicators, ind)
+	}
+
+	objects := []indicator{}
+	if err := optionalJSONValue("objects", args, &objects); err != nil {
+		return nil, nil, err
+	}
+	for _, obj := range objects {
+		ind, err := newIndicator(obj.Type, obj.Value)
+		if err != nil {
+			addInvalid(err)
+			continue
+		}
+		ind.Description = obj.Description
+		ind.ScanAction = obj.ScanAction
+		ind.RiskLevel = obj.RiskLevel
+		ind.DaysToExpiration = obj.DaysToExpiration
+		indicators = append(indicators, ind)
+	}
+
+	stixBundle, err := optionalValue[string]("stixBundle", args)
+	if err != nil {
+		return nil, nil, err
+	}
+	if stixBundle != "" {
+		stixIndicators, errs := parseSTIXBundle([]byte(stixBundle))
+		indicators = append(indicators, stixIndicators...)
+		addInvalid(errs...)
+	}
+
+	filePath, err := optionalValue[string]("filePath", args)
+	if err != nil {
+		return nil, nil, err
+	}
+	if filePath != "" {
+		dir := importDir(ctx)
+		if dir == "" {
+			return nil, nil, errors.New("filePath requires the server to be started with an import directory (-import-dir)")
+		}
+		fileIndicators, errs := readIndicatorFile(dir, filePath)
+		indicators = append(indicators, fileIndicators...)
+		addInvalid(errs...)
+	}
+
+	if len(indicators) == 0 && len(invalid) == 0 {
+		return nil, nil, errors.New("one of 'values', 'objects', 'text', 'stixBundle' or 'filePath' must be provided")
+	}
+
+	return indicators, invalid, nil
+}
+
+// existingIndicatorKeys looks up which indicators are already present in a list.
+// The lookup is split into filters that fit the filter length and page size limits.
+func existingIndicatorKeys(
+	list func(filter string, qp v1client.ThreatIntelQueryParameters) (*http.Response, error),
+	indicators []indicator,
+) (map[string]bool, error) {
+	existing := map[string]bool{}
+
+	lookup := func(clauses []string) error {
+		resp, err := list(strings.Join(clauses, " or "), v1client.ThreatIntelQueryParameters{Top: 200})
+		if err != nil {
+			return err
+		}
+
+		defer func() {
+			_ = resp.Body.Close()
+		}()
+
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return err
+		}
+
+		if resp.StatusCode != http.StatusOK {
+			return fmt.Errorf("failed to look up existing objects: %s", string(body))
+		}
+
+		page := struct {
+			Items []map[string]any `json:"items"`
+		}{}
+		if err := json.Unmarshal(body, &page); err != nil {
+			return fmt.Errorf("failed to look up existing objects: %w", err)
+		}
+
+		for _, item := range page.Items {
+			t, _ := item["type"].(string)
+			v, _ := item[t].(string)
+			existing[indicator{Type: t, Value: v}.key()] = true
+		}
+		return nil
+	}
+
+	clauses := []string{}
+	length := 0
+	for _, ind := range indicators {
+		clause := fmt.Sprintf("%s eq '%s'", ind.Type, escapeFilterValue(ind.Value))
+
+		if len(clauses) > 0 && (len(clauses) == 200 || length+len(clause)+len(" or ") > threatIntelFilterMaxLength) {
+			if err := lookup(clauses); err != nil {
+				return nil, err
+			}
+			clauses = []string{}
+			length = 0
+		}
+
+		clauses = append(clauses, clause)
+		length += len(clause) + len(" or ")
+	}
+
+	if len(clauses) > 0 {
+		if err := lookup(clauses); err != nil {
+			return nil, err
+		}
+	}
+
+	return existing, nil
+}
+
+// bulkImport dedupes indicators, submits them in batches and maps each
+// sub-response of the 207 Multi-Status responses back to its indicator.
+func bulkImport(
+	ctx context.Context,
+	args map[string]any,
+	list func(filter string, qp v1client.ThreatIntelQueryParameters) (*http.Response, error),
+	submit func(batch []indicator) (*http.Response, error),
+	applyDefaults func(ind *indicator),
+) (*mcp.CallToolResult, error) {
+	indicators, invalid, err := collectIndicators(ctx, args)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	skipExisting, err := optionalPointerValue[bool]("skipExisting", args)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	for i := range indicators {
+		applyDefaults(&indicators[i])
+	}
+
+	result := bulkImportResult{Items: []bulkImportItem{}}
+	for _, item := range invalid {
+		result.add(item)
+	}
+
+	unique, duplicates := dedupeIndicators(indicators)
+	for _, ind := range duplicates {
+		result.add(bulkImportItem{Type: ind.Type, Value: ind.Value, Result: "skipped", Reason: "duplicate in input"})
+	}
+
+	if skipExisting == nil || *skipExisting {
+		existing, err := existingIndicatorKeys(list, unique)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		toSubmit := []indicator{}
+		for _, ind := range unique {
+			if existing[ind.key()] {
+				result.add(bulkImportItem{Type: ind.Type, Value: ind.Value, Result: "skipped", Reason: "already in list"})
+				continue
+			}
+			toSubmit = append(toSubmit, ind)
+		}
+		unique = toSubmit
+	}
+
+	for start := 0; start < len(unique); start += threatIntelBatchSize {
+		batch := unique[start:min(start+threatIntelBatchSize, len(unique))]
+
+		failBatch := func(reason string) {
+			for _, ind := range batch {
+				result.add(bulkImportItem{Type: ind.Type, Value: ind.Value, Result: "failed", Reason: reason})
+			}
+		}
+
+		resp, err := submit(batch)
+		if err != nil {
+			failBatch(err.Error())
+			continue
+		}
+
+		body, err := io.ReadAll(resp.Body)
+		_ = resp.Body.Close()
+		if err != nil {
+			failBatch(err.Error())
+			continue
+		}
+
+		if resp.StatusCode != http.StatusMultiStatus {
+			failBatch(fmt.Sprintf("unexpected status %d: %s", resp.StatusCode, string(body)))
+			continue
+		}
+
+		responses, err := parseMultiStatus(body)
+		if err != nil {
+			failBatch(err.Error())
+			continue
+		}
+
+		for i, ind := range batch {
+			item := bulkImportItem{Type: ind.Type, Value: ind.Value}
+			switch {
+			case i >= len(responses):
+				item.Result = "failed"
+				item.Reason = "no response for this object"
+			case responses[i].succeeded():
+				item.Result = "added"
+				item.Status = responses[i].Status
+			default:
+				item.Result = "failed"
+				item.Status = responses[i].Status
+				item.Error = responses[i].error()
+			}
+			result.add(item)
+		}
+	}
+
+	b, err := json.Marshal(result)
+	if err != nil {
+		return nil, err
+	}
+
+	toolResult := mcp.NewToolResultStructured(result, string(b))
+	toolResult.IsError = result.Failed > 0
+	return toolResult, nil
+}
+
+func toolThreatIntelSuspiciousObjectsBulkAdd(client *v1client.V1ApiClient) mcpserver.ServerTool {
+	options := []mcp.ToolOption{
+		mcp.WithDescription("Adds many domains, file SHA-1, file SHA-256, IP addresses, email addresses, or URLs to the Suspicious Object List from arrays, a plain list, a STIX 2.1 bundle or a CSV file of the import directory. Returns the result of each object"),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:           "Bulk Add Suspicious Objects",
+			ReadOnlyHint:    toPtr(false),
+			DestructiveHint: toPtr(false),
+			IdempotentHint:  toPtr(true),
+			OpenWorldHint:   toPtr(false),
+		}),
+	}
+	options = append(options, bulkImportToolOptions("Suspicious Object List")...)
+	options = append(options,
+		mcp.WithString("scanAction",
+			mcp.Description("Default action that connected products apply after detecting a suspicious object"),
+			mcp.Enum("block", "log"),
+		),
+		mcp.WithString("riskLevel",
+			mcp.Description("Default risk level of the suspicious objects"),
+			mcp.Enum("high", "medium", "low"),
+		),
+		mcp.WithNumber("daysToExpiration",
+			mcp.Description("Default number of days before the objects expire from the list"),
+		),
+	)
+
+	return mcpserver.ServerTool{
+		Tool: mcp.NewTool("threatintel_suspicious_objects_bulk_add", options...),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			description, err := optionalValue[string]("description", request.GetArguments())
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			scanAction, err := optionalValue[string]("scanAction", request.GetArguments())
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			riskLevel, err := optionalValue[string]("riskLevel", request.GetArguments())
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			daysToExpiration, err := optionalIntValue("daysToExpiration", request.GetArguments())
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			return bulkImport(
+				ctx,
+				request.GetArguments(),
+				client.ThreatIntelListSuspiciousObjects,
+				func(batch []indicator) (*http.Response, error) {
+					objects := make([]v1client.SuspiciousObject, 0, len(batch))
+					for _, ind := range batch {
+						objects = append(objects, ind.suspiciousObject())
+					}
+					return client.ThreatIntelAddSuspiciousObjects(objects)
+				},
+				func(ind *indicator) {
+					if ind.Description == "" {
+						ind.Description = description
+					}
+					if ind.ScanAction == "" {
+						ind.ScanAction = scanAction
+					}
+					if ind.RiskLevel == "" {
+						ind.RiskLevel = riskLevel
+					}
+					if ind.DaysToExpiration == 0 {
+						ind.DaysToExpiration = daysToExpiration
+					}
+				},
+			)
+		},
+	}
+}
+
+func toolThreatIntelExceptionsBulkAdd(client *v1client.V1ApiClient) mcpserver.ServerTool {
+	options := []mcp.ToolOption{
+		mcp.WithDescription("Adds many domains, file SHA-1, file SHA-256, IP addresses, sender addresses, or URLs to the Exception List from arrays, a plain list, a STIX 2.1 bundle or a CSV file of the import directory. Returns the result of each object"),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:           "Bulk Add Exceptions",
+			ReadOnlyHint:    toPtr(false),
+			DestructiveHint: toPtr(false),
+			IdempotentHint:  toPtr(true),
+			OpenWorldHint:   toPtr(false),
+		}),
+	}
+	options = append(options, bulkImportToolOptions("Exception List")...)
+
+	return mcpserver.ServerTool{
+		Tool: mcp.NewTool("threatintel_exceptions_bulk_add", options...),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			description, err := optionalValue[string]("description", request.GetArguments())
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			return bulkImport(
+				ctx,
+				request.GetArguments(),
+				client.ThreatIntelListExceptions,
+				func(batch []indicator) (*http.Response, error) {
+					objects := make([]v1client.SuspiciousObjectException, 0, len(batch))
+					for _, ind := range batch {
+						objects = append(objects, ind.exception())
+					}
+					return client.ThreatIntelAddExceptions(objects)
+				},
+				func(ind *indicator) {
+					if ind.Description == "" {
+						ind.Description = description
+					}
+				},
+			)
+		},
+	}
+}
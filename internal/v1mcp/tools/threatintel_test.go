@@ -0,0 +1,35 @@
+package tools
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	"github.com/stretchr/testify/require"
+	"github.com/trendmicro/vision-one-mcp-server/internal/v1client"
+)
+
+func TestThreatIntelToolsRejectMalformedTimes(t *testing.T) {
+	for _, newTool := range []func(*v1client.V1ApiClient) mcpserver.ServerTool{
+		toolThreatIntelSuspiciousObjectsList,
+		toolThreatIntelExceptionsList,
+		toolThreatIntelIntelligenceReportsList,
+		toolThreatIntelTasksList,
+		toolThreatIntelFeedIndicatorsList,
+		toolThreatIntelFeedsList,
+	} {
+		// The handlers return before the nil client is used.
+		tool := newTool(nil)
+		for _, property := range []string{"startDateTime", "endDateTime"} {
+			t.Run(tool.Tool.Name+"/"+property, func(t *testing.T) {
+				request := mcp.CallToolRequest{}
+				request.Params.Arguments = map[string]any{property: "2024-06-01 10:00"}
+				result, err := tool.Handler(context.Background(), request)
+				require.NoError(t, err)
+				require.True(t, result.IsError)
+				require.Contains(t, result.Content[0].(mcp.TextContent).Text, property+" is not an RFC3339 time string")
+			})
+		}
+	}
+}
@@ -0,0 +1,265 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	mcpserver "github.com/mark3labs/mcp-go/server"
+)
+
+// timeRangeArgument is added to tools with a date range by WithTimeRange.
+const timeRangeArgument = "timeRange"
+
+// timeNow is the time relative times are resolved against.
+var timeNow = time.Now
+
+// timeRangeSpec describes the date ranges of a tool that cannot be derived
+// from the names of its arguments.
+type timeRangeSpec struct {
+	// main is the start argument of the range set by timeRange. Tools
+	// without a spec use startDateTime, or their only range.
+	main string
+	// maxWindows are the longest ranges the API accepts, by start argument.
+	// Only the observed attack techniques document a limit; the ranges of
+	// the other tools are not checked for length.
+	maxWindows map[string]time.Duration
+}
+
+var timeRangeSpecs = map[string]timeRangeSpec{
+	"crem_attack_surface_devices_list":              {main: "lastDetectedStartDateTime"},
+	"crem_attack_surface_cloud_assets_list":         {main: "lastDetectedStartDateTime"},
+	"container_security_image_vulnerabilities_list": {main: "lastDetectedStartDateTime"},
+	"workbench_observed_attack_techniques_list": {
+		main: "detectedStartDateTime",
+		maxWindows: map[string]time.Duration{
+			"detectedStartDateTime": 30 * 24 * time.Hour,
+			"ingestedStartDateTime": 30 * 24 * time.Hour,
+		},
+	},
+}
+
+var (
+	// relativeTimeRegexp matches now, today or yesterday followed by an
+	// optional offset, or an offset from now such as -24h.
+	relativeTimeRegexp = regexp.MustCompile(`^(now|today|yesterday)?(?:([+-])(\d+)([smhdw]))?$`)
+	// timeRangeRegexp matches a duration back from now such as 7d.
+	timeRangeRegexp = regexp.MustCompile(`^(?:last ?)?(\d+) ?([smhdw])$`)
+)
+
+var timeUnits = map[string]time.Duration{
+	"s": time.Second,
+	"m": time.Minute,
+	"h": time.Hour,
+	"d": 24 * time.Hour,
+	"w": 7 * 24 * time.Hour,
+}
+
+// parseTime parses an RFC3339 time, a date such as 2024-06-01, or a time
+// relative to now such as now, today, yesterday, -24h, now-7d or today+9h.
+// Dates, today and yesterday are midnight UTC.
+func parseTime(value string, now time.Time) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	if t, err := time.Parse(time.DateOnly, value); err == nil {
+		return t, nil
+	}
+
+	m := relativeTimeRegexp.FindStringSubmatch(strings.ToLower(strings.TrimSpace(value)))
+	if m == nil || m[1] == "" && m[2] == "" {
+		return time.Time{}, fmt.Errorf("unsupported time %q", value)
+	}
+
+	now = now.UTC()
+	t := now
+	switch m[1] {
+	case "today":
+		t = now.Truncate(24 * time.Hour)
+	case "yesterday":
+		t = now.Truncate(24 * time.Hour).Add(-24 * time.Hour)
+	}
+
+	if m[2] != "" {
+		n, err := strconv.Atoi(m[3])
+		if err != nil {
+			return time.Time{}, fmt.Errorf("unsupported time %q", value)
+		}
+		offset := time.Duration(n) * timeUnits[m[4]]
+		if m[2] == "-" {
+			offset = -offset
+		}
+		t = t.Add(offset)
+	}
+	return t, nil
+}
+
+// parseTimeRange parses a timeRange such as 24h, 7d, today or yesterday.
+func parseTimeRange(value string, now time.Time) (time.Time, time.Time, error) {
+	now = now.UTC()
+	value = strings.ToLower(strings.TrimSpace(value))
+	switch value {
+	case "today":
+		return now.Truncate(24 * time.Hour), now, nil
+	case "yesterday":
+		today := now.Truncate(24 * time.Hour)
+		return today.Add(-24 * time.Hour), today, nil
+	}
+
+	m := timeRangeRegexp.FindStringSubmatch(strings.TrimPrefix(value, "-"))
+	if m == nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("unsupported timeRange %q, use a duration such as 24h or 7d, today or yesterday", value)
+	}
+	n, err := strconv.Atoi(m[1])
+	if err != nil || n == 0 {
+		return time.Time{}, time.Time{}, fmt.Errorf("unsupported timeRange %q, use a duration such as 24h or 7d, today or yesterday", value)
+	}
+	return now.Add(-time.Duration(n) * timeUnits[m[2]]), now, nil
+}
+
+// timeRangePair is a start and an end argument of a tool.
+type timeRangePair struct {
+	start, end string
+}
+
+// timeRangePairs returns the date ranges of a tool, the arguments named
+// startDateTime and endDateTime or <name>StartDateTime and <name>EndDateTime.
+func timeRangePairs(tool mcp.Tool) []timeRangePair {
+	pairs := []timeRangePair{}
+	for _, start := range slices.Sorted(maps.Keys(tool.InputSchema.Properties)) {
+		end := ""
+		if start == "startDateTime" {
+			end = "endDateTime"
+		} else if prefix, ok := strings.CutSuffix(start, "StartDateTime"); ok {
+			end = prefix + "EndDateTime"
+		}
+		if _, ok := tool.InputSchema.Properties[end]; ok {
+			pairs = append(pairs, timeRangePair{start: start, end: end})
+		}
+	}
+	return pairs
+}
+
+func formatWindow(d time.Duration) string {
+	if d%(24*time.Hour) == 0 {
+		return fmt.Sprintf("%d days", d/(24*time.Hour))
+	}
+	return d.String()
+}
+
+// WithTimeRange lets the date range arguments of a tool accept relative
+// times such as -24h, now-7d and today, and adds a timeRange argument that
+// sets the start and end of the main range of the tool. Relative times are
+// resolved to RFC3339 before the tool is run, and every range is checked to
+// start before it ends and to fit the longest range the API accepts.
+func WithTimeRange(tool mcpserver.ServerTool) mcpserver.ServerTool {
+	pairs := timeRangePairs(tool.Tool)
+	if len(pairs) == 0 {
+		return tool
+	}
+
+	spec := timeRangeSpecs[tool.Tool.Name]
+	main := pairs[0]
+	for _, pair := range pairs {
+		if pair.start == spec.main || spec.main == "" && pair.start == "startDateTime" {
+			main = pair
+		}
+	}
+
+	for _, pair := range pairs {
+		for _, name := range []string{pair.start, pair.end} {
+			property, ok := tool.Tool.InputSchema.Properties[name].(map[string]any)
+			if !ok {
+				continue
+			}
+			description, _ := property["description"].(string)
+			property["description"] = strings.TrimSpace(strings.TrimSuffix(description, ".") +
+				". Accepts RFC3339 times, dates such as 2024-06-01 and relative times such as now, today, yesterday, -24h or now-7d")
+		}
+	}
+	mcp.WithString(timeRangeArgument,
+		mcp.Description(fmt.Sprintf("Shorthand that sets %s and %s, such as 24h, 7d, today or yesterday. Times are UTC", main.start, main.end)),
+	)(&tool.Tool)
+
+	handler := tool.Handler
+	tool.Handler = func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		now := timeNow().UTC()
+		arguments := maps.Clone(request.GetArguments())
+		if arguments == nil {
+			arguments = map[string]any{}
+		}
+
+		timeRange, err := optionalValue[string](timeRangeArgument, arguments)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		delete(arguments, timeRangeArgument)
+		if timeRange != "" {
+			if _, ok := arguments[main.start]; ok {
+				return mcp.NewToolResultError(fmt.Sprintf("timeRange cannot be combined with %s", main.start)), nil
+			}
+			if _, ok := arguments[main.end]; ok {
+				return mcp.NewToolResultError(fmt.Sprintf("timeRange cannot be combined with %s", main.end)), nil
+			}
+
+			start, end, err := parseTimeRange(timeRange, now)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			arguments[main.start] = start.Format(time.RFC3339)
+			arguments[main.end] = end.Format(time.RFC3339)
+		}
+
+		for _, pair := range pairs {
+			start, err := resolveTimeArgument(pair.start, arguments, now)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			end, err := resolveTimeArgument(pair.end, arguments, now)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			if !start.IsZero() && !end.IsZero() && !start.Before(end) {
+				return mcp.NewToolResultError(fmt.Sprintf("%s (%s) must be before %s (%s)",
+					pair.start, start.Format(time.RFC3339), pair.end, end.Format(time.RFC3339))), nil
+			}
+
+			maxWindow := spec.maxWindows[pair.start]
+			if end.IsZero() {
+				end = now
+			}
+			if maxWindow > 0 && !start.IsZero() && end.Sub(start) > maxWindow {
+				return mcp.NewToolResultError(fmt.Sprintf("the range from %s to %s is longer than %s, the longest range %s accepts",
+					pair.start, pair.end, formatWindow(maxWindow), tool.Tool.Name)), nil
+			}
+		}
+
+		request.Params.Arguments = arguments
+		return handler(ctx, request)
+	}
+
+	return tool
+}
+
+// resolveTimeArgument parses a time argument and replaces it with its
+// RFC3339 form. It returns the zero time when the argument is not set.
+func resolveTimeArgument(property string, arguments map[string]any, now time.Time) (time.Time, error) {
+	value, err := optionalValue[string](property, arguments)
+	if err != nil || value == "" {
+		return time.Time{}, err
+	}
+
+	t, err := parseTime(value, now)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%s is not an RFC3339 time string or a relative time such as -24h, now-7d or today: %q", property, value)
+	}
+	arguments[property] = t.Format(time.RFC3339)
+	return t, nil
+}
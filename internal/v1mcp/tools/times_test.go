@@ -0,0 +1,153 @@
+package tools
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	"github.com/stretchr/testify/require"
+)
+
+var testNow = time.Date(2024, 6, 15, 13, 30, 0, 0, time.UTC)
+
+func TestParseTime(t *testing.T) {
+	for _, tc := range []struct {
+		value    string
+		expected time.Time
+	}{
+		{"2024-06-01T10:00:00Z", time.Date(2024, 6, 1, 10, 0, 0, 0, time.UTC)},
+		{"2024-06-01", time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)},
+		{"now", testNow},
+		{"NOW", testNow},
+		{"-24h", testNow.Add(-24 * time.Hour)},
+		{"now-7d", testNow.Add(-7 * 24 * time.Hour)},
+		{"now+30m", testNow.Add(30 * time.Minute)},
+		{"-2w", testNow.Add(-14 * 24 * time.Hour)},
+		{"today", time.Date(2024, 6, 15, 0, 0, 0, 0, time.UTC)},
+		{"today+9h", time.Date(2024, 6, 15, 9, 0, 0, 0, time.UTC)},
+		{"yesterday", time.Date(2024, 6, 14, 0, 0, 0, 0, time.UTC)},
+	} {
+		t.Run(tc.value, func(t *testing.T) {
+			actual, err := parseTime(tc.value, testNow)
+			require.NoError(t, err)
+			require.True(t, tc.expected.Equal(actual), "%s != %s", tc.expected, actual)
+		})
+	}
+
+	for _, value := range []string{"", "24h", "last week", "now-", "2024-13-01", "now-7x"} {
+		_, err := parseTime(value, testNow)
+		require.Error(t, err, value)
+	}
+}
+
+func TestParseTimeRange(t *testing.T) {
+	start, end, err := parseTimeRange("7d", testNow)
+	require.NoError(t, err)
+	require.Equal(t, testNow.Add(-7*24*time.Hour), start)
+	require.Equal(t, testNow, end)
+
+	start, end, err = parseTimeRange("yesterday", testNow)
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2024, 6, 14, 0, 0, 0, 0, time.UTC), start)
+	require.Equal(t, time.Date(2024, 6, 15, 0, 0, 0, 0, time.UTC), end)
+
+	for _, value := range []string{"0h", "week", "7"} {
+		_, _, err := parseTimeRange(value, testNow)
+		require.Error(t, err, value)
+	}
+}
+
+func TestWithTimeRange(t *testing.T) {
+	timeNow = func() time.Time { return testNow }
+	defer func() { timeNow = time.Now }()
+
+	var sent map[string]any
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		sent = request.GetArguments()
+		return mcp.NewToolResultText("{}"), nil
+	}
+	call := func(tool mcpserver.ServerTool, args map[string]any) *mcp.CallToolResult {
+		sent = nil
+		request := mcp.CallToolRequest{}
+		request.Params.Arguments = args
+		result, err := tool.Handler(context.Background(), request)
+		require.NoError(t, err)
+		return result
+	}
+
+	alerts := WithTimeRange(mcpserver.ServerTool{
+		Tool:    mcp.NewTool("workbench_alerts_list", mcp.WithString("startDateTime"), mcp.WithString("endDateTime")),
+		Handler: handler,
+	})
+	require.Contains(t, alerts.Tool.InputSchema.Properties, timeRangeArgument)
+
+	t.Run("should resolve relative times", func(t *testing.T) {
+		require.False(t, call(alerts, map[string]any{"startDateTime": "now-7d", "endDateTime": "today"}).IsError)
+		require.Equal(t, map[string]any{"startDateTime": "2024-06-08T13:30:00Z", "endDateTime": "2024-06-15T00:00:00Z"}, sent)
+	})
+
+	t.Run("should fill the range from timeRange", func(t *testing.T) {
+		require.False(t, call(alerts, map[string]any{"timeRange": "24h"}).IsError)
+		require.Equal(t, map[string]any{"startDateTime": "2024-06-14T13:30:00Z", "endDateTime": "2024-06-15T13:30:00Z"}, sent)
+
+		result := call(alerts, map[string]any{"timeRange": "24h", "startDateTime": "-1h"})
+		require.True(t, result.IsError)
+		require.Nil(t, sent)
+	})
+
+	t.Run("should reject a start after the end", func(t *testing.T) {
+		result := call(alerts, map[string]any{"startDateTime": "today", "endDateTime": "yesterday"})
+		require.True(t, result.IsError)
+		require.Equal(t, "startDateTime (2024-06-15T00:00:00Z) must be before endDateTime (2024-06-14T00:00:00Z)", result.Content[0].(mcp.TextContent).Text)
+	})
+
+	t.Run("should reject malformed times", func(t *testing.T) {
+		result := call(alerts, map[string]any{"startDateTime": "last tuesday"})
+		require.True(t, result.IsError)
+		require.Contains(t, result.Content[0].(mcp.TextContent).Text, "startDateTime is not an RFC3339 time string")
+	})
+
+	oat := WithTimeRange(mcpserver.ServerTool{
+		Tool: mcp.NewTool("workbench_observed_attack_techniques_list",
+			mcp.WithString("detectedStartDateTime"), mcp.WithString("detectedEndDateTime"),
+			mcp.WithString("ingestedStartDateTime"), mcp.WithString("ingestedEndDateTime"),
+		),
+		Handler: handler,
+	})
+
+	t.Run("should fill the main range of the tool", func(t *testing.T) {
+		require.False(t, call(oat, map[string]any{"timeRange": "today"}).IsError)
+		require.Equal(t, map[string]any{"detectedStartDateTime": "2024-06-15T00:00:00Z", "detectedEndDateTime": "2024-06-15T13:30:00Z"}, sent)
+	})
+
+	t.Run("should reject ranges longer than the API accepts", func(t *testing.T) {
+		result := call(oat, map[string]any{"timeRange": "31d"})
+		require.True(t, result.IsError)
+		require.Contains(t, result.Content[0].(mcp.TextContent).Text, "longer than 30 days")
+
+		require.True(t, call(oat, map[string]any{"ingestedStartDateTime": "-5w"}).IsError)
+		require.False(t, call(oat, map[string]any{"ingestedStartDateTime": "-4w"}).IsError)
+	})
+
+	t.Run("should not change tools without a range", func(t *testing.T) {
+		other := WithTimeRange(mcpserver.ServerTool{Tool: mcp.NewTool("other", mcp.WithString("startDateTime"))})
+		require.NotContains(t, other.Tool.InputSchema.Properties, timeRangeArgument)
+	})
+}
+
+func TestToolsDeclareBothEndsOfRanges(t *testing.T) {
+	for name, tool := range allTools(t) {
+		paired := map[string]bool{}
+		for _, pair := range timeRangePairs(tool) {
+			paired[pair.start], paired[pair.end] = true, true
+		}
+		for property := range tool.InputSchema.Properties {
+			if strings.HasSuffix(strings.ToLower(property), "startdatetime") || strings.HasSuffix(strings.ToLower(property), "enddatetime") {
+				require.True(t, paired[property], "%s: %s has no matching start or end", name, property)
+			}
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package tools
 
 import (
+	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
@@ -82,9 +83,9 @@ func optionalTimeValue(property string, vals map[string]any) (time.Time, error)
 		return time.Time{}, nil
 	}
 
-	t, err := time.Parse(time.RFC3339, val)
+	t, err := parseTime(val, timeNow())
 	if err != nil {
-		return time.Time{}, fmt.Errorf("%s is not an RFC3339 time string: %q", property, val)
+		return time.Time{}, fmt.Errorf("%s is not an RFC3339 time string or a relative time such as -24h, now-7d or today: %q", property, val)
 	}
 	return t, nil
 }
@@ -118,6 +119,26 @@ func optionalPointerValue[T any](property string, vals map[string]any) (*T, erro
 	return &returnVal, nil
 }
 
+// optionalJSONValue decodes an optional object or array argument into v.
+// v is left unchanged when the argument is not provided.
+func optionalJSONValue(property string, vals map[string]any, v any) error {
+	val, ok := vals[property]
+	if !ok || val == nil {
+		return nil
+	}
+
+	b, err := json.Marshal(val)
+	if err != nil {
+		return fmt.Errorf("%s could not be encoded: %w", property, err)
+	}
+
+	if err := json.Unmarshal(b, v); err != nil {
+		return fmt.Errorf("%s is not valid: %w", property, err)
+	}
+
+	return nil
+}
+
 func handleStatusResponse(r *http.Response, err error, expectedStatusCode int, msg string) (*mcp.CallToolResult, error) {
 	if err != nil {
 		return nil, err
@@ -136,6 +157,13 @@ func handleStatusResponse(r *http.Response, err error, expectedStatusCode int, m
 		return mcp.NewToolResultError(fmt.Sprintf("%s: %s", msg, string(body))), nil
 	}
 
+	// JSON objects are also returned as structured content so clients can
+	// validate them against the output schema of the tool.
+	structured := map[string]any{}
+	if err := json.Unmarshal(body, &structured); err == nil {
+		return mcp.NewToolResultStructured(structured, string(body)), nil
+	}
+
 	return mcp.NewToolResultText(string(body)), nil
 }
 
@@ -1,10 +1,39 @@
 package tools
 
 import (
-	"github.com/stretchr/testify/require"
+	"context"
+	"encoding/json"
 	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	"github.com/stretchr/testify/require"
 )
 
+// cannedTool returns a tool that answers every call with text, and with text
+// parsed as JSON as its structured content.
+func cannedTool(name, text string, opts ...mcp.ToolOption) mcpserver.ServerTool {
+	return mcpserver.ServerTool{
+		Tool: mcp.NewTool(name, opts...),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			structured := map[string]any{}
+			_ = json.Unmarshal([]byte(text), &structured)
+			return mcp.NewToolResultStructured(structured, text), nil
+		},
+	}
+}
+
+// callTool calls the handler of tool with arguments.
+func callTool(ctx context.Context, t *testing.T, tool mcpserver.ServerTool, arguments map[string]any) *mcp.CallToolResult {
+	t.Helper()
+	request := mcp.CallToolRequest{}
+	request.Params.Name = tool.Tool.Name
+	request.Params.Arguments = arguments
+	result, err := tool.Handler(ctx, request)
+	require.NoError(t, err)
+	return result
+}
+
 func TestWithOrdering(t *testing.T) {
 	expected := []string{
 		"key asc",
@@ -125,3 +154,31 @@ func TestOptionalPointerValue(t *testing.T) {
 		require.Equal(t, 1, *n)
 	})
 }
+
+func TestOptionalJSONValue(t *testing.T) {
+	vals := map[string]any{
+		"obj":     map[string]any{"name": "test"},
+		"invalid": "notAnObject",
+	}
+
+	type obj struct {
+		Name string `json:"name"`
+	}
+
+	t.Run("should decode value if found", func(t *testing.T) {
+		var o obj
+		require.NoError(t, optionalJSONValue("obj", vals, &o))
+		require.Equal(t, "test", o.Name)
+	})
+
+	t.Run("should leave value unchanged if not found", func(t *testing.T) {
+		var o *obj
+		require.NoError(t, optionalJSONValue("none", vals, &o))
+		require.Nil(t, o)
+	})
+
+	t.Run("should return an error if value cannot be decoded", func(t *testing.T) {
+		var o obj
+		require.Error(t, optionalJSONValue("invalid", vals, &o))
+	})
+}
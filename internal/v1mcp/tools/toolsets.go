@@ -0,0 +1,84 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	mcpserver "github.com/mark3labs/mcp-go/server"
+)
+
+// EnableToolsetToolName is the name of the tool that adds a toolset to the
+// server in lazy mode.
+const EnableToolsetToolName = "enable_toolset"
+
+// Toolsets returns the toolsets of the registrations in registry order.
+func Toolsets(registry []Registration) []string {
+	toolsets := []string{}
+	for _, r := range registry {
+		if !slices.Contains(toolsets, r.Toolset) {
+			toolsets = append(toolsets, r.Toolset)
+		}
+	}
+	return toolsets
+}
+
+type enableToolsetResult struct {
+	Toolset string   `json:"toolset"`
+	Tools   []string `json:"tools" jsonschema:"description=The tools of the toolset, which can be called now"`
+}
+
+// NewEnableToolsetTool returns the enable_toolset tool. enable adds the
+// tools of a toolset to the server, it returns their names and is called
+// again for toolsets that are enabled already.
+func NewEnableToolsetTool(toolsets []string, enable func(toolset string) ([]string, error)) mcpserver.ServerTool {
+	return mcpserver.ServerTool{
+		Tool: mcp.NewTool(
+			EnableToolsetToolName,
+			mcp.WithDescription(
+				"Adds the tools of a toolset to this server, only a few tools are available until then. "+
+					"Use "+DescribeToolName+" to find the toolset of the tool for a task. "+
+					"Toolsets that are not used for a while are removed again",
+			),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title: "Enable Toolset",
+				// Enabling a toolset changes the tools of this server, not
+				// Vision One.
+				ReadOnlyHint:    toPtr(true),
+				DestructiveHint: toPtr(false),
+				IdempotentHint:  toPtr(true),
+				OpenWorldHint:   toPtr(false),
+			}),
+			mcp.WithString("toolset",
+				mcp.Required(),
+				mcp.Description("The toolset to enable"),
+				mcp.Enum(toolsets...),
+			),
+			mcp.WithOutputSchema[enableToolsetResult](),
+		),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			toolset, err := requiredValue[string]("toolset", request.GetArguments())
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if !slices.Contains(toolsets, toolset) {
+				return mcp.NewToolResultError(fmt.Sprintf("unknown toolset %q, expected one of %s", toolset, strings.Join(toolsets, ", "))), nil
+			}
+
+			names, err := enable(toolset)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			result := enableToolsetResult{Toolset: toolset, Tools: names}
+			b, err := json.Marshal(result)
+			if err != nil {
+				return nil, err
+			}
+			return mcp.NewToolResultStructured(result, string(b)), nil
+		},
+	}
+}
@@ -0,0 +1,78 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"strconv"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	mcpserver "github.com/mark3labs/mcp-go/server"
+)
+
+// WithDefaultTop sets the top argument of a list tool to top when the model
+// doesn't set it. top is adjusted to the closest value the tool accepts.
+// Tools without a top argument are returned unchanged, as are all tools
+// when top is zero.
+func WithDefaultTop(tool mcpserver.ServerTool, top int) mcpserver.ServerTool {
+	property, ok := tool.Tool.InputSchema.Properties["top"].(map[string]any)
+	if !ok || top <= 0 {
+		return tool
+	}
+
+	value := topValue(property, top)
+	description, _ := property["description"].(string)
+	property["description"] = strings.TrimSpace(fmt.Sprintf("%s Defaults to %v.", description, value))
+
+	handler := tool.Handler
+	tool.Handler = func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		if _, ok := request.GetArguments()["top"]; !ok {
+			arguments := maps.Clone(request.GetArguments())
+			if arguments == nil {
+				arguments = map[string]any{}
+			}
+			arguments["top"] = value
+			request.Params.Arguments = arguments
+		}
+		return handler(ctx, request)
+	}
+	return tool
+}
+
+// topValue returns the value of the top property closest to top: the largest
+// enum value that is at most top, or the smallest enum value, or top within
+// the minimum and maximum of the property. Values of string properties are
+// returned as strings, others as numbers like JSON arguments.
+func topValue(property map[string]any, top int) any {
+	if enum, ok := property["enum"].([]string); ok && len(enum) > 0 {
+		best, smallest := 0, 0
+		for _, s := range enum {
+			n, err := strconv.Atoi(s)
+			if err != nil {
+				continue
+			}
+			if n <= top && n > best {
+				best = n
+			}
+			if smallest == 0 || n < smallest {
+				smallest = n
+			}
+		}
+		if best == 0 {
+			best = smallest
+		}
+		top = best
+	}
+	if minimum, ok := property["minimum"].(float64); ok && float64(top) < minimum {
+		top = int(minimum)
+	}
+	if maximum, ok := property["maximum"].(float64); ok && float64(top) > maximum {
+		top = int(maximum)
+	}
+
+	if property["type"] == "string" {
+		return strconv.Itoa(top)
+	}
+	return float64(top)
+}
@@ -0,0 +1,58 @@
+package tools
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithDefaultTop(t *testing.T) {
+	var sent map[string]any
+	newTool := func(options ...mcp.ToolOption) mcpserver.ServerTool {
+		return mcpserver.ServerTool{
+			Tool: mcp.NewTool("things_list", options...),
+			Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+				sent = request.GetArguments()
+				return mcp.NewToolResultText("{}"), nil
+			},
+		}
+	}
+	call := func(tool mcpserver.ServerTool, args map[string]any) {
+		sent = nil
+		request := mcp.CallToolRequest{}
+		request.Params.Arguments = args
+		_, err := tool.Handler(context.Background(), request)
+		require.NoError(t, err)
+	}
+
+	t.Run("should set top when it is missing", func(t *testing.T) {
+		tool := WithDefaultTop(newTool(mcp.WithNumber("top", mcp.Description("Page size."), mcp.Min(50), mcp.Max(200))), 100)
+		call(tool, nil)
+		require.Equal(t, map[string]any{"top": float64(100)}, sent)
+
+		call(tool, map[string]any{"top": float64(50)})
+		require.Equal(t, map[string]any{"top": float64(50)}, sent)
+
+		require.Equal(t, "Page size. Defaults to 100.", tool.Tool.InputSchema.Properties["top"].(map[string]any)["description"])
+	})
+
+	t.Run("should pick a value the tool accepts", func(t *testing.T) {
+		call(WithDefaultTop(newTool(mcp.WithString("top", mcp.Enum("10", "50", "100"))), 60), nil)
+		require.Equal(t, map[string]any{"top": "50"}, sent)
+
+		call(WithDefaultTop(newTool(mcp.WithString("top", mcp.Enum("10", "50", "100"))), 5), nil)
+		require.Equal(t, map[string]any{"top": "10"}, sent)
+
+		call(WithDefaultTop(newTool(mcp.WithNumber("top", mcp.Min(50), mcp.Max(200))), 1000), nil)
+		require.Equal(t, map[string]any{"top": float64(200)}, sent)
+	})
+
+	t.Run("should leave tools without top alone", func(t *testing.T) {
+		tool := WithDefaultTop(newTool(mcp.WithString("filter")), 100)
+		call(tool, map[string]any{})
+		require.Equal(t, map[string]any{}, sent)
+	})
+}
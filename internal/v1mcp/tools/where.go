@@ -0,0 +1,156 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	"github.com/trendmicro/vision-one-mcp-server/internal/v1mcp/filter"
+	"github.com/trendmicro/vision-one-mcp-server/internal/v1mcp/tooldescriptions"
+)
+
+const (
+	whereArgument = "where"
+	// whereConditionDef is the name of the schema definition of a condition.
+	whereConditionDef = "whereCondition"
+)
+
+// WithWhere adds the where argument to tools with a filter argument. It is
+// a JSON array of conditions that the server compiles to the filter syntax
+// of the endpoint, with the supported fields and values enumerated in the
+// schema. When both where and filter are set they are joined with and.
+func WithWhere(tool mcpserver.ServerTool) mcpserver.ServerTool {
+	fields, ok := filterFields(tool.Tool)["filter"]
+	if !ok {
+		return tool
+	}
+
+	if tool.Tool.InputSchema.Defs == nil {
+		tool.Tool.InputSchema.Defs = map[string]any{}
+	}
+	tool.Tool.InputSchema.Defs[whereConditionDef] = whereConditionSchema(fields)
+	mcp.WithArray(whereArgument,
+		mcp.Description("Structured alternative to filter. The conditions are joined with and, "+
+			`e.g. [{"field": "severity", "op": "eq", "value": "high"}, {"or": [{"field": "status", "op": "eq", "value": "Open"}, {"field": "status", "op": "eq", "value": "In Progress"}]}]. `+
+			"Use in and hassubset with a list of values"),
+		mcp.Items(map[string]any{"$ref": "#/$defs/" + whereConditionDef}),
+	)(&tool.Tool)
+
+	handler := tool.Handler
+	tool.Handler = func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		arguments := request.GetArguments()
+		if _, ok := arguments[whereArgument]; !ok {
+			return handler(ctx, request)
+		}
+
+		conditions := []filter.Condition{}
+		if err := optionalJSONValue(whereArgument, arguments, &conditions); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		expr, err := filter.Compile(conditions, fields)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("invalid where:\n%s", err)), nil
+		}
+
+		raw, err := optionalValue[string]("filter", arguments)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if strings.TrimSpace(raw) != "" {
+			rawExpr, err := filter.Parse(raw)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("invalid filter %q:\n%s", raw, err)), nil
+			}
+			expr = filter.And(rawExpr, expr)
+		}
+
+		arguments = maps.Clone(arguments)
+		delete(arguments, whereArgument)
+		arguments["filter"] = expr.String()
+		request.Params.Arguments = arguments
+		return handler(ctx, request)
+	}
+
+	return tool
+}
+
+// whereConditionSchema returns the schema of a where condition. Fields with
+// the same supported values share a comparison schema that enumerates the
+// values, the other fields accept any value.
+func whereConditionSchema(fields []tooldescriptions.FilterField) map[string]any {
+	ref := map[string]any{"$ref": "#/$defs/" + whereConditionDef}
+	scalar := []any{
+		map[string]any{"type": "string"},
+		map[string]any{"type": "number"},
+		map[string]any{"type": "boolean"},
+	}
+
+	byValues := map[string][]string{}
+	values := map[string][]string{}
+	free := []string{}
+	for _, field := range fields {
+		if field.Deprecated {
+			continue
+		}
+		if len(field.Values) == 0 {
+			free = append(free, field.Name)
+			continue
+		}
+		key, _ := json.Marshal(field.Values)
+		byValues[string(key)] = append(byValues[string(key)], field.Name)
+		values[string(key)] = field.Values
+	}
+
+	conditions := []any{}
+	for _, key := range slices.Sorted(maps.Keys(byValues)) {
+		enum := []any{}
+		for _, v := range values[key] {
+			enum = append(enum, v)
+		}
+		if slices.Equal(values[key], []string{"true", "false"}) {
+			enum = append(enum, true, false)
+		}
+		conditions = append(conditions, whereComparisonSchema(byValues[key], []string{filter.OpEq, filter.OpNe, filter.OpIn, filter.FuncHasSubset}, map[string]any{
+			"anyOf": []any{
+				map[string]any{"enum": enum},
+				map[string]any{"type": "array", "items": map[string]any{"enum": enum}},
+			},
+		}))
+	}
+	if len(free) > 0 {
+		conditions = append(conditions, whereComparisonSchema(free, filter.ConditionOperators, map[string]any{
+			"anyOf": append(slices.Clone(scalar), map[string]any{"type": "array", "items": map[string]any{"anyOf": scalar}}),
+		}))
+	}
+
+	for _, op := range []string{filter.OpAnd, filter.OpOr} {
+		conditions = append(conditions, map[string]any{
+			"type":                 "object",
+			"description":          fmt.Sprintf("Conditions joined with %s", op),
+			"properties":           map[string]any{op: map[string]any{"type": "array", "items": ref}},
+			"required":             []string{op},
+			"additionalProperties": false,
+		})
+	}
+
+	return map[string]any{"anyOf": conditions}
+}
+
+func whereComparisonSchema(fields, ops []string, value map[string]any) map[string]any {
+	return map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"field": map[string]any{"type": "string", "enum": fields},
+			"op":    map[string]any{"type": "string", "enum": ops},
+			"value": value,
+		},
+		"required":             []string{"field", "op", "value"},
+		"additionalProperties": false,
+	}
+}
@@ -0,0 +1,96 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	"github.com/stretchr/testify/require"
+	"github.com/trendmicro/vision-one-mcp-server/internal/v1mcp/tooldescriptions"
+)
+
+func TestWithWhere(t *testing.T) {
+	var sent map[string]any
+	tool := WithWhere(WithFilterValidation(mcpserver.ServerTool{
+		Tool: mcp.NewTool("workbench_alerts_list", mcp.WithString("filter", mcp.Description(tooldescriptions.FilterWorkbenchAlerts.Description()))),
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			sent = request.GetArguments()
+			return mcp.NewToolResultText("{}"), nil
+		},
+	}))
+
+	call := func(args map[string]any) *mcp.CallToolResult {
+		sent = nil
+		request := mcp.CallToolRequest{}
+		request.Params.Arguments = args
+		result, err := tool.Handler(context.Background(), request)
+		require.NoError(t, err)
+		return result
+	}
+
+	where := []any{
+		map[string]any{"field": "severity", "op": "eq", "value": "high"},
+		map[string]any{"or": []any{
+			map[string]any{"field": "status", "op": "eq", "value": "Open"},
+			map[string]any{"field": "model", "op": "contains", "value": "Admin's"},
+		}},
+	}
+
+	t.Run("should compile where to the filter", func(t *testing.T) {
+		require.False(t, call(map[string]any{"where": where}).IsError)
+		require.Equal(t, map[string]any{"filter": "severity eq 'high' and (status eq 'Open' or contains(model, 'Admin''s'))"}, sent)
+	})
+
+	t.Run("should join where and filter with and", func(t *testing.T) {
+		require.False(t, call(map[string]any{"where": where, "filter": "alertProvider eq 'SAE' and modelType eq 'preset'"}).IsError)
+		require.Equal(t, "alertProvider eq 'SAE' and modelType eq 'preset' and severity eq 'high' and (status eq 'Open' or contains(model, 'Admin''s'))", sent["filter"])
+	})
+
+	t.Run("should leave the filter alone without where", func(t *testing.T) {
+		require.False(t, call(map[string]any{"filter": "severity eq 'high'"}).IsError)
+		require.Equal(t, map[string]any{"filter": "severity eq 'high'"}, sent)
+	})
+
+	t.Run("should not send invalid conditions", func(t *testing.T) {
+		result := call(map[string]any{"where": []any{map[string]any{"field": "severity", "op": "eq", "value": "urgent"}}})
+		require.True(t, result.IsError)
+		require.Nil(t, sent)
+		require.Contains(t, result.Content[0].(mcp.TextContent).Text, "where[0]: unsupported value 'urgent' for severity")
+	})
+
+	t.Run("should enumerate the fields and values in the schema", func(t *testing.T) {
+		require.Contains(t, tool.Tool.InputSchema.Properties, whereArgument)
+
+		b, err := json.Marshal(tool.Tool.InputSchema)
+		require.NoError(t, err)
+		schema := struct {
+			Defs map[string]struct {
+				AnyOf []struct {
+					Properties map[string]struct {
+						Enum []any `json:"enum"`
+					} `json:"properties"`
+				} `json:"anyOf"`
+			} `json:"$defs"`
+		}{}
+		require.NoError(t, json.Unmarshal(b, &schema))
+
+		enums := map[string][]any{}
+		for _, condition := range schema.Defs[whereConditionDef].AnyOf {
+			for _, field := range condition.Properties["field"].Enum {
+				enums[field.(string)] = condition.Properties["op"].Enum
+			}
+		}
+		require.Contains(t, enums, "severity")
+		require.Contains(t, enums, "model")
+		require.NotContains(t, enums, "investigationStatus")
+		require.Contains(t, enums["model"], "contains")
+		require.NotContains(t, enums["severity"], "contains")
+	})
+
+	t.Run("should not add where to tools without a filter", func(t *testing.T) {
+		other := WithWhere(mcpserver.ServerTool{Tool: mcp.NewTool("other")})
+		require.NotContains(t, other.Tool.InputSchema.Properties, whereArgument)
+	})
+}
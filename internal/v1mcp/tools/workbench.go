@@ -10,21 +10,19 @@ import (
 	"github.com/trendmicro/vision-one-mcp-server/internal/v1mcp/tooldescriptions"
 )
 
-var ToolsetsReadOnlyWorkench = []func(*v1client.V1ApiClient) mcpserver.ServerTool{
-	toolWorkbenchAlertsList,
-	toolWorkbenchAlertDetailGet,
-	toolObservedAttackTechniquesList,
-}
-
 func toolWorkbenchAlertsList(client *v1client.V1ApiClient) mcpserver.ServerTool {
 	return mcpserver.ServerTool{
 		Tool: mcp.NewTool(
 			"workbench_alerts_list",
 			mcp.WithDescription("List Trend Vision One Workbench Alerts"),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
-				ReadOnlyHint: toPtr(true),
+				Title:           "List Workbench Alerts",
+				ReadOnlyHint:    toPtr(true),
+				DestructiveHint: toPtr(false),
+				IdempotentHint:  toPtr(true),
+				OpenWorldHint:   toPtr(false),
 			}),
-			mcp.WithString("filter", mcp.Description(tooldescriptions.FilterWorkbenchAlerts)),
+			mcp.WithString("filter", mcp.Description(tooldescriptions.FilterWorkbenchAlerts.Description())),
 			mcp.WithString("orderBy",
 				mcp.Description("the field to order by"),
 				mcp.Enum(withOrdering(
@@ -45,6 +43,7 @@ func toolWorkbenchAlertsList(client *v1client.V1ApiClient) mcpserver.ServerTool
 			),
 			mcp.WithString("startDateTime", mcp.Description("The start of the data retrieval range")),
 			mcp.WithString("endDateTime", mcp.Description("The end of the data retrieval range")),
+			mcp.WithOutputSchema[listResponse[workbenchAlert]](),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			filter, err := optionalValue[string]("filter", request.GetArguments())
@@ -84,12 +83,17 @@ func toolWorkbenchAlertDetailGet(client *v1client.V1ApiClient) mcpserver.ServerT
 		Tool: mcp.NewTool(
 			"workbench_alert_detail_get",
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
-				ReadOnlyHint: toPtr(true),
+				Title:           "Get Workbench Alert",
+				ReadOnlyHint:    toPtr(true),
+				DestructiveHint: toPtr(false),
+				IdempotentHint:  toPtr(true),
+				OpenWorldHint:   toPtr(false),
 			}),
 			mcp.WithDescription("Displays information about the specified alert."),
 			mcp.WithString("alertId",
 				mcp.Required(),
 			),
+			mcp.WithOutputSchema[workbenchAlert](),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			alertId, err := requiredValue[string]("alertId", request.GetArguments())
@@ -109,12 +113,16 @@ func TookWorkbenchAlertNotesList(client *v1client.V1ApiClient) mcpserver.ServerT
 			"workbench_alert_notes_list",
 			mcp.WithDescription("Displays the notes of the specified Workbench alert."),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
-				ReadOnlyHint: toPtr(true),
+				Title:           "List Workbench Alert Notes",
+				ReadOnlyHint:    toPtr(true),
+				DestructiveHint: toPtr(false),
+				IdempotentHint:  toPtr(true),
+				OpenWorldHint:   toPtr(false),
 			}),
 			mcp.WithString("alertId",
 				mcp.Required(),
 			),
-			mcp.WithString("filter", mcp.Description(tooldescriptions.FilterWorkbenchNotes)),
+			mcp.WithString("filter", mcp.Description(tooldescriptions.FilterWorkbenchNotes.Description())),
 			mcp.WithString(
 				"orderBy",
 				mcp.Description("the field to order by"),
@@ -137,6 +145,7 @@ func TookWorkbenchAlertNotesList(client *v1client.V1ApiClient) mcpserver.ServerT
 				mcp.Description("The token use to paginate. Used to retrieve the next page of information.")),
 			mcp.WithString("startDateTime", mcp.Description("The start of the data retrieval range")),
 			mcp.WithString("endDateTime", mcp.Description("The end of the data retrieval range")),
+			mcp.WithOutputSchema[listResponse[map[string]any]](),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			alertId, err := requiredValue[string]("alertId", request.GetArguments())
@@ -159,6 +168,11 @@ func TookWorkbenchAlertNotesList(client *v1client.V1ApiClient) mcpserver.ServerT
 				return mcp.NewToolResultError(err.Error()), nil
 			}
 
+			skipToken, err := optionalValue[string]("skipToken", request.GetArguments())
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
 			startDate, err := optionalTimeValue("startDateTime", request.GetArguments())
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
@@ -172,6 +186,7 @@ func TookWorkbenchAlertNotesList(client *v1client.V1ApiClient) mcpserver.ServerT
 			qp := v1client.QueryParameters{
 				Top:           top,
 				OrderBy:       orderBy,
+				SkipToken:     skipToken,
 				StartDateTime: startDate,
 				EndDateTime:   endDate,
 			}
@@ -188,9 +203,13 @@ func toolObservedAttackTechniquesList(client *v1client.V1ApiClient) mcpserver.Se
 			"workbench_observed_attack_techniques_list",
 			mcp.WithDescription("List observed attack techniques"),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
-				ReadOnlyHint: toPtr(true),
+				Title:           "List Observed Attack Techniques",
+				ReadOnlyHint:    toPtr(true),
+				DestructiveHint: toPtr(false),
+				IdempotentHint:  toPtr(true),
+				OpenWorldHint:   toPtr(false),
 			}),
-			mcp.WithString("filter", mcp.Description(tooldescriptions.ObservedAttackFilter)),
+			mcp.WithString("filter", mcp.Description(tooldescriptions.ObservedAttackFilter.Description())),
 			mcp.WithString("top",
 				mcp.Description(tooldescriptions.DefaultTop),
 				mcp.Enum("50", "100", "200"),
@@ -209,6 +228,7 @@ func toolObservedAttackTechniquesList(client *v1client.V1ApiClient) mcpserver.Se
 			),
 			mcp.WithString("nextBatchToken",
 				mcp.Description("The token use to paginate. Used to retrieve the next page of information.")),
+			mcp.WithOutputSchema[listResponse[observedAttackTechnique]](),
 		),
 		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			top, err := optionalStrInt("top", request.GetArguments())
@@ -0,0 +1,135 @@
+package v1mcp
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	"github.com/trendmicro/vision-one-mcp-server/internal/v1mcp/tools"
+)
+
+// toolsetLoader adds the toolsets of the registry to a running server when
+// the model enables them, and removes them again once none of their tools
+// was called for idleTimeout and no call is running. mcp-go notifies the clients of the server
+// whenever its tools change.
+type toolsetLoader struct {
+	s           *mcpserver.MCPServer
+	factory     toolFactory
+	registry    []tools.Registration
+	idleTimeout time.Duration
+
+	mu sync.Mutex
+	// enabled are the names of the tools of the enabled toolsets.
+	enabled  map[string][]string
+	lastUsed map[string]time.Time
+	// inFlight are the number of running calls of the tools of each
+	// toolset.
+	inFlight map[string]int
+}
+
+func newToolsetLoader(s *mcpserver.MCPServer, factory toolFactory, registry []tools.Registration, idleTimeout time.Duration) *toolsetLoader {
+	return &toolsetLoader{
+		s:           s,
+		factory:     factory,
+		registry:    registry,
+		idleTimeout: idleTimeout,
+		enabled:     map[string][]string{},
+		lastUsed:    map[string]time.Time{},
+		inFlight:    map[string]int{},
+	}
+}
+
+// enableToolsetTool returns the enable_toolset tool of the loader.
+func (l *toolsetLoader) enableToolsetTool() mcpserver.ServerTool {
+	return tools.NewEnableToolsetTool(tools.Toolsets(l.registry), l.enable)
+}
+
+// enable adds the tools of toolset to the server unless it is enabled
+// already, and returns their names.
+func (l *toolsetLoader) enable(toolset string) ([]string, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.lastUsed[toolset] = time.Now()
+	if names, ok := l.enabled[toolset]; ok {
+		return names, nil
+	}
+
+	registrations := []tools.Registration{}
+	for _, r := range l.registry {
+		if r.Toolset == toolset {
+			registrations = append(registrations, r)
+		}
+	}
+	if len(registrations) == 0 {
+		return nil, fmt.Errorf("toolset %q is not available", toolset)
+	}
+
+	names, err := addRegistrations(l.s, registrations, func(r tools.Registration) mcpserver.ServerTool {
+		return l.tool(r)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("toolset %q could not be enabled: %w", toolset, err)
+	}
+	l.enabled[toolset] = names
+	if l.idleTimeout > 0 {
+		time.AfterFunc(l.idleTimeout, func() { l.expire(toolset) })
+	}
+	return names, nil
+}
+
+// tool returns the tool of r, which records when its toolset is used.
+func (l *toolsetLoader) tool(r tools.Registration) mcpserver.ServerTool {
+	tool := l.factory.tool(r)
+	handler := tool.Handler
+	tool.Handler = func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Calls can take longer than the idle timeout, the toolset is
+		// not removed until they return.
+		l.begin(r.Toolset)
+		defer l.end(r.Toolset)
+		return handler(ctx, request)
+	}
+	return tool
+}
+
+// begin records the start of a call of a tool of toolset.
+func (l *toolsetLoader) begin(toolset string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.inFlight[toolset]++
+	l.lastUsed[toolset] = time.Now()
+}
+
+// end records the end of a call of a tool of toolset.
+func (l *toolsetLoader) end(toolset string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.inFlight[toolset]--
+	l.lastUsed[toolset] = time.Now()
+}
+
+// expire removes toolset from the server when it was idle for idleTimeout
+// and none of its calls is running, and checks again later otherwise.
+func (l *toolsetLoader) expire(toolset string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	names, ok := l.enabled[toolset]
+	if !ok {
+		return
+	}
+	if l.inFlight[toolset] > 0 {
+		time.AfterFunc(l.idleTimeout, func() { l.expire(toolset) })
+		return
+	}
+	if idle := time.Since(l.lastUsed[toolset]); idle < l.idleTimeout {
+		time.AfterFunc(l.idleTimeout-idle, func() { l.expire(toolset) })
+		return
+	}
+
+	delete(l.enabled, toolset)
+	l.s.DeleteTools(names...)
+}
@@ -0,0 +1,205 @@
+package v1mcp
+
+import (
+	"context"
+	"encoding/json"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	"github.com/stretchr/testify/require"
+	"github.com/trendmicro/vision-one-mcp-server/internal/v1client"
+	"github.com/trendmicro/vision-one-mcp-server/internal/v1mcp/tools"
+)
+
+type notificationTestSession struct {
+	notifications chan mcp.JSONRPCNotification
+}
+
+func (s *notificationTestSession) SessionID() string { return "test" }
+func (s *notificationTestSession) NotificationChannel() chan<- mcp.JSONRPCNotification {
+	return s.notifications
+}
+func (s *notificationTestSession) Initialize()       {}
+func (s *notificationTestSession) Initialized() bool { return true }
+
+// listChanged returns the number of tools/list_changed notifications the
+// session received.
+func (s *notificationTestSession) listChanged() int {
+	n := 0
+	for {
+		select {
+		case notification := <-s.notifications:
+			if notification.Method == mcp.MethodNotificationToolsListChanged {
+				n++
+			}
+		default:
+			return n
+		}
+	}
+}
+
+func newLazyTestServer(t *testing.T, cfg ServerConfig) (*mcpserver.MCPServer, *notificationTestSession) {
+	t.Helper()
+	cfg.Region = "us"
+	cfg.LazyToolsets = true
+	s, err := NewMcpServer(cfg)
+	require.NoError(t, err)
+
+	session := &notificationTestSession{notifications: make(chan mcp.JSONRPCNotification, 10)}
+	require.NoError(t, s.RegisterSession(context.Background(), session))
+	return s, session
+}
+
+func TestLazyToolsets(t *testing.T) {
+	t.Run("should only register the core tools at start", func(t *testing.T) {
+		s, _ := newLazyTestServer(t, ServerConfig{ReadOnly: true})
+		require.Equal(t,
+			[]string{tools.EnableToolsetToolName, tools.DescribeToolName},
+			slices.Sorted(maps.Keys(s.ListTools())),
+		)
+	})
+
+	t.Run("should add toolsets when they are enabled", func(t *testing.T) {
+		s, session := newLazyTestServer(t, ServerConfig{ReadOnly: true})
+
+		result := callToolResult(t, s.HandleMessage, tools.EnableToolsetToolName, map[string]any{"toolset": "container"})
+		require.False(t, result.IsError)
+		enabled := struct {
+			Tools []string `json:"tools"`
+		}{}
+		require.NoError(t, json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &enabled))
+		require.Contains(t, enabled.Tools, "container_security_k8_clusters_list")
+		for _, name := range enabled.Tools {
+			require.NotNil(t, s.GetTool(name))
+		}
+		require.Nil(t, s.GetTool("iam_accounts_list"))
+		require.Equal(t, 1, session.listChanged())
+
+		// The tools of read toolsets get the read arguments.
+		require.Contains(t, s.GetTool("container_security_k8_clusters_list").Tool.InputSchema.Properties, "fields")
+
+		result = callToolResult(t, s.HandleMessage, tools.EnableToolsetToolName, map[string]any{"toolset": "container"})
+		require.False(t, result.IsError)
+		require.Equal(t, 0, session.listChanged())
+	})
+
+	t.Run("should describe tools that are not enabled", func(t *testing.T) {
+		s, _ := newLazyTestServer(t, ServerConfig{ReadOnly: true})
+		require.False(t, callToolResult(t, s.HandleMessage, tools.EnableToolsetToolName, map[string]any{"toolset": "iam"}).IsError)
+
+		result := callToolResult(t, s.HandleMessage, tools.DescribeToolName, map[string]any{})
+		require.False(t, result.IsError)
+		page := struct {
+			Tools []struct {
+				Name    string `json:"name"`
+				Toolset string `json:"toolset"`
+				Enabled bool   `json:"enabled"`
+			} `json:"tools"`
+		}{}
+		require.NoError(t, json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &page))
+		require.NotEmpty(t, page.Tools)
+		for _, tool := range page.Tools {
+			require.Equal(t, tool.Toolset == "iam", tool.Enabled, tool.Name)
+		}
+	})
+
+	t.Run("should remove idle toolsets", func(t *testing.T) {
+		s, session := newLazyTestServer(t, ServerConfig{ReadOnly: true, ToolsetIdleTimeout: 20 * time.Millisecond})
+
+		require.False(t, callToolResult(t, s.HandleMessage, tools.EnableToolsetToolName, map[string]any{"toolset": "container"}).IsError)
+		require.NotNil(t, s.GetTool("container_security_k8_clusters_list"))
+
+		require.Eventually(t, func() bool {
+			return s.GetTool("container_security_k8_clusters_list") == nil
+		}, time.Second, 5*time.Millisecond)
+		require.Equal(t, 2, session.listChanged())
+
+		require.False(t, callToolResult(t, s.HandleMessage, tools.EnableToolsetToolName, map[string]any{"toolset": "container"}).IsError)
+		require.NotNil(t, s.GetTool("container_security_k8_clusters_list"))
+	})
+
+	t.Run("should keep toolsets with running calls", func(t *testing.T) {
+		started := make(chan struct{})
+		release := make(chan struct{})
+		registration := tools.Registration{
+			Toolset: "blocking",
+			New: func(*v1client.V1ApiClient) mcpserver.ServerTool {
+				return mcpserver.ServerTool{
+					Tool: mcp.NewTool("blocking_call", mcp.WithToolAnnotation(mcp.ToolAnnotation{
+						Title:           "Blocking Call",
+						ReadOnlyHint:    toPtr(true),
+						DestructiveHint: toPtr(false),
+						IdempotentHint:  toPtr(true),
+						OpenWorldHint:   toPtr(false),
+					})),
+					Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+						close(started)
+						<-release
+						return mcp.NewToolResultText("done"), nil
+					},
+				}
+			},
+		}
+
+		s := mcpserver.NewMCPServer("test", "1", mcpserver.WithToolCapabilities(true))
+		loader := newToolsetLoader(s, toolFactory{}, []tools.Registration{registration}, 20*time.Millisecond)
+		_, err := loader.enable("blocking")
+		require.NoError(t, err)
+
+		done := make(chan *mcp.CallToolResult)
+		go func() {
+			done <- callToolResult(t, s.HandleMessage, "blocking_call", map[string]any{})
+		}()
+		<-started
+
+		// The call runs for several idle timeouts.
+		time.Sleep(100 * time.Millisecond)
+		require.NotNil(t, s.GetTool("blocking_call"))
+
+		close(release)
+		require.False(t, (<-done).IsError)
+		require.Eventually(t, func() bool {
+			return s.GetTool("blocking_call") == nil
+		}, time.Second, 5*time.Millisecond)
+	})
+
+	t.Run("should return an error for invalid tools", func(t *testing.T) {
+		registration := tools.Registration{
+			Toolset: "invalid",
+			New: func(*v1client.V1ApiClient) mcpserver.ServerTool {
+				return mcpserver.ServerTool{Tool: mcp.Tool{Name: "invalid_call"}}
+			},
+		}
+
+		s := mcpserver.NewMCPServer("test", "1", mcpserver.WithToolCapabilities(true))
+		loader := newToolsetLoader(s, toolFactory{}, []tools.Registration{registration}, 0)
+		_, err := loader.enable("invalid")
+		require.ErrorContains(t, err, `toolset "invalid" could not be enabled: tool "invalid_call": missing title`)
+		require.Nil(t, s.GetTool("invalid_call"))
+	})
+
+	t.Run("should reject unknown toolsets", func(t *testing.T) {
+		s, _ := newLazyTestServer(t, ServerConfig{ReadOnly: true})
+		result := callToolResult(t, s.HandleMessage, tools.EnableToolsetToolName, map[string]any{"toolset": "unknown"})
+		require.True(t, result.IsError)
+	})
+
+	t.Run("should validate policies against tools that are not enabled", func(t *testing.T) {
+		dir := t.TempDir()
+		policyFile := filepath.Join(dir, "policy.yaml")
+		require.NoError(t, os.WriteFile(policyFile, []byte("tools:\n  iam_account_delete: {}\n"), 0o600))
+
+		s, _ := newLazyTestServer(t, ServerConfig{
+			ReadOnly:   false,
+			AuditLog:   filepath.Join(dir, "audit.jsonl"),
+			PolicyFile: policyFile,
+		})
+		require.Nil(t, s.GetTool("iam_account_delete"))
+	})
+}